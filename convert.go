@@ -0,0 +1,59 @@
+package units
+
+import (
+	"fmt"
+)
+
+// TypeMismatchError is returned when two units that belong to different
+// UnitTypes are used together, eg. converting a Pressure to a Temperature
+type TypeMismatchError struct {
+	From UnitType
+	To   UnitType
+}
+
+// Error implements the error interface
+func (e *TypeMismatchError) Error() string {
+	return fmt.Sprintf("units: cannot use %s with %s", e.From.Title(), e.To.Title())
+}
+
+// SameType returns true if both units belong to the same UnitType
+func SameType(a, b Unit) bool {
+	return a.TypeOf().Title() == b.TypeOf().Title()
+}
+
+// Convert converts value from one unit to another by way of the base unit of
+// their UnitType. A *TypeMismatchError is returned when the units don't share
+// a UnitType.
+func Convert(value float64, from, to Unit) (float64, error) {
+	if !SameType(from, to) {
+		return 0, &TypeMismatchError{From: from.TypeOf(), To: to.TypeOf()}
+	}
+	return to.FromBase(from.ToBase(value)), nil
+}
+
+// ConvertDifference converts a difference between two values, rather than a
+// value, from one unit to another. For linear units it's the same as Convert,
+// but the offset of affine units is dropped, so a 10 °C rise is an 18 °F rise
+// rather than 50 °F.
+func ConvertDifference(value float64, from, to Unit) (float64, error) {
+	zero, err := Convert(0, from, to)
+	if err != nil {
+		return 0, err
+	}
+	v, _ := Convert(value, from, to)
+	return v - zero, nil
+}
+
+// ConvertAlaka is Convert for units given as AlakaTitle strings, eg.
+// "Pressure_PoundsPerSquareInch"
+func ConvertAlaka(value float64, fromTitle, toTitle string) (float64, error) {
+	_, from, err := LookupTypeUnit(fromTitle)
+	if err != nil {
+		return 0, err
+	}
+	_, to, err := LookupTypeUnit(toTitle)
+	if err != nil {
+		return 0, err
+	}
+	return Convert(value, from, to)
+}
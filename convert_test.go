@@ -0,0 +1,35 @@
+package units
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestConvertDifference(t *testing.T) {
+	tests := []struct {
+		value    float64
+		from, to Unit
+		want     float64
+	}{
+		{10, DegreesCelsiusTemperatureUnit, DegreesFahrenheitTemperatureUnit, 18},
+		{10, KelvinsTemperatureUnit, DegreesCelsiusTemperatureUnit, 10},
+		{-9, DegreesFahrenheitTemperatureUnit, KelvinsTemperatureUnit, -5},
+		{10, DegreesCelsiusTemperatureDifferenceUnit, DegreesFahrenheitTemperatureDifferenceUnit, 18},
+		{2, KilometersLengthUnit, MetersLengthUnit, 2000},
+	}
+	for _, tc := range tests {
+		got, err := ConvertDifference(tc.value, tc.from, tc.to)
+		if err != nil || math.Abs(got-tc.want) > 1e-9 {
+			t.Errorf("ConvertDifference(%v, %s, %s) = %v, %v, want %v", tc.value, tc.from.Symbol(), tc.to.Symbol(), got, err, tc.want)
+		}
+	}
+
+	var mismatch *TypeMismatchError
+	if _, err := ConvertDifference(10, DegreesCelsiusTemperatureUnit, MetersLengthUnit); !errors.As(err, &mismatch) {
+		t.Errorf("ConvertDifference(°C, m) = %v, want a *TypeMismatchError", err)
+	}
+	if _, err := ConvertDifference(10, DegreesCelsiusTemperatureUnit, DegreesFahrenheitTemperatureDifferenceUnit); !errors.As(err, &mismatch) {
+		t.Errorf("ConvertDifference(°C, Δ°F) = %v, want a *TypeMismatchError", err)
+	}
+}
@@ -0,0 +1,214 @@
+package units
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// DecimalPlaces is how many places ConvertDecimal rounds to when the exact
+// result doesn't terminate, eg. a third of a barrel
+var DecimalPlaces = 18
+
+// InexactError is returned by the exact conversions when a unit has no exact
+// factor to its base, ie. it's affine like DegreesFahrenheit, see ExactFactor
+type InexactError struct {
+	Unit Unit
+}
+
+// Error implements the error interface
+func (e *InexactError) Error() string {
+	return fmt.Sprintf("units: %s has no exact factor", AlakaTitle(e.Unit.TypeOf(), e.Unit))
+}
+
+// ExactFactorer is implemented by units which know the exact number of base
+// units in one of them, as the generated and registered units do
+type ExactFactorer interface {
+	// ExactFactor returns the exact number of base units in one of this unit,
+	// or false when the unit is affine and has no single factor
+	ExactFactor() (*big.Rat, bool)
+}
+
+// ExactFactor returns the exact number of base units in one of u, or false
+// when u is affine or doesn't implement ExactFactorer
+func ExactFactor(u Unit) (*big.Rat, bool) {
+	if f, ok := u.(ExactFactorer); ok {
+		return f.ExactFactor()
+	}
+	return nil, false
+}
+
+// ConvertRat converts value from one unit to another exactly, using the
+// rational factors the float64 conversions are rounded from. Only linear
+// units can be converted; an *InexactError is returned otherwise.
+func ConvertRat(value *big.Rat, from, to Unit) (*big.Rat, error) {
+	if !SameType(from, to) {
+		return nil, &TypeMismatchError{From: from.TypeOf(), To: to.TypeOf()}
+	}
+	fromFactor, ok := ExactFactor(from)
+	if !ok {
+		return nil, &InexactError{Unit: from}
+	}
+	toFactor, ok := ExactFactor(to)
+	if !ok {
+		return nil, &InexactError{Unit: to}
+	}
+
+	result := new(big.Rat).Mul(value, fromFactor)
+	return result.Quo(result, toFactor), nil
+}
+
+// ConvertDecimal is ConvertRat for decimal strings such as "1234.5678". The
+// result is exact when it terminates, otherwise it's rounded to DecimalPlaces.
+func ConvertDecimal(value string, from, to Unit) (string, error) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(value))
+	if !ok {
+		return "", fmt.Errorf("units: %q is not a decimal number", value)
+	}
+	result, err := ConvertRat(r, from, to)
+	if err != nil {
+		return "", err
+	}
+	return decimalString(result), nil
+}
+
+// decimalString writes r in full if its decimal expansion terminates, or
+// rounded to DecimalPlaces if not, without trailing zeros
+func decimalString(r *big.Rat) string {
+	places, ok := terminatingPlaces(r.Denom())
+	if !ok {
+		places = DecimalPlaces
+	}
+
+	out := r.FloatString(places)
+	if strings.Contains(out, ".") {
+		out = strings.TrimRight(strings.TrimRight(out, "0"), ".")
+	}
+	if out == "-0" {
+		out = "0"
+	}
+	return out
+}
+
+// terminatingPlaces returns how many decimal places 1/denom needs, or false if
+// it repeats forever
+func terminatingPlaces(denom *big.Int) (int, bool) {
+	d := new(big.Int).Set(denom)
+	twos, fives := 0, 0
+	two, five, rem := big.NewInt(2), big.NewInt(5), new(big.Int)
+	for d.Cmp(big.NewInt(1)) != 0 {
+		switch {
+		case rem.Mod(d, two).Sign() == 0:
+			d.Quo(d, two)
+			twos++
+		case rem.Mod(d, five).Sign() == 0:
+			d.Quo(d, five)
+			fives++
+		default:
+			return 0, false
+		}
+	}
+	if twos > fives {
+		return twos, true
+	}
+	return fives, true
+}
+
+// MicrosPerUnit is the number of FixedQuantity.Micros in one of its unit
+const MicrosPerUnit = 1000000
+
+// ErrOverflow is returned when a FixedQuantity doesn't fit in an int64
+var ErrOverflow = errors.New("units: fixed quantity overflows int64")
+
+// FixedQuantity is an amount of a Unit in whole millionths. Unlike Quantity,
+// sums of quantities in the same unit are exact, which is what totalisers
+// and royalty volumes need.
+type FixedQuantity struct {
+	Micros int64
+	Unit   Unit
+}
+
+// ParseFixedQuantity parses a decimal string such as "1234.567891" as an
+// amount of u. Digits beyond the sixth decimal place are rounded half away
+// from zero.
+func ParseFixedQuantity(value string, u Unit) (FixedQuantity, error) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(value))
+	if !ok {
+		return FixedQuantity{}, fmt.Errorf("units: %q is not a decimal number", value)
+	}
+	return FixedQuantityOf(r, u)
+}
+
+// FixedQuantityOf returns r of u rounded half away from zero to the nearest
+// millionth
+func FixedQuantityOf(r *big.Rat, u Unit) (FixedQuantity, error) {
+	scaled := new(big.Rat).Mul(r, big.NewRat(MicrosPerUnit, 1))
+	num, denom := scaled.Num(), scaled.Denom()
+
+	micros, rem := new(big.Int).QuoRem(num, denom, new(big.Int))
+	if rem.Sign() != 0 && new(big.Int).Mul(new(big.Int).Abs(rem), big.NewInt(2)).Cmp(denom) >= 0 {
+		micros.Add(micros, big.NewInt(int64(num.Sign())))
+	}
+	if !micros.IsInt64() {
+		return FixedQuantity{}, ErrOverflow
+	}
+	return FixedQuantity{Micros: micros.Int64(), Unit: u}, nil
+}
+
+// Rat returns the exact amount
+func (q FixedQuantity) Rat() *big.Rat {
+	return big.NewRat(q.Micros, MicrosPerUnit)
+}
+
+// Quantity returns the nearest float64 Quantity
+func (q FixedQuantity) Quantity() Quantity {
+	v, _ := q.Rat().Float64()
+	return Quantity{Value: v, Unit: q.Unit}
+}
+
+// In returns the quantity converted exactly to u, then rounded to the nearest
+// millionth of u
+func (q FixedQuantity) In(u Unit) (FixedQuantity, error) {
+	r, err := ConvertRat(q.Rat(), q.Unit, u)
+	if err != nil {
+		return FixedQuantity{}, err
+	}
+	return FixedQuantityOf(r, u)
+}
+
+// Add returns q + o in the unit of q. The sum is exact when both share a
+// unit; otherwise o is first converted with In.
+func (q FixedQuantity) Add(o FixedQuantity) (FixedQuantity, error) {
+	if o.Unit != q.Unit {
+		var err error
+		if o, err = o.In(q.Unit); err != nil {
+			return FixedQuantity{}, err
+		}
+	}
+
+	sum := q.Micros + o.Micros
+	if (o.Micros > 0 && sum < q.Micros) || (o.Micros < 0 && sum > q.Micros) {
+		return FixedQuantity{}, ErrOverflow
+	}
+	return FixedQuantity{Micros: sum, Unit: q.Unit}, nil
+}
+
+// Sub returns q - o in the unit of q, see Add
+func (q FixedQuantity) Sub(o FixedQuantity) (FixedQuantity, error) {
+	if o.Micros == math.MinInt64 {
+		return FixedQuantity{}, ErrOverflow
+	}
+	return q.Add(FixedQuantity{Micros: -o.Micros, Unit: o.Unit})
+}
+
+// String returns the exact amount followed by the unit symbol, eg.
+// "1234.567891 bbl"
+func (q FixedQuantity) String() string {
+	value := decimalString(q.Rat())
+	if q.Unit == nil || q.Unit.Symbol() == "" {
+		return value
+	}
+	return value + " " + q.Unit.Symbol()
+}
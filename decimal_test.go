@@ -0,0 +1,115 @@
+package units
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestConvertDecimal(t *testing.T) {
+	tests := []struct {
+		value    string
+		from, to Unit
+		want     string
+	}{
+		{"1", BarrelsOfOilVolumeUnit, GallonUSFluidVolumeUnit, "42"},
+		{"1", FeetLengthUnit, MetersLengthUnit, "0.3048"},
+		{"1234.5678", MetersLengthUnit, MetersLengthUnit, "1234.5678"},
+		{"-0", MetersLengthUnit, FeetLengthUnit, "0"},
+		// 1/42 and 1/0.3048 repeat forever so they're rounded to DecimalPlaces
+		{"1", GallonUSFluidVolumeUnit, BarrelsOfOilVolumeUnit, "0.02380952380952381"},
+		{"1", MetersLengthUnit, FeetLengthUnit, "3.28083989501312336"},
+		{"-2", GallonUSFluidVolumeUnit, BarrelsOfOilVolumeUnit, "-0.047619047619047619"},
+	}
+	for _, tc := range tests {
+		got, err := ConvertDecimal(tc.value, tc.from, tc.to)
+		if err != nil || got != tc.want {
+			t.Errorf("ConvertDecimal(%q, %s, %s) = %q, %v, want %q", tc.value, tc.from.Symbol(), tc.to.Symbol(), got, err, tc.want)
+		}
+	}
+
+	if _, err := ConvertDecimal("1.2.3", FeetLengthUnit, MetersLengthUnit); err == nil {
+		t.Error("ConvertDecimal(1.2.3) didn't fail")
+	}
+	var inexact *InexactError
+	if _, err := ConvertDecimal("1", DegreesFahrenheitTemperatureUnit, DegreesCelsiusTemperatureUnit); !errors.As(err, &inexact) {
+		t.Errorf("ConvertDecimal(°F) = %v, want an InexactError", err)
+	}
+	var mismatch *TypeMismatchError
+	if _, err := ConvertDecimal("1", FeetLengthUnit, GallonUSFluidVolumeUnit); !errors.As(err, &mismatch) {
+		t.Errorf("ConvertDecimal(ft to gal) = %v, want a TypeMismatchError", err)
+	}
+}
+
+func TestParseFixedQuantity(t *testing.T) {
+	tests := []struct {
+		value  string
+		micros int64
+	}{
+		{"1234.567891", 1234567891},
+		{" 42 ", 42000000},
+		{"0.0000005", 1},
+		{"-0.0000005", -1},
+		{"0.00000049", 0},
+		{"-0.00000049", 0},
+		{"1.2345675", 1234568},
+		{"-2.5000015", -2500002},
+		{"9223372036854.775807", math.MaxInt64},
+	}
+	for _, tc := range tests {
+		q, err := ParseFixedQuantity(tc.value, BarrelsOfOilVolumeUnit)
+		if err != nil || q.Micros != tc.micros {
+			t.Errorf("ParseFixedQuantity(%q) = %d, %v, want %d", tc.value, q.Micros, err, tc.micros)
+		}
+	}
+
+	for _, value := range []string{"", "abc", "1.2.3", "1,000"} {
+		if _, err := ParseFixedQuantity(value, BarrelsOfOilVolumeUnit); err == nil || errors.Is(err, ErrOverflow) {
+			t.Errorf("ParseFixedQuantity(%q) = %v, want a parse error", value, err)
+		}
+	}
+	for _, value := range []string{"9223372036854.7758075", "1e13", "-1e13"} {
+		if _, err := ParseFixedQuantity(value, BarrelsOfOilVolumeUnit); !errors.Is(err, ErrOverflow) {
+			t.Errorf("ParseFixedQuantity(%q) = %v, want ErrOverflow", value, err)
+		}
+	}
+}
+
+func TestFixedQuantityArithmetic(t *testing.T) {
+	bbl := func(micros int64) FixedQuantity { return FixedQuantity{Micros: micros, Unit: BarrelsOfOilVolumeUnit} }
+
+	sum, err := bbl(100000).Add(bbl(200000))
+	if err != nil || sum != bbl(300000) || sum.String() != "0.3 bbl" {
+		t.Errorf("0.1 bbl + 0.2 bbl = %v, %v", sum, err)
+	}
+	sum, err = bbl(1000000).Add(FixedQuantity{Micros: 21000000, Unit: GallonUSFluidVolumeUnit})
+	if err != nil || sum != bbl(1500000) {
+		t.Errorf("1 bbl + 21 gal = %v, %v", sum, err)
+	}
+	diff, err := bbl(1000000).Sub(bbl(2500000))
+	if err != nil || diff != bbl(-1500000) {
+		t.Errorf("1 bbl - 2.5 bbl = %v, %v", diff, err)
+	}
+
+	overflows := []struct {
+		name string
+		op   func() (FixedQuantity, error)
+	}{
+		{"max + 1", func() (FixedQuantity, error) { return bbl(math.MaxInt64).Add(bbl(1)) }},
+		{"min + -1", func() (FixedQuantity, error) { return bbl(math.MinInt64).Add(bbl(-1)) }},
+		{"min - 1", func() (FixedQuantity, error) { return bbl(math.MinInt64).Sub(bbl(1)) }},
+		{"0 - min", func() (FixedQuantity, error) { return bbl(0).Sub(bbl(math.MinInt64)) }},
+		{"-2 - max", func() (FixedQuantity, error) { return bbl(-2).Sub(bbl(math.MaxInt64)) }},
+		{"max bbl in gal", func() (FixedQuantity, error) {
+			return FixedQuantity{Micros: 1, Unit: GallonUSFluidVolumeUnit}.Add(bbl(math.MaxInt64))
+		}},
+	}
+	for _, tc := range overflows {
+		if _, err := tc.op(); !errors.Is(err, ErrOverflow) {
+			t.Errorf("%s = %v, want ErrOverflow", tc.name, err)
+		}
+	}
+	if got, err := bbl(-1).Sub(bbl(math.MaxInt64)); err != nil || got.Micros != math.MinInt64 {
+		t.Errorf("-1 - max = %v, %v", got, err)
+	}
+}
@@ -0,0 +1,128 @@
+package units
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Dimension holds the exponents of the SI base dimensions which make up a
+// UnitType, eg. Force is Mass¹·Length¹·Time⁻². Dimensionless types such as
+// Number and Percentage are the zero value.
+type Dimension struct {
+	Mass        int
+	Length      int
+	Time        int
+	Temperature int
+	Current     int
+	Amount      int
+}
+
+// IsDimensionless returns true if every exponent is zero
+func (d Dimension) IsDimensionless() bool {
+	return d == Dimension{}
+}
+
+// String returns the dimension in the form "M·L⁻¹·T⁻²"
+func (d Dimension) String() string {
+	if d.IsDimensionless() {
+		return "1"
+	}
+
+	var parts []string
+	for _, c := range []struct {
+		symbol string
+		exp    int
+	}{
+		{"M", d.Mass},
+		{"L", d.Length},
+		{"T", d.Time},
+		{"Θ", d.Temperature},
+		{"I", d.Current},
+		{"N", d.Amount},
+	} {
+		switch c.exp {
+		case 0:
+		case 1:
+			parts = append(parts, c.symbol)
+		default:
+			parts = append(parts, c.symbol+superscript(c.exp))
+		}
+	}
+	return strings.Join(parts, "·")
+}
+
+var superscripts = strings.NewReplacer(
+	"-", "⁻",
+	"0", "⁰", "1", "¹", "2", "²", "3", "³", "4", "⁴",
+	"5", "⁵", "6", "⁶", "7", "⁷", "8", "⁸", "9", "⁹",
+)
+
+// superscript formats n with unicode superscript digits
+func superscript(n int) string {
+	return superscripts.Replace(strconv.Itoa(n))
+}
+
+// IsCompatible returns true if both unit types share the same Dimension,
+// ie. they measure the same physical quantity even if they are stored apart
+// (eg. ElectricPotential and ElectricPotentialLoaded)
+func IsCompatible(a, b UnitType) bool {
+	return a.Dimension() == b.Dimension()
+}
+
+// Mul returns the dimension of a product of quantities of d and o
+func (d Dimension) Mul(o Dimension) Dimension {
+	return Dimension{
+		Mass:        d.Mass + o.Mass,
+		Length:      d.Length + o.Length,
+		Time:        d.Time + o.Time,
+		Temperature: d.Temperature + o.Temperature,
+		Current:     d.Current + o.Current,
+		Amount:      d.Amount + o.Amount,
+	}
+}
+
+// Div returns the dimension of a quotient of quantities of d and o
+func (d Dimension) Div(o Dimension) Dimension {
+	return Dimension{
+		Mass:        d.Mass - o.Mass,
+		Length:      d.Length - o.Length,
+		Time:        d.Time - o.Time,
+		Temperature: d.Temperature - o.Temperature,
+		Current:     d.Current - o.Current,
+		Amount:      d.Amount - o.Amount,
+	}
+}
+
+// DimensionError is returned when no UnitType has the dimension of a
+// derived quantity
+type DimensionError struct {
+	Dimension Dimension
+}
+
+// Error implements the error interface
+func (e *DimensionError) Error() string {
+	return fmt.Sprintf("units: no unit type has dimension %s", e.Dimension)
+}
+
+// TypeOfDimension returns the unit type which has dimension d. Several unit
+// types can share a dimension (eg. ElectricPotential and its Loaded and
+// Unloaded copies), so the first of prefer that matches wins, then the first
+// of UnitTypes. Dimensionless results that don't match prefer are a Number
+// rather than a Percentage. A *DimensionError is returned when nothing matches.
+func TypeOfDimension(d Dimension, prefer ...UnitType) (UnitType, error) {
+	for _, ut := range prefer {
+		if ut.Dimension() == d {
+			return ut, nil
+		}
+	}
+	if d.IsDimensionless() {
+		return NumberUnitType, nil
+	}
+	for _, ut := range UnitTypes {
+		if ut.Dimension() == d {
+			return ut, nil
+		}
+	}
+	return nil, &DimensionError{Dimension: d}
+}
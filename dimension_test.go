@@ -0,0 +1,70 @@
+package units
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestIsCompatible(t *testing.T) {
+	tests := []struct {
+		a, b UnitType
+		want bool
+	}{
+		{PressureUnitType, PressureUnitType, true},
+		{ElectricPotentialUnitType, ElectricPotentialLoadedUnitType, true},
+		{TemperatureUnitType, TemperatureDifferenceUnitType, true},
+		{PressureUnitType, LengthUnitType, false},
+		{WorkUnitType, ForceUnitType, false},
+		{PercentageUnitType, NumberUnitType, true},
+		{PercentageUnitType, LengthUnitType, false},
+	}
+	for _, tc := range tests {
+		if got := IsCompatible(tc.a, tc.b); got != tc.want {
+			t.Errorf("IsCompatible(%s, %s) = %v, want %v", tc.a.Title(), tc.b.Title(), got, tc.want)
+		}
+	}
+	if !PercentageUnitType.Dimension().IsDimensionless() || PressureUnitType.Dimension().IsDimensionless() {
+		t.Error("only Percentage should be dimensionless")
+	}
+}
+
+func TestTypeOfDimension(t *testing.T) {
+	tests := []struct {
+		dim    Dimension
+		prefer []UnitType
+		want   UnitType
+	}{
+		{Dimension{Mass: 1, Length: -1, Time: -2}, nil, PressureUnitType},
+		{Dimension{Mass: 1, Length: 1, Time: -2}, nil, ForceUnitType},
+		{Dimension{Length: 3, Time: -1}, nil, FlowUnitType},
+		{Dimension{}, nil, NumberUnitType},
+		{Dimension{}, []UnitType{PercentageUnitType}, PercentageUnitType},
+		{ElectricPotentialUnitType.Dimension(), nil, ElectricPotentialUnitType},
+		{ElectricPotentialUnitType.Dimension(), []UnitType{LengthUnitType, ElectricPotentialLoadedUnitType}, ElectricPotentialLoadedUnitType},
+	}
+	for _, tc := range tests {
+		if got, err := TypeOfDimension(tc.dim, tc.prefer...); err != nil || got != tc.want {
+			t.Errorf("TypeOfDimension(%s) = %v, %v, want %s", tc.dim, got, err, tc.want.Title())
+		}
+	}
+
+	var dimErr *DimensionError
+	if got, err := TypeOfDimension(Dimension{Length: 2}); got != nil || !errors.As(err, &dimErr) {
+		t.Errorf("TypeOfDimension(L²) = %v, %v, want a *DimensionError", got, err)
+	}
+}
+
+func TestDimensionString(t *testing.T) {
+	tests := map[Dimension]string{
+		{}:                                 "1",
+		{Mass: 1, Length: -1, Time: -2}:    "M·L⁻¹·T⁻²",
+		{Length: 3, Time: -1}:              "L³·T⁻¹",
+		{Temperature: 1}:                   "Θ",
+		{Length: 2, Time: -2, Current: -1}: "L²·T⁻²·I⁻¹",
+	}
+	for d, want := range tests {
+		if got := d.String(); got != want {
+			t.Errorf("%#v.String() = %q, want %q", d, got, want)
+		}
+	}
+}
@@ -0,0 +1,427 @@
+package units
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// UnitExpression is a compound unit such as "kg·m/s²", see ParseUnitExpression
+type UnitExpression struct {
+	// Expression is the source that was parsed
+	Expression string
+	// Factor is the exact amount of the coherent SI unit of Dimension in one
+	// of the expression, eg. 1/60 for "kg/min"
+	Factor *big.Rat
+	// Dimension is the dimension of the expression
+	Dimension Dimension
+}
+
+// ExpressionError is returned by ParseUnitExpression when an expression
+// can't be parsed, or one of its units isn't known or is ambiguous
+type ExpressionError struct {
+	Expression string
+	// Offset is the byte offset of the problem in Expression
+	Offset int
+	Reason string
+}
+
+// Error implements the error interface
+func (e *ExpressionError) Error() string {
+	return fmt.Sprintf("units: unit expression %q at offset %d: %s", e.Expression, e.Offset, e.Reason)
+}
+
+// ExpressionMismatchError is returned when a UnitExpression is converted to a
+// unit of another dimension
+type ExpressionMismatchError struct {
+	Expression *UnitExpression
+	To         UnitType
+}
+
+// Error implements the error interface
+func (e *ExpressionMismatchError) Error() string {
+	return fmt.Sprintf("units: cannot convert %q (%s) to %s (%s)",
+		e.Expression.Expression, e.Expression.Dimension, e.To.Title(), e.To.Dimension())
+}
+
+// ParseUnitExpression builds a unit out of the products, quotients and
+// powers of registered units, eg. "lb/min", "m^3/s", "ft³/d", "kg·m/s²" or
+// "1/s". Units are multiplied by "*", "·", "×" or whitespace, divided by "/"
+// and raised to an integer power from -16 to 16 by "^2", "^-1" or
+// superscripts, and these are applied left to right so "J/kg·K" is (J/kg)·K
+// unless it's written "J/(kg·K)". Each unit is looked up across every unit
+// type as by FindUnits, preferring ones whose symbol is exactly the same, and
+// it's an error when they disagree. Absolute units of affine unit types are
+// read as their difference, so "°F" is Δ°F, and percentages as fractions, so
+// "%/min" is 1/6000 per second. An expression which is a unit by itself is
+// used as is, although one with whitespace only when it's the unit's symbol
+// or name, eg. "in lbf", so "m m" is m².
+func ParseUnitExpression(expr string) (*UnitExpression, error) {
+	p := &expressionParser{source: expr}
+	if f, ok, err := p.resolve(strings.TrimSpace(expr), 0); ok || err != nil {
+		return p.result(f, err)
+	}
+
+	p.input = []rune(expr)
+	p.skipSpace()
+	if p.done() {
+		return nil, p.errorf("empty expression")
+	}
+	f, err := p.product()
+	if err == nil && !p.done() {
+		err = p.errorf("unexpected %q", string(p.input[p.pos]))
+	}
+	return p.result(f, err)
+}
+
+// Type returns the unit type of the expression's dimension, see TypeOfDimension
+func (e *UnitExpression) Type() (UnitType, error) {
+	return TypeOfDimension(e.Dimension)
+}
+
+// Unit returns the first registered unit which is the same as the expression,
+// eg. BarrelsPerMinuteFlowUnit for "bbl/min", or false when there is none.
+// Absolute values of affine unit types are skipped, as for Convert.
+func (e *UnitExpression) Unit() (Unit, bool) {
+	for _, ut := range RegisteredTypes() {
+		if ut.Dimension() != e.Dimension || DifferenceType(ut) != nil {
+			continue
+		}
+		for _, u := range DefaultRegistry.Units(ut) {
+			f, exact := ExactFactor(u)
+			if exact && new(big.Rat).Mul(f, exactCoherentScale(ut)).Cmp(e.Factor) == 0 {
+				return u, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// Convert converts value of the expression to the unit to, which must have
+// the same dimension. Absolute values of affine unit types such as
+// Temperature can't be converted to, as an expression has no offset.
+func (e *UnitExpression) Convert(value float64, to Unit) (float64, error) {
+	if to.TypeOf().Dimension() != e.Dimension {
+		return 0, &ExpressionMismatchError{Expression: e, To: to.TypeOf()}
+	}
+	if DifferenceType(to.TypeOf()) != nil {
+		return 0, &AbsoluteValueError{Op: "convert an expression to", Type: to.TypeOf()}
+	}
+	factor, _ := e.Factor.Float64()
+	return to.FromBase(value * factor / coherentScale(to.TypeOf())), nil
+}
+
+// expressionFactor is the exact factor and dimension of part of an expression
+type expressionFactor struct {
+	value     *big.Rat
+	dimension Dimension
+}
+
+func (f expressionFactor) mul(o expressionFactor) expressionFactor {
+	return expressionFactor{new(big.Rat).Mul(f.value, o.value), f.dimension.Mul(o.dimension)}
+}
+
+func (f expressionFactor) quo(o expressionFactor) expressionFactor {
+	return expressionFactor{new(big.Rat).Quo(f.value, o.value), f.dimension.Div(o.dimension)}
+}
+
+// maxExpressionPower bounds the powers of an expression, which would
+// otherwise let "ft^20000" take as long as it likes
+const maxExpressionPower = 16
+
+// pow raises f to the power n, which is at most maxExpressionPower either way
+func (f expressionFactor) pow(n int) expressionFactor {
+	abs := n
+	if n < 0 {
+		abs = -n
+	}
+	exp := big.NewInt(int64(abs))
+	num := new(big.Int).Exp(f.value.Num(), exp, nil)
+	denom := new(big.Int).Exp(f.value.Denom(), exp, nil)
+	if n < 0 {
+		num, denom = denom, num
+	}
+
+	result := expressionFactor{value: new(big.Rat).SetFrac(num, denom)}
+	for i := 0; i < abs; i++ {
+		if n < 0 {
+			result.dimension = result.dimension.Div(f.dimension)
+		} else {
+			result.dimension = result.dimension.Mul(f.dimension)
+		}
+	}
+	return result
+}
+
+// expressionParser is a recursive descent parser over the grammar
+//
+//	product = power { ("*" | "·" | "×" | "/" | whitespace) power }
+//	power   = primary [ "^" [ "-" ] digits | superscripts ]
+//	primary = number | unit | "(" product ")"
+type expressionParser struct {
+	source string
+	input  []rune
+	pos    int
+}
+
+// expressionOperators end a unit or number
+const expressionOperators = "*·⋅×/^()"
+
+// superscriptDigits are the digits of a superscript power, by value
+const superscriptDigits = "⁰¹²³⁴⁵⁶⁷⁸⁹"
+
+func (p *expressionParser) result(f expressionFactor, err error) (*UnitExpression, error) {
+	if err != nil {
+		return nil, err
+	}
+	return &UnitExpression{Expression: p.source, Factor: f.value, Dimension: f.dimension}, nil
+}
+
+func (p *expressionParser) errorf(format string, args ...interface{}) error {
+	offset := len(p.source)
+	if p.input != nil {
+		offset = len(string(p.input[:p.pos]))
+	}
+	return &ExpressionError{Expression: p.source, Offset: offset, Reason: fmt.Sprintf(format, args...)}
+}
+
+func (p *expressionParser) done() bool {
+	return p.pos >= len(p.input)
+}
+
+func (p *expressionParser) peek() rune {
+	if p.done() {
+		return 0
+	}
+	return p.input[p.pos]
+}
+
+func (p *expressionParser) skipSpace() bool {
+	start := p.pos
+	for !p.done() && unicode.IsSpace(p.peek()) {
+		p.pos++
+	}
+	return p.pos > start
+}
+
+func (p *expressionParser) product() (expressionFactor, error) {
+	left, err := p.power()
+	if err != nil {
+		return left, err
+	}
+	for {
+		spaced := p.skipSpace()
+		if p.done() || p.peek() == ')' {
+			return left, nil
+		}
+
+		divide := false
+		switch p.peek() {
+		case '/':
+			divide = true
+			p.pos++
+		case '*', '·', '⋅', '×':
+			p.pos++
+		default:
+			if !spaced {
+				return left, p.errorf("unexpected %q", string(p.peek()))
+			}
+		}
+		p.skipSpace()
+
+		right, err := p.power()
+		if err != nil {
+			return left, err
+		}
+		if divide {
+			left = left.quo(right)
+		} else {
+			left = left.mul(right)
+		}
+	}
+}
+
+func (p *expressionParser) power() (expressionFactor, error) {
+	base, err := p.primary()
+	if err != nil {
+		return base, err
+	}
+
+	switch {
+	case p.peek() == '^':
+		p.pos++
+		start := p.pos
+		if p.peek() == '-' {
+			p.pos++
+		}
+		for !p.done() && p.peek() >= '0' && p.peek() <= '9' {
+			p.pos++
+		}
+		n, err := strconv.Atoi(string(p.input[start:p.pos]))
+		if err != nil {
+			return base, p.errorf("expected an integer power")
+		}
+		return p.raise(base, n, start)
+	case p.peek() == '⁻' || strings.ContainsRune(superscriptDigits, p.peek()):
+		sign := 1
+		if p.peek() == '⁻' {
+			sign = -1
+			p.pos++
+		}
+		start := p.pos
+		n, digits := 0, 0
+		for ; !p.done(); p.pos++ {
+			idx := strings.IndexRune(superscriptDigits, p.peek())
+			if idx < 0 {
+				break
+			}
+			if n <= maxExpressionPower {
+				n = n*10 + len([]rune(superscriptDigits[:idx]))
+			}
+			digits++
+		}
+		if digits == 0 {
+			return base, p.errorf("expected a superscript power")
+		}
+		return p.raise(base, sign*n, start)
+	}
+	return base, nil
+}
+
+// raise raises base to the power n, which starts at rune offset start, or
+// fails when n is out of range
+func (p *expressionParser) raise(base expressionFactor, n, start int) (expressionFactor, error) {
+	if n < -maxExpressionPower || n > maxExpressionPower {
+		p.pos = start
+		return base, p.errorf("power out of range -%d to %d", maxExpressionPower, maxExpressionPower)
+	}
+	return base.pow(n), nil
+}
+
+func (p *expressionParser) primary() (expressionFactor, error) {
+	if p.peek() == '(' {
+		p.pos++
+		p.skipSpace()
+		f, err := p.product()
+		if err != nil {
+			return f, err
+		}
+		if p.peek() != ')' {
+			return f, p.errorf("expected \")\"")
+		}
+		p.pos++
+		return f, nil
+	}
+
+	start := p.pos
+	for !p.done() {
+		r := p.peek()
+		if unicode.IsSpace(r) || strings.ContainsRune(expressionOperators+superscriptDigits+"⁻", r) {
+			break
+		}
+		p.pos++
+	}
+	if p.pos == start {
+		if p.done() {
+			return expressionFactor{}, p.errorf("expected a unit")
+		}
+		return expressionFactor{}, p.errorf("expected a unit but found %q", string(p.peek()))
+	}
+
+	text := string(p.input[start:p.pos])
+	if r := []rune(text)[0]; r == '.' || (r >= '0' && r <= '9') {
+		value, ok := new(big.Rat).SetString(text)
+		if !ok || value.Sign() == 0 {
+			return expressionFactor{}, p.errorf("invalid number %q", text)
+		}
+		return expressionFactor{value: value}, nil
+	}
+
+	f, ok, err := p.resolve(text, start)
+	if err != nil || ok {
+		return f, err
+	}
+	// Trailing digits are a power, eg. m3 is m^3
+	if trimmed := strings.TrimRight(text, "0123456789"); trimmed != text && trimmed != "" {
+		if f, ok, err := p.resolve(trimmed, start); err != nil {
+			return f, err
+		} else if ok {
+			n, err := strconv.Atoi(text[len(trimmed):])
+			if err != nil {
+				n = maxExpressionPower + 1
+			}
+			return p.raise(f, n, start+len([]rune(trimmed)))
+		}
+	}
+	return f, &ExpressionError{Expression: p.source, Offset: len(string(p.input[:start])), Reason: fmt.Sprintf("unknown unit %q", text)}
+}
+
+// resolve finds the factor of the unit text, which starts at rune offset
+// start. It's false when no unit matches. Text with whitespace only matches
+// a unit with that symbol or name, rather than any with it squashed together.
+func (p *expressionParser) resolve(text string, start int) (expressionFactor, bool, error) {
+	fail := func(format string, args ...interface{}) (expressionFactor, bool, error) {
+		offset := len(string([]rune(p.source)[:start]))
+		return expressionFactor{}, false, &ExpressionError{Expression: p.source, Offset: offset, Reason: fmt.Sprintf(format, args...)}
+	}
+
+	found := FindUnits(text)
+	if strings.IndexFunc(text, unicode.IsSpace) >= 0 {
+		found = spelledOut(found, text)
+	}
+	var symbols []TypeUnit
+	for _, tu := range found {
+		if tu.Unit.Symbol() == text {
+			symbols = append(symbols, tu)
+		}
+	}
+	if len(symbols) > 0 {
+		found = symbols
+	}
+	if len(found) == 0 {
+		return expressionFactor{}, false, nil
+	}
+
+	var first *TypeUnit
+	var result expressionFactor
+	for idx := range found {
+		tu := &found[idx]
+		u, ut := tu.Unit, tu.Type
+		if diff := DifferenceType(ut); diff != nil {
+			if du, err := differenceUnit(u, diff); err == nil {
+				u, ut = du, diff
+			}
+		}
+		value, exact := ExactFactor(u)
+		if !exact {
+			continue
+		}
+		value = new(big.Rat).Mul(value, exactCoherentScale(ut))
+		f := expressionFactor{value: value, dimension: ut.Dimension()}
+		if first != nil && (f.value.Cmp(result.value) != 0 || f.dimension != result.dimension) {
+			return fail("%q is ambiguous, it's both %s and %s", text,
+				AlakaTitle(first.Type, first.Unit), AlakaTitle(tu.Type, tu.Unit))
+		}
+		first, result = tu, f
+	}
+	if first == nil {
+		return fail("%s is affine so it can't be part of an expression", AlakaTitle(found[0].Type, found[0].Unit))
+	}
+	return result, true, nil
+}
+
+// spelledOut returns the units of found whose symbol or name is text, apart
+// from runs of whitespace and the case of the name
+func spelledOut(found []TypeUnit, text string) []TypeUnit {
+	text = strings.Join(strings.Fields(text), " ")
+	var spelled []TypeUnit
+	for _, tu := range found {
+		if strings.Join(strings.Fields(tu.Unit.Symbol()), " ") == text ||
+			strings.EqualFold(strings.Join(strings.Fields(tu.Unit.Name()), " "), text) {
+			spelled = append(spelled, tu)
+		}
+	}
+	return spelled
+}
@@ -0,0 +1,75 @@
+package units
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParseUnitExpression(t *testing.T) {
+	tests := []struct {
+		expr   string
+		factor string
+		dim    Dimension
+		unit   Unit
+	}{
+		{"lb/min", "45359237/6000000000", Dimension{Mass: 1, Time: -1}, PoundsPerMinuteMassFlowUnit},
+		{"m^3/s", "1", Dimension{Length: 3, Time: -1}, CubicMetersPerSecondFlowUnit},
+		{"m3 / s", "1", Dimension{Length: 3, Time: -1}, CubicMetersPerSecondFlowUnit},
+		{"ft³/d", "2048383/6250000000000", Dimension{Length: 3, Time: -1}, nil},
+		{"kg·m/s²", "1", Dimension{Mass: 1, Length: 1, Time: -2}, NewtonsForceUnit},
+		{"kg m s⁻²", "1", Dimension{Mass: 1, Length: 1, Time: -2}, NewtonsForceUnit},
+		{"N*m", "1", Dimension{Mass: 1, Length: 2, Time: -2}, JoulesWorkUnit},
+		{"in lbf", "1129848290276167/10000000000000000", Dimension{Mass: 1, Length: 2, Time: -2}, InchPoundsForceWorkUnit},
+		{"kJ/(kg·°C)", "1000", Dimension{Length: 2, Time: -2, Temperature: -1}, nil},
+		{"°C", "1", Dimension{Temperature: 1}, DegreesCelsiusTemperatureDifferenceUnit},
+		{"°F", "5/9", Dimension{Temperature: 1}, DegreesFahrenheitTemperatureDifferenceUnit},
+		{"K", "1", Dimension{Temperature: 1}, DegreesCelsiusTemperatureDifferenceUnit},
+		{"J/(kg·K)", "1", Dimension{Length: 2, Time: -2, Temperature: -1}, nil},
+		{"J/kg·K", "1", Dimension{Length: 2, Time: -2, Temperature: 1}, nil},
+		{"°F/min", "1/108", Dimension{Temperature: 1, Time: -1}, nil},
+		{"m m", "1", Dimension{Length: 2}, nil},
+		{"m  m", "1", Dimension{Length: 2}, nil},
+		{"%", "1/100", Dimension{}, PercentPercentageUnit},
+		{"%/min", "1/6000", Dimension{Time: -1}, nil},
+		{"km^16", "1000000000000000000000000000000000000000000000000", Dimension{Length: 16}, nil},
+		{"s⁻¹⁶", "1", Dimension{Time: -16}, nil},
+	}
+	for _, tc := range tests {
+		e, err := ParseUnitExpression(tc.expr)
+		if err != nil {
+			t.Errorf("ParseUnitExpression(%q): %v", tc.expr, err)
+			continue
+		}
+		if e.Factor.RatString() != tc.factor || e.Dimension != tc.dim {
+			t.Errorf("ParseUnitExpression(%q) = %s %s, want %s %s", tc.expr, e.Factor.RatString(), e.Dimension, tc.factor, tc.dim)
+		}
+		if u, ok := e.Unit(); u != tc.unit && (ok || tc.unit != nil) {
+			t.Errorf("ParseUnitExpression(%q).Unit() = %v", tc.expr, u)
+		}
+	}
+
+	e, err := ParseUnitExpression("bbl/d")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, err := e.Convert(1440, BarrelsPerMinuteFlowUnit); err != nil || math.Abs(v-1) > 1e-12 {
+		t.Errorf("1440 bbl/d = %v bbl/min, %v", v, err)
+	}
+	if e, err := ParseUnitExpression("%"); err != nil {
+		t.Error(err)
+	} else if v, err := e.Convert(50, PercentPercentageUnit); err != nil || math.Abs(v-50) > 1e-12 {
+		t.Errorf("50 %% = %v %%, %v", v, err)
+	}
+	var mismatch *ExpressionMismatchError
+	if _, err := e.Convert(1, BarrelsOfOilVolumeUnit); !errors.As(err, &mismatch) {
+		t.Errorf("converting bbl/d to bbl = %v", err)
+	}
+
+	for _, expr := range []string{"", "m/", "(m", "m^", "zz/s", "lbs", "m)", "mPa", "mJ", "ft^17", "ft^20000", "s⁻¹⁷", "m²⁰⁰⁰⁰", "m17", "m99999999999999999999"} {
+		var parseErr *ExpressionError
+		if _, err := ParseUnitExpression(expr); !errors.As(err, &parseErr) {
+			t.Errorf("ParseUnitExpression(%q) = %v", expr, err)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package units
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// QuantityFlag is a flag.Value for command-line options such as
+// --max-pressure=150psi or --min-temp=-10°F. The unit suffix is optional
+// and is looked up in the flag's UnitType; plain numbers are read in the
+// default unit. Eg.:
+//
+//	maxPressure := units.NewQuantityFlag(units.PressureUnitType, units.PoundsPerSquareInchPressureUnit)
+//	flag.Var(maxPressure, "max-pressure", "pressure limit")
+type QuantityFlag struct {
+	Type     UnitType
+	Default  Unit
+	Quantity Quantity
+}
+
+// NewQuantityFlag returns a QuantityFlag of typeOf holding zero of def
+func NewQuantityFlag(typeOf UnitType, def Unit) *QuantityFlag {
+	return &QuantityFlag{Type: typeOf, Default: def, Quantity: Quantity{Unit: def}}
+}
+
+// Set implements flag.Value
+func (f *QuantityFlag) Set(input string) error {
+	value, unit, err := splitNumber(input)
+	if err != nil {
+		return err
+	}
+
+	u := f.Default
+	if unit != "" {
+		if u, err = LookupUnit(unit, f.Type); err != nil {
+			return fmt.Errorf("units: parsing %q: %w", input, err)
+		}
+	}
+	if u == nil {
+		return fmt.Errorf("units: parsing %q: missing unit", input)
+	}
+
+	f.Quantity = Quantity{Value: value, Unit: u}
+	return nil
+}
+
+// String implements flag.Value. The unit is written so that Set reads it
+// back, eg. "150 psi"; it's left off when it's the default and has no symbol.
+func (f *QuantityFlag) String() string {
+	if f == nil || f.Quantity.Unit == nil {
+		return ""
+	}
+
+	value := strconv.FormatFloat(f.Quantity.Value, 'g', -1, 64)
+	u := f.Quantity.Unit
+	if suffix := u.Symbol(); suffix != "" {
+		return value + " " + suffix
+	}
+	if u == f.Default {
+		return value
+	}
+	for _, m := range u.MatchList() {
+		if m != "*" {
+			return value + " " + m
+		}
+	}
+	return value
+}
+
+// Get implements flag.Getter, returning the Quantity
+func (f *QuantityFlag) Get() interface{} {
+	return f.Quantity
+}
+
+// In returns the value converted to u
+func (f *QuantityFlag) In(u Unit) (float64, error) {
+	return Convert(f.Quantity.Value, f.Quantity.Unit, u)
+}
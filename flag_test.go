@@ -0,0 +1,121 @@
+package units
+
+import (
+	"errors"
+	"flag"
+	"io"
+	"math"
+	"testing"
+)
+
+func TestQuantityFlagSet(t *testing.T) {
+	tests := map[string]Quantity{
+		"150":      NewQuantity(150, PoundsPerSquareInchPressureUnit),
+		"150psi":   NewQuantity(150, PoundsPerSquareInchPressureUnit),
+		"12.5 kPa": NewQuantity(12.5, KilopascalsPressureUnit),
+		"1.2e3 Pa": NewQuantity(1200, PascalsPressureUnit),
+		"-3 MPa":   NewQuantity(-3, MegapascalsPressureUnit),
+	}
+	for input, want := range tests {
+		f := NewQuantityFlag(PressureUnitType, PoundsPerSquareInchPressureUnit)
+		if err := f.Set(input); err != nil || f.Quantity != want {
+			t.Errorf("Set(%q) = %v, %v, want %v", input, f.Quantity, err, want)
+		}
+	}
+
+	var unknown *ErrUnknownUnit
+	f := NewQuantityFlag(PressureUnitType, PoundsPerSquareInchPressureUnit)
+	if err := f.Set("10 °F"); !errors.As(err, &unknown) {
+		t.Errorf("Set(10 °F) = %v, want an *ErrUnknownUnit", err)
+	}
+	for _, input := range []string{"", "psi", "ten psi"} {
+		if err := f.Set(input); err == nil {
+			t.Errorf("Set(%q) = %v, want an error", input, f.Quantity)
+		}
+	}
+	if f.Quantity != NewQuantity(0, PoundsPerSquareInchPressureUnit) {
+		t.Errorf("failed Sets changed the flag to %v", f.Quantity)
+	}
+
+	noDefault := &QuantityFlag{Type: PressureUnitType}
+	if err := noDefault.Set("150"); err == nil {
+		t.Errorf("Set(150) without a default = %v, want an error", noDefault.Quantity)
+	}
+}
+
+func TestQuantityFlagString(t *testing.T) {
+	tests := []struct {
+		typeOf UnitType
+		def    Unit
+		input  string
+		want   string
+	}{
+		{PressureUnitType, PoundsPerSquareInchPressureUnit, "150", "150 psi"},
+		{PressureUnitType, PoundsPerSquareInchPressureUnit, "12.5kPa", "12.5 kPa"},
+		{TemperatureUnitType, DegreesCelsiusTemperatureUnit, "-10°F", "-10 °F"},
+		{NumberUnitType, NumberNumberUnit, "42", "42"},
+	}
+	for _, tc := range tests {
+		f := NewQuantityFlag(tc.typeOf, tc.def)
+		if err := f.Set(tc.input); err != nil {
+			t.Errorf("Set(%q): %v", tc.input, err)
+			continue
+		}
+		if got := f.String(); got != tc.want {
+			t.Errorf("Set(%q).String() = %q, want %q", tc.input, got, tc.want)
+		}
+
+		again := NewQuantityFlag(tc.typeOf, tc.def)
+		if err := again.Set(f.String()); err != nil || again.Quantity != f.Quantity {
+			t.Errorf("Set(%q) = %v, %v, want %v", f.String(), again.Quantity, err, f.Quantity)
+		}
+	}
+
+	var nilFlag *QuantityFlag
+	if got := nilFlag.String(); got != "" {
+		t.Errorf("nil String() = %q", got)
+	}
+}
+
+func TestQuantityFlagIn(t *testing.T) {
+	f := NewQuantityFlag(PressureUnitType, PoundsPerSquareInchPressureUnit)
+	if err := f.Set("2 MPa"); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := f.In(KilopascalsPressureUnit); err != nil || math.Abs(v-2000) > 1e-9 {
+		t.Errorf("2 MPa In kPa = %v, %v", v, err)
+	}
+	var mismatch *TypeMismatchError
+	if _, err := f.In(MetersLengthUnit); !errors.As(err, &mismatch) {
+		t.Errorf("2 MPa In m = %v, want a TypeMismatchError", err)
+	}
+	if got, ok := f.Get().(Quantity); !ok || got != NewQuantity(2, MegapascalsPressureUnit) {
+		t.Errorf("Get() = %v", f.Get())
+	}
+}
+
+func TestQuantityFlagParse(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	maxPressure := NewQuantityFlag(PressureUnitType, PoundsPerSquareInchPressureUnit)
+	minTemp := NewQuantityFlag(TemperatureUnitType, DegreesCelsiusTemperatureUnit)
+	fs.Var(maxPressure, "max-pressure", "pressure limit")
+	fs.Var(minTemp, "min-temp", "temperature limit")
+
+	if err := fs.Parse([]string{"--max-pressure=150psi", "-min-temp", "-10°F", "rest"}); err != nil {
+		t.Fatal(err)
+	}
+	if maxPressure.Quantity != NewQuantity(150, PoundsPerSquareInchPressureUnit) {
+		t.Errorf("max-pressure = %v", maxPressure.Quantity)
+	}
+	if minTemp.Quantity != NewQuantity(-10, DegreesFahrenheitTemperatureUnit) {
+		t.Errorf("min-temp = %v", minTemp.Quantity)
+	}
+	if fs.NArg() != 1 || fs.Arg(0) != "rest" {
+		t.Errorf("args = %v", fs.Args())
+	}
+
+	if err := fs.Parse([]string{"--max-pressure=150 m"}); err == nil {
+		t.Error("Parse(--max-pressure=150 m) succeeded")
+	}
+}
@@ -0,0 +1,319 @@
+package units
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Precision selects how many digits Format writes, see FormatOptions
+type Precision int
+
+const (
+	// FormatShortest writes the fewest digits which read back as the same
+	// float64, without an exponent unless FormatOptions.Engineering is set or
+	// the value is below 1e-6 or from 1e21 on, eg. "1e-300" or "1.5e21".
+	// FormatDecimals and FormatSignificant write an exponent for those too.
+	FormatShortest Precision = iota
+	// FormatDecimals writes FormatOptions.Digits digits after the decimal
+	// point, or after the first digit when there's an exponent, eg. "1.50e300"
+	FormatDecimals
+	// FormatSignificant rounds to FormatOptions.Digits significant figures
+	FormatSignificant
+)
+
+// FormatOptions controls how Format writes a value and its unit. The zero
+// value writes the shortest number, a space and the unit symbol, eg. "12.5 psi".
+type FormatOptions struct {
+	Precision Precision
+	// Digits is the number of decimals or significant figures, see Precision
+	Digits int
+	// Engineering writes an exponent which is a multiple of 3, eg. "12.5e3",
+	// and applies Precision to the number in front of it
+	Engineering bool
+	// Grouping separates each group of three digits before the decimal point,
+	// eg. "," for "3,051.5". Digits aren't grouped when it's empty.
+	Grouping string
+	// Name writes the unit's name rather than its symbol, eg. "2 Pounds"
+	Name bool
+	// Separator goes between the number and the unit, a space when empty.
+	// Percentages are always written straight after the number, eg. "12%".
+	Separator string
+	// NoSeparator writes the unit straight after the number, eg. "5psi",
+	// ignoring Separator
+	NoSeparator bool
+}
+
+// Format writes value of u according to opts. Units without a symbol, such
+// as Number, are written as the number alone. Names are singular when the
+// number is written as exactly 1, so "1 Pound" but "1.0 Pounds" and
+// "2 Pounds". A nil u writes the number alone.
+func Format(value float64, u Unit, opts FormatOptions) string {
+	number := formatNumber(value, opts)
+	if u == nil || u.Symbol() == "" {
+		return number
+	}
+
+	if !opts.Name {
+		if u.Symbol() == "%" {
+			return number + "%"
+		}
+		return number + formatSeparator(opts) + u.Symbol()
+	}
+
+	name := u.Name()
+	if u.Symbol() != "%" {
+		name = inflect(name, number == "1" || number == "-1")
+	}
+	return number + formatSeparator(opts) + name
+}
+
+// Format is Format for the value and unit of q
+func (q Quantity) Format(opts FormatOptions) string {
+	return Format(q.Value, q.Unit, opts)
+}
+
+func formatSeparator(opts FormatOptions) string {
+	if opts.NoSeparator {
+		return ""
+	}
+	if opts.Separator == "" {
+		return " "
+	}
+	return opts.Separator
+}
+
+// formatNumber writes value according to the Precision, Engineering and
+// Grouping of opts
+func formatNumber(value float64, opts FormatOptions) string {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return strconv.FormatFloat(value, 'g', -1, 64)
+	}
+
+	var number, exponent string
+	switch {
+	case opts.Engineering:
+		number, exponent = formatEngineering(value, opts)
+	case opts.Precision == FormatDecimals:
+		if _, exp := scientific(value, -1); plainExponent(exp) {
+			number = strconv.FormatFloat(value, 'f', opts.Digits, 64)
+		} else {
+			number, exponent = exponential(scientific(value, opts.Digits))
+		}
+	case opts.Precision == FormatSignificant:
+		digits, exp := scientific(value, opts.Digits-1)
+		if plainExponent(exp) {
+			number = placePoint(digits, exp+1)
+		} else {
+			number, exponent = exponential(digits, exp)
+		}
+	default:
+		number, exponent = formatShortest(value)
+	}
+
+	negative := strings.HasPrefix(number, "-")
+	number = strings.TrimPrefix(number, "-")
+	if opts.Grouping != "" {
+		number = groupDigits(number, opts.Grouping)
+	}
+	// Don't write -0 when a small negative value rounds to zero
+	if negative && strings.Trim(number, "0.") != "" {
+		number = "-" + number
+	}
+	return number + exponent
+}
+
+// Numbers whose decimal exponent is between minPlainExponent and
+// maxPlainExponent, exclusive, are written without an exponent unless
+// FormatOptions.Engineering is set, as JavaScript does for the shortest
+const (
+	minPlainExponent = -7
+	maxPlainExponent = 21
+)
+
+// plainExponent is true when a number with the decimal exponent exp is
+// written without an exponent
+func plainExponent(exp int) bool {
+	return exp > minPlainExponent && exp < maxPlainExponent
+}
+
+// formatShortest returns the shortest number and exponent, eg. "e-300", of
+// value, leaving out the exponent when it's plainExponent
+func formatShortest(value float64) (string, string) {
+	digits, exp := scientific(value, -1)
+	if plainExponent(exp) {
+		return strconv.FormatFloat(value, 'f', -1, 64), ""
+	}
+	return exponential(digits, exp)
+}
+
+// exponential returns the number and exponent of the signed digits with the
+// decimal exponent exp, eg. "1.5" and "e21"
+func exponential(digits string, exp int) (string, string) {
+	return placePoint(digits, 1), "e" + strconv.Itoa(exp)
+}
+
+// formatEngineering returns the number and exponent, eg. "e3", of value in
+// engineering notation
+func formatEngineering(value float64, opts FormatOptions) (string, string) {
+	_, exp := scientific(value, -1)
+	exp3 := exp - ((exp%3)+3)%3
+
+	var number string
+	switch opts.Precision {
+	case FormatDecimals:
+		for {
+			number = strconv.FormatFloat(value/math.Pow10(exp3), 'f', opts.Digits, 64)
+			// Rounding can carry up to 1000, eg. 999.96 to 1 decimal
+			if n, _ := strconv.ParseFloat(number, 64); math.Abs(n) < 1000 {
+				break
+			}
+			exp3 += 3
+		}
+	case FormatSignificant:
+		digits, exp := scientific(value, opts.Digits-1)
+		exp3 = exp - ((exp%3)+3)%3
+		number = placePoint(digits, exp-exp3+1)
+	default:
+		digits, _ := scientific(value, -1)
+		number = placePoint(digits, exp-exp3+1)
+	}
+
+	if exp3 == 0 {
+		return number, ""
+	}
+	return number, "e" + strconv.Itoa(exp3)
+}
+
+// scientific returns the signed digits of value rounded to prec digits after
+// the first, or the shortest when prec is negative, and the decimal exponent
+// of the first digit
+func scientific(value float64, prec int) (string, int) {
+	if prec < -1 {
+		prec = 0
+	}
+	s := strconv.FormatFloat(value, 'e', prec, 64)
+	idx := strings.IndexByte(s, 'e')
+	exp, _ := strconv.Atoi(s[idx+1:])
+	return strings.Replace(s[:idx], ".", "", 1), exp
+}
+
+// placePoint writes the signed digits with the decimal point after the first
+// point of them, padding with zeros either side as needed
+func placePoint(digits string, point int) string {
+	sign := ""
+	if strings.HasPrefix(digits, "-") {
+		sign, digits = "-", digits[1:]
+	}
+	switch {
+	case point <= 0:
+		return sign + "0." + strings.Repeat("0", -point) + digits
+	case point >= len(digits):
+		return sign + digits + strings.Repeat("0", point-len(digits))
+	default:
+		return sign + digits[:point] + "." + digits[point:]
+	}
+}
+
+// groupDigits separates the unsigned number's whole digits into threes
+func groupDigits(number, sep string) string {
+	whole, fraction := number, ""
+	if idx := strings.IndexByte(number, '.'); idx >= 0 {
+		whole, fraction = number[:idx], number[idx:]
+	}
+
+	var b strings.Builder
+	for idx, r := range whole {
+		if idx > 0 && (len(whole)-idx)%3 == 0 {
+			b.WriteString(sep)
+		}
+		b.WriteRune(r)
+	}
+	return b.String() + fraction
+}
+
+// nameEnds mark the end of the part of a unit name which is inflected, eg.
+// only "Pounds" of "Pounds per Square Inch"
+var nameEnds = []string{" per ", " of ", " ("}
+
+// countWords count the rest of a name, which is left alone after them, eg.
+// "Thousand Cubic Feet per Day". In front of "of" they're made singular
+// without it, eg. "1 Thousand Cubic Feet" of "Thousands of Cubic Feet".
+var countWords = map[string]bool{"Hundred": true, "Thousand": true, "Million": true}
+
+// inflect returns the singular or plural of a unit name. Names are usually
+// declared in the plural, so the first plural word is made singular, eg.
+// "Inch-pound Force" from "Inch-pounds Force"; names without one are
+// singular and their last word is made plural, eg. "Gallons (U.S. Fluid)".
+// Only the part of the name before any "per", "of" or bracket is changed.
+func inflect(name string, singular bool) string {
+	head, tail := name, ""
+	for _, end := range nameEnds {
+		if idx := strings.Index(head, end); idx >= 0 {
+			head, tail = name[:idx], name[idx:]
+		}
+	}
+
+	start := 0
+	for idx := 0; idx <= len(head); idx++ {
+		if idx < len(head) && head[idx] != ' ' && head[idx] != '-' {
+			continue
+		}
+		word := head[start:idx]
+		if countWords[word] {
+			return name
+		}
+		if one := singularWord(word); one != word {
+			if !singular {
+				return name
+			}
+			if countWords[one] && strings.HasPrefix(tail, " of ") {
+				tail = tail[len(" of"):]
+			}
+			return head[:start] + one + head[idx:] + tail
+		}
+		start = idx + 1
+	}
+
+	if singular {
+		return name
+	}
+	last := strings.LastIndexAny(head, " -") + 1
+	return head[:last] + pluralWord(head[last:]) + tail
+}
+
+// irregularPlurals are the plurals which pluralWord and singularWord can't
+// work out
+var irregularPlurals = map[string]string{"Foot": "Feet", "foot": "feet"}
+
+// singularWord returns the singular of a plural word, or the word itself when
+// it isn't plural
+func singularWord(word string) string {
+	for one, many := range irregularPlurals {
+		if word == many {
+			return one
+		}
+	}
+	for _, suffix := range []string{"ches", "shes", "sses", "xes"} {
+		if strings.HasSuffix(word, suffix) {
+			return word[:len(word)-2]
+		}
+	}
+	if strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss") && !strings.HasSuffix(word, "us") && len(word) > 1 {
+		return word[:len(word)-1]
+	}
+	return word
+}
+
+// pluralWord returns the plural of a singular word
+func pluralWord(word string) string {
+	if many, ok := irregularPlurals[word]; ok {
+		return many
+	}
+	for _, suffix := range []string{"ch", "sh", "s", "x"} {
+		if strings.HasSuffix(word, suffix) {
+			return word + "es"
+		}
+	}
+	return word + "s"
+}
@@ -0,0 +1,64 @@
+package units
+
+import "testing"
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		value float64
+		unit  Unit
+		opts  FormatOptions
+		want  string
+	}{
+		{12.5, PoundsPerSquareInchPressureUnit, FormatOptions{}, "12.5 psi"},
+		{3051.456, PoundsPerSquareInchPressureUnit, FormatOptions{Precision: FormatDecimals, Digits: 2, Grouping: ","}, "3,051.46 psi"},
+		{-1234567, PascalsPressureUnit, FormatOptions{Grouping: " "}, "-1 234 567 Pa"},
+		{0.00123456, MetersLengthUnit, FormatOptions{Precision: FormatSignificant, Digits: 3}, "0.00123 m"},
+		{123456, MetersLengthUnit, FormatOptions{Precision: FormatSignificant, Digits: 2}, "120000 m"},
+		{12500, PascalsPressureUnit, FormatOptions{Engineering: true}, "12.5e3 Pa"},
+		{0.0000047, SecondsTimeUnit, FormatOptions{Engineering: true, Precision: FormatSignificant, Digits: 3}, "4.70e-6 s"},
+		{999.96, PascalsPressureUnit, FormatOptions{Engineering: true, Precision: FormatDecimals, Digits: 1}, "1.0e3 Pa"},
+		{-0.001, PascalsPressureUnit, FormatOptions{Precision: FormatDecimals, Digits: 1}, "0.0 Pa"},
+		{150, PoundsPerSquareInchPressureUnit, FormatOptions{Separator: " "}, "150 psi"},
+		{5, PoundsPerSquareInchPressureUnit, FormatOptions{NoSeparator: true}, "5psi"},
+		{5, PoundsMassUnit, FormatOptions{Name: true, NoSeparator: true, Separator: "-"}, "5Pounds"},
+		{1e-300, MetersLengthUnit, FormatOptions{}, "1e-300 m"},
+		{-2.5e-7, MetersLengthUnit, FormatOptions{}, "-2.5e-7 m"},
+		{0.000001, MetersLengthUnit, FormatOptions{}, "0.000001 m"},
+		{1.5e21, PascalsPressureUnit, FormatOptions{Grouping: ","}, "1.5e21 Pa"},
+		{123456789e20, PascalsPressureUnit, FormatOptions{Grouping: ","}, "1.23456789e28 Pa"},
+		{1e20, PascalsPressureUnit, FormatOptions{Grouping: ","}, "100,000,000,000,000,000,000 Pa"},
+		{0, PascalsPressureUnit, FormatOptions{}, "0 Pa"},
+		{0, PascalsPressureUnit, FormatOptions{Precision: FormatSignificant, Digits: 2}, "0.0 Pa"},
+		{1e300, MetersLengthUnit, FormatOptions{}, "1e300 m"},
+		{1.5e300, MetersLengthUnit, FormatOptions{Precision: FormatSignificant, Digits: 3}, "1.50e300 m"},
+		{-1e-300, MetersLengthUnit, FormatOptions{Precision: FormatSignificant, Digits: 2}, "-1.0e-300 m"},
+		{1.5e300, MetersLengthUnit, FormatOptions{Precision: FormatDecimals, Digits: 2}, "1.50e300 m"},
+		{1e-300, MetersLengthUnit, FormatOptions{Precision: FormatDecimals, Digits: 1, Grouping: ","}, "1.0e-300 m"},
+		{123456, MetersLengthUnit, FormatOptions{Precision: FormatDecimals, Digits: 1, Grouping: ","}, "123,456.0 m"},
+		{12, PercentPercentageUnit, FormatOptions{}, "12%"},
+		{12, PercentHumidityUnit, FormatOptions{Name: true}, "12 Percent"},
+		{42, NumberNumberUnit, FormatOptions{Name: true}, "42"},
+		{1, PoundsMassUnit, FormatOptions{Name: true}, "1 Pound"},
+		{2, PoundsMassUnit, FormatOptions{Name: true}, "2 Pounds"},
+		{1, PoundsMassUnit, FormatOptions{Name: true, Precision: FormatDecimals, Digits: 1}, "1.0 Pounds"},
+		{1, PoundsPerSquareInchPressureUnit, FormatOptions{Name: true}, "1 Pound per Square Inch"},
+		{1, DegreesCelsiusTemperatureUnit, FormatOptions{Name: true}, "1 Degree Celsius"},
+		{1, InchesOfWaterPressureUnit, FormatOptions{Name: true}, "1 Inch of Water"},
+		{1, FeetLengthUnit, FormatOptions{Name: true}, "1 Foot"},
+		{1, InchPoundsForceWorkUnit, FormatOptions{Name: true}, "1 Inch-pound Force"},
+		{1, GallonUSFluidVolumeUnit, FormatOptions{Name: true}, "1 Gallon (U.S. Fluid)"},
+		{3, GallonUSFluidVolumeUnit, FormatOptions{Name: true}, "3 Gallons (U.S. Fluid)"},
+		{1, ThousandsOfCubicFeetVolumeUnit, FormatOptions{Name: true}, "1 Thousand Cubic Feet"},
+		{1, ThousandCubicFeetPerDayFlowUnit, FormatOptions{Name: true}, "1 Thousand Cubic Feet per Day"},
+		{2.5, nil, FormatOptions{}, "2.5"},
+	}
+	for _, tc := range tests {
+		if got := Format(tc.value, tc.unit, tc.opts); got != tc.want {
+			t.Errorf("Format(%v, %v, %+v) = %q, want %q", tc.value, tc.unit, tc.opts, got, tc.want)
+		}
+	}
+
+	if got := NewQuantity(2, KilogramsMassUnit).Format(FormatOptions{Name: true}); got != "2 Kilograms" {
+		t.Errorf("Quantity.Format = %q", got)
+	}
+}
@@ -0,0 +1,3 @@
+package units
+
+//go:generate go run ./generate
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/AlakaCore/units/internal/schema"
+	"gopkg.in/yaml.v3"
+)
+
+// locator finds where matches are declared in the yaml files so problems
+// can be reported as path:line:column. Definitions are numbered across
+// every file in the order they're loaded.
+//
+// The definitions themselves are decoded with yaml.v2, as the runtime
+// Registry does, but it has no node API with line numbers, so the files are
+// parsed a second time with yaml.v3 for those.
+type locator struct {
+	paths       map[int]string
+	typeMatches map[[2]int]*yaml.Node
+	unitMatches map[unitMatchKey]*yaml.Node
+	prefixes    map[prefixKey]*yaml.Node
+}
+
+// unitMatchKey is a unit's match, by the unit's name as units with prefixes
+// are expanded before matches are checked
+type unitMatchKey struct {
+	def   int
+	unit  string
+	match int
+}
+
+// prefixKey is one of the prefixes of a unit, which is where the matches of
+// the unit it makes are reported
+type prefixKey struct {
+	def    int
+	unit   string
+	prefix string
+}
+
+func newLocator() *locator {
+	return &locator{
+		paths:       map[int]string{},
+		typeMatches: map[[2]int]*yaml.Node{},
+		unitMatches: map[unitMatchKey]*yaml.Node{},
+		prefixes:    map[prefixKey]*yaml.Node{},
+	}
+}
+
+// add indexes the match scalars of root, the parsed yaml file at path, whose
+// first definition is number offset
+func (l *locator) add(path string, root *yaml.Node, offset int) {
+	if root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		root = root.Content[0]
+	}
+
+	for idx, d := range mappingValue(root, "definitions").Content {
+		di := offset + idx
+		l.paths[di] = path
+		for mi, m := range mappingValue(d, "matches").Content {
+			l.typeMatches[[2]int{di, mi}] = m
+		}
+		for _, u := range mappingValue(d, "units").Content {
+			name := mappingValue(u, "name").Value
+			for mi, m := range mappingValue(u, "matches").Content {
+				l.unitMatches[unitMatchKey{di, name, mi}] = m
+			}
+			for _, p := range mappingValue(u, "prefixes").Content {
+				l.prefixes[prefixKey{di, name, p.Value}] = p
+			}
+		}
+	}
+}
+
+// mappingValue returns the value of key in the mapping n, or an empty node
+func mappingValue(n *yaml.Node, key string) *yaml.Node {
+	if n != nil && n.Kind == yaml.MappingNode {
+		for idx := 0; idx+1 < len(n.Content); idx += 2 {
+			if n.Content[idx].Value == key {
+				return n.Content[idx+1]
+			}
+		}
+	}
+	return &yaml.Node{}
+}
+
+func (l *locator) at(di int, n *yaml.Node) string {
+	if n == nil {
+		return l.paths[di]
+	}
+	return fmt.Sprintf("%s:%d:%d", l.paths[di], n.Line, n.Column)
+}
+
+// typeMatch returns the location of match mi of definition di
+func (l *locator) typeMatch(di, mi int) string {
+	return l.at(di, l.typeMatches[[2]int{di, mi}])
+}
+
+// unitMatch returns the location of match mi of u, a unit of definition di.
+// Every match of a prefixed unit is located at its prefix.
+func (l *locator) unitMatch(di int, u *schema.Unit, mi int) string {
+	if u.PrefixOf != "" {
+		return l.prefix(di, u)
+	}
+	return l.at(di, l.unitMatches[unitMatchKey{di, u.Name, mi}])
+}
+
+// prefix returns the location of the prefix which made u, or of the file
+// when u isn't prefixed
+func (l *locator) prefix(di int, u *schema.Unit) string {
+	return l.at(di, l.prefixes[prefixKey{di, u.PrefixOf, u.Prefix}])
+}
+
+// claim is a match string declared by a unit type or unit
+type claim struct {
+	owner    string
+	location string
+}
+
+// checkMatches returns an error listing every match string, or exact match,
+// which is declared twice within a unit type, since the generated lookup switches wouldn't
+// compile, and a warning for every unit match shared between unit types.
+// Unit type matches share one switch so any duplicate is an error. Types
+// with copyUnits are skipped as their units are their parent's.
+//
+// Matches are checked once prefixes are expanded, so a prefixed unit can
+// clash too. Exact matches are looked up first, so one which is the same as
+// another unit's match, ignoring case, shadows it and is an error as well.
+func checkMatches(uy *schema.UnitsYaml, loc *locator) (warnings []string, err error) {
+	var errs []string
+	typeClaims := map[string]claim{}
+	unitClaims := map[string][]claim{}
+
+	for di := range uy.Definitions {
+		d := &uy.Definitions[di]
+		for mi, m := range d.Matches {
+			here := claim{owner: d.Type, location: loc.typeMatch(di, mi)}
+			if prev, ok := typeClaims[m]; ok {
+				errs = append(errs, fmt.Sprintf("%s: unit type match %q of %s is already declared by %s at %s",
+					here.location, m, here.owner, prev.owner, prev.location))
+				continue
+			}
+			typeClaims[m] = here
+		}
+
+		if d.CopyUnits != nil {
+			continue
+		}
+		claims := map[string]claim{}
+		exact := map[string]claim{}
+		for ui := range d.Units {
+			u := &d.Units[ui]
+			for _, m := range u.ExactMatches {
+				here := claim{owner: d.Type + " -> " + u.Name, location: loc.prefix(di, u)}
+				if prev, ok := exact[m]; ok {
+					errs = append(errs, fmt.Sprintf("%s: exact match %q of %s is already declared by %s at %s",
+						here.location, m, here.owner, prev.owner, prev.location))
+					continue
+				}
+				exact[m] = here
+			}
+			for mi, m := range u.Matches {
+				here := claim{owner: d.Type + " -> " + u.Name, location: loc.unitMatch(di, u, mi)}
+				if prev, ok := claims[m]; ok {
+					errs = append(errs, fmt.Sprintf("%s: match %q of %s is already declared by %s at %s",
+						here.location, m, here.owner, prev.owner, prev.location))
+					continue
+				}
+				claims[m] = here
+				unitClaims[m] = append(unitClaims[m], here)
+			}
+		}
+
+		for ui := range d.Units {
+			for _, m := range d.Units[ui].ExactMatches {
+				e := exact[m]
+				if c, ok := claims[fold(m)]; ok && c.owner != e.owner {
+					errs = append(errs, fmt.Sprintf("%s: match %q of %s is shadowed by exact match %q of %s at %s",
+						c.location, fold(m), c.owner, m, e.owner, e.location))
+				}
+			}
+		}
+	}
+
+	var shared []string
+	for m, claims := range unitClaims {
+		if len(claims) > 1 {
+			shared = append(shared, m)
+		}
+	}
+	sort.Strings(shared)
+	for _, m := range shared {
+		var others []string
+		for _, c := range unitClaims[m][1:] {
+			others = append(others, fmt.Sprintf("%s at %s", c.owner, c.location))
+		}
+		first := unitClaims[m][0]
+		warnings = append(warnings, fmt.Sprintf("%s: warning: match %q of %s is also declared by %s",
+			first.location, m, first.owner, strings.Join(others, ", ")))
+	}
+
+	if len(errs) > 0 {
+		return warnings, fmt.Errorf("duplicate matches:\n%s", strings.Join(errs, "\n"))
+	}
+	return warnings, nil
+}
+
+// fold is how the generated SanitizeString compares a match
+func fold(match string) string {
+	return strings.ToLower(strings.Join(strings.Fields(match), ""))
+}
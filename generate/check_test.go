@@ -0,0 +1,133 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/AlakaCore/units/internal/schema"
+	yaml2 "gopkg.in/yaml.v2"
+	"gopkg.in/yaml.v3"
+)
+
+// checkYaml runs checkMatches over source as load does, as if it were read
+// from test.yaml
+func checkYaml(t *testing.T, source string) ([]string, error) {
+	t.Helper()
+	var uy schema.UnitsYaml
+	if err := yaml2.Unmarshal([]byte(source), &uy); err != nil {
+		t.Fatal(err)
+	}
+	var root yaml.Node
+	if err := yaml.Unmarshal([]byte(source), &root); err != nil {
+		t.Fatal(err)
+	}
+	loc := newLocator()
+	loc.add("test.yaml", &root, 0)
+	if err := uy.ExpandPrefixes(); err != nil {
+		t.Fatal(err)
+	}
+	return checkMatches(&uy, loc)
+}
+
+func TestCheckMatches(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  string
+		err     string
+		warning string
+	}{
+		{
+			name: "duplicate unit match",
+			source: `
+definitions:
+  - type: Length
+    units:
+      - name: Meters
+        matches: [m, meters]
+      - name: Miles
+        matches: [mi, m]
+`,
+			err: `test.yaml:8:23: match "m" of Length -> Miles is already declared by Length -> Meters at test.yaml:6:19`,
+		},
+		{
+			name: "duplicate type match",
+			source: `
+definitions:
+  - type: Length
+    matches: [length]
+  - type: Distance
+    matches: [distance, length]
+`,
+			err: `test.yaml:6:25: unit type match "length" of Distance is already declared by Length at test.yaml:4:15`,
+		},
+		{
+			name: "shared between types",
+			source: `
+definitions:
+  - type: Mass
+    units:
+      - name: Pounds
+        matches: [lbs]
+  - type: Mass Flow
+    units:
+      - name: Pounds per Second
+        matches: [lbs, lbps]
+`,
+			warning: `test.yaml:6:19: warning: match "lbs" of Mass -> Pounds is also declared by Mass Flow -> Pounds per Second at test.yaml:10:19`,
+		},
+		{
+			name: "prefixed match",
+			source: `
+definitions:
+  - type: Length
+    units:
+      - name: Meters
+        symbol: m
+        prefixes: [k]
+        matches: [m, meters]
+      - name: Kilometres
+        matches: [km, kilometers]
+`,
+			err: `test.yaml:10:23: match "kilometers" of Length -> Kilometres is already declared by Length -> Kilometers at test.yaml:7:20`,
+		},
+		{
+			name: "shadowed by an exact match",
+			source: `
+definitions:
+  - type: Pressure
+    units:
+      - name: Pascals
+        symbol: Pa
+        prefixes: [m, M]
+        matches: [pa]
+      - name: Megapounds
+        matches: [mpa]
+`,
+			err: `test.yaml:10:19: match "mpa" of Pressure -> Megapounds is shadowed by exact match "MPa" of Pressure -> Megapascals at test.yaml:7:23`,
+		},
+		{
+			name: "copies are skipped",
+			source: `
+definitions:
+  - type: Electric Potential
+    units:
+      - name: Volts
+        matches: [v]
+  - type: Electric Potential Loaded
+    copyUnits: Electric Potential
+`,
+		},
+	}
+	for _, tc := range tests {
+		warnings, err := checkYaml(t, tc.source)
+		switch {
+		case tc.err == "" && err != nil:
+			t.Errorf("%s: %v", tc.name, err)
+		case tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)):
+			t.Errorf("%s: checkMatches() = %v, want %s", tc.name, err, tc.err)
+		}
+		if got := strings.Join(warnings, "\n"); got != tc.warning {
+			t.Errorf("%s: warnings = %q, want %q", tc.name, got, tc.warning)
+		}
+	}
+}
@@ -1,26 +1,21 @@
 package main
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"flag"
 	"fmt"
+	"github.com/AlakaCore/units/internal/schema"
+	"go/format"
 	"gopkg.in/yaml.v2"
+	yaml3 "gopkg.in/yaml.v3"
 	"log"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
-	"time"
 )
 
-func title(x string) string {
-	tmp := strings.ReplaceAll(x, "-", " ")
-	r := strings.NewReplacer(".", "", "(", "", ")", "")
-	tmp = r.Replace(tmp)
-	components := strings.Split(tmp, " ")
-	result := ""
-	for _, component := range components {
-		result = result + strings.Title(component)
-	}
-	return result
-}
-
 func array(x []string, quote bool) string {
 	result := ""
 	for _, s := range x {
@@ -116,14 +111,6 @@ func tabOut(input string, times int) string {
 	return strings.Join(lines, "\n")
 }
 
-func conversionComponents(converter string) []string {
-	components := strings.Split(converter, "=>")
-	for idx, c := range components {
-		components[idx] = strings.TrimSpace(strings.ReplaceAll(c, ",", ""))
-	}
-	return components
-}
-
 func appendText(spaces int, to, format string, args ...interface{}) string {
 	next := fmt.Sprintf(format, args...)
 	f := "%s"
@@ -138,6 +125,15 @@ func appends(to, format string, args ...interface{}) string {
 	return appendText(2, to, format, args...)
 }
 
+// exactMatchCode is prepended to matchCode for units with exact matches
+var exactMatchCode = `exact := WhitespaceRegex.ReplaceAllString(check, "")
+for _, m := range x.ExactMatchList() {
+	if m == exact {
+		return true
+	}
+}
+`
+
 var matchCode = `check = SanitizeString(check)
 for _, m := range x.MatchList() {
 	if m == check || m == "*" {
@@ -146,49 +142,7 @@ for _, m := range x.MatchList() {
 }
 return false`
 
-type Unit struct {
-	Name     string   `yaml:"name"`
-	Symbol   string   `yaml:"symbol"`
-	FromBase string   `yaml:"fromBase"`
-	ToBase   string   `yaml:"toBase"`
-	Matches  []string `yaml:"matches"`
-}
-
-func (u *Unit) Title() string {
-	return title(u.Name)
-}
-
-func (u *Unit) StructName(defName string) string {
-	return u.Title() + defName
-}
-
-func (u *Unit) VarName(defName string) string {
-	return u.StructName(defName) + "Unit"
-}
-
-type Definition struct {
-	Type      string   `yaml:"type"`
-	BaseUnit  string   `yaml:"baseUnit"`
-	Matches   []string `yaml:"matches"`
-	Units     []Unit   `yaml:"units"`
-	CopyUnits *string  `yaml:"copyUnits"`
-	Base      Unit
-}
-
-func (d *Definition) StructName() string {
-	return title(d.Type)
-}
-
-func (d *Definition) VarName() string {
-	return d.StructName() + "UnitType"
-}
-
-type UnitsYaml struct {
-	Version     string       `yaml:"version"`
-	Definitions []Definition `yaml:"definitions"`
-}
-
-func (u *Unit) MakeGoCode(def *Definition) string {
+func makeGoUnit(u *schema.Unit, def *schema.Definition) string {
 	block := ""
 	name := u.StructName(def.StructName())
 
@@ -208,23 +162,21 @@ func (u *Unit) MakeGoCode(def *Definition) string {
 	block = appends(block, getter(name, "Title", u.Title(), "string", true))
 	block = appends(block, getter(name, "Name", u.Name, "string", true))
 	block = appends(block, getter(name, "Symbol", u.Symbol, "string", true))
-	fromComponents := conversionComponents(u.FromBase)
-	toComponents := conversionComponents(u.ToBase)
 	block = appends(block, fn(
 		name,
 		"FromBase",
-		fmt.Sprintf("return %s", fromComponents[1]),
+		fmt.Sprintf("return %s", u.From.Emit(schema.GoTarget(u.From.Var))),
 		"float64",
 		fmt.Sprintf("converts %s to %s", def.Base.Symbol, u.Symbol),
-		fmt.Sprintf("%s float64", fromComponents[0]),
+		fmt.Sprintf("%s float64", u.From.Var),
 	))
 	block = appends(block, fn(
 		name,
 		"ToBase",
-		fmt.Sprintf("return %s", toComponents[1]),
+		fmt.Sprintf("return %s", u.To.Emit(schema.GoTarget(u.To.Var))),
 		"float64",
 		fmt.Sprintf("converts %s to %s", u.Symbol, def.Base.Symbol),
-		fmt.Sprintf("%s float64", toComponents[0]),
+		fmt.Sprintf("%s float64", u.To.Var),
 	))
 
 	matches := array(u.Matches, true)
@@ -232,18 +184,35 @@ func (u *Unit) MakeGoCode(def *Definition) string {
 var %sMatchList = [...]string {%s}`, name, name, matches)
 	block = appends(block, getter(name, "MatchList", fmt.Sprintf(`%sMatchList[:]`, name), "[]string", false))
 
-	block = appends(block, fn(name, "Matches", matchCode, "bool", `returns true if check matches our possible names.
+	unitMatchCode := matchCode
+	if len(u.ExactMatches) > 0 {
+		block = appends(block, `// %sExactMatchList is effectively a constant
+var %sExactMatchList = [...]string {%s}`, name, name, array(u.ExactMatches, true))
+		block = appends(block, getter(name, "ExactMatchList", fmt.Sprintf(`%sExactMatchList[:]`, name), "[]string", false))
+		unitMatchCode = exactMatchCode + matchCode
+	} else {
+		block = appends(block, getter(name, "ExactMatchList", "nil", "[]string", false))
+	}
+
+	block = appends(block, fn(name, "Matches", unitMatchCode, "bool", `returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.`, "check string"))
 
 	block = appends(block, getter(name, "TypeOf", def.VarName(), "UnitType", false))
 	block = appends(block, getter(name, "Base", def.Base.VarName(def.StructName()), "Unit", false))
 
+	if u.Factor != nil {
+		block = appends(block, fn(name, "ExactFactor", fmt.Sprintf(`r, _ := new(big.Rat).SetString("%s")
+return r, true`, u.Factor.RatString()), "(*big.Rat, bool)", fmt.Sprintf("always returns %s exactly", u.Factor.RatString())))
+	} else {
+		block = appends(block, fn(name, "ExactFactor", "return nil, false", "(*big.Rat, bool)", "always returns false, the unit is affine"))
+	}
+
 	block = appends(block, `var %s %s = 0.0`, u.VarName(def.StructName()), name)
 	return block
 }
 
-func (u *Unit) MakeJsCode(def *Definition) string {
+func makeJsUnit(u *schema.Unit, def *schema.Definition) string {
 	block := ""
 	name := u.StructName(def.StructName())
 
@@ -259,34 +228,34 @@ func (u *Unit) MakeJsCode(def *Definition) string {
 // Unit.ToBase  : %-`+fmt.Sprintf("%d", longest)+`s = %s`,
 		name, def.StructName(), def.Base.StructName(def.StructName()), u.FromBase, u.Symbol, u.ToBase, def.Base.Symbol)
 
-	fromComponents := conversionComponents(u.FromBase)
-	toComponents := conversionComponents(u.ToBase)
+	fromTarget := schema.JsTarget(u.From.Var)
 	fromBase := tabOut(fnJs(
 		"fromBase",
-		fmt.Sprintf("return %s", fromComponents[1]),
+		fmt.Sprintf("return %s", u.From.Emit(fromTarget)),
 		"scalar",
 		fmt.Sprintf("converts %s to %s", def.Base.Symbol, u.Symbol),
-		fmt.Sprintf("%s: scalar", fromComponents[0]),
+		fmt.Sprintf("%s: scalar", fromTarget.Var),
 	), 1)
 
-	// in is a keyword in js
-	if toComponents[0] == "in" {
-		toComponents[0] = "inch"
-		toComponents[1] = "inch"
-	}
-
+	toTarget := schema.JsTarget(u.To.Var)
 	toBase := tabOut(fnJs(
 		"toBase",
-		fmt.Sprintf("return %s", toComponents[1]),
+		fmt.Sprintf("return %s", u.To.Emit(toTarget)),
 		"scalar",
 		fmt.Sprintf("converts %s to %s", u.Symbol, def.Base.Symbol),
-		fmt.Sprintf("%s: scalar", toComponents[0]),
+		fmt.Sprintf("%s: scalar", toTarget.Var),
 	), 1)
 
 	matches := array(u.Matches, true)
+	matcherCode := ""
+	if len(u.ExactMatches) > 0 {
+		matcherCode = `const exact = check.replace(WhitespaceRegex, '')
+if (this.exactMatchList.includes(exact)) return true
+`
+	}
 	matcher := tabOut(fnJs(
 		"matcher",
-		`check = sanitizeString(check)
+		matcherCode+`check = sanitizeString(check)
 for (const m of this.matchList) {
 	if (m === check || m === '*') return true
 }
@@ -320,6 +289,11 @@ return false`,
 	%s,
 	%s,
 	%s`, u.Title(), u.Name, u.Symbol, matches, def.VarName(), base, fromBase, toBase, matcher)
+	if len(u.ExactMatches) > 0 {
+		constructor += fmt.Sprintf(`,
+	// exactMatchList
+	[%s]`, array(u.ExactMatches, true))
+	}
 
 	block = appends(block, `export const %s = new Unit(
 	%s
@@ -328,7 +302,7 @@ return false`,
 	return block
 }
 
-func (d *Definition) MakeGoCode() string {
+func makeGoDefinition(d *schema.Definition) string {
 	block := ""
 	name := d.StructName()
 
@@ -376,6 +350,7 @@ func (d *Definition) MakeGoCode() string {
 	block = appends(block, getter(name, "Title", name, "string", true))
 	block = appends(block, getter(name, "Name", d.Type, "string", true))
 	block = appends(block, getter(name, "Base", d.Base.VarName(d.StructName()), "Unit", false))
+	block = appends(block, getter(name, "Dimension", d.Dimension.GoLiteral(), "Dimension", false))
 
 	block = appends(block, `// %sUnits is effectively a constant
 var %sUnits = [...]Unit {%s}`, name, name, uVars)
@@ -396,13 +371,13 @@ var %sMatchList = [...]string {%s}`, name, name, matches)
 	block = appends(block, `var %s %s = 0.0`, d.VarName(), name)
 
 	for _, u := range d.Units {
-		block = appends(block, u.MakeGoCode(d))
+		block = appends(block, makeGoUnit(&u, d))
 	}
 
 	return block
 }
 
-func (d *Definition) MakeJsCode() string {
+func makeJsDefinition(d *schema.Definition) string {
 	block := ""
 	name := d.StructName()
 
@@ -472,7 +447,7 @@ return false`,
 )`, d.VarName(), constructor)
 
 	for _, u := range d.Units {
-		block = appends(block, u.MakeJsCode(d))
+		block = appends(block, makeJsUnit(&u, d))
 	}
 
 	block = appends(block, `%s.base = %s`, d.VarName(), d.Base.VarName(d.StructName()))
@@ -481,8 +456,8 @@ return false`,
 	return block
 }
 
-func (uy *UnitsYaml) MakeGoFile() []byte {
-	file := `// Package units provides a standard way of working with unit for
+func makeGoFile(uy *schema.UnitsYaml, pkg, stamp string) []byte {
+	file := `// Package ` + pkg + ` provides a standard way of working with unit for
 // Alaka and Alakans alike. It's automatically generated via a
 // .yaml file with a format that makes it really easy to add new
 // units. Because we use code generation, we can provide functions
@@ -499,15 +474,23 @@ func (uy *UnitsYaml) MakeGoFile() []byte {
 // func AddPressure (p1, p2 PascalsPressure) PascalsPressure {
 //     returns p1 + p2
 // }
-package units
+//
+// Scalars of the generated types marshal as plain numbers, so they keep
+// their value in JSON and YAML. To write a unit or unit type itself, eg.
+// as a map key or in a config file, wrap it in AnyUnit or AnyUnitType,
+// which marshal to text, JSON and YAML as its AlakaTitle or Title, or
+// encode a Quantity to keep both the value and its unit.
+package ` + pkg + `
 
 import (
+    "fmt"
+    "math/big"
     "regexp"
     "strings"
 )`
 
-	file = appends(file, `// File autogenerated on %s.
-// Do not edit directly`, time.Now().String())
+	file = appends(file, `// File autogenerated from %s.
+// Do not edit directly`, stamp)
 
 	// Add the primary interfaces
 	file = appends(file, `// Unit represents a scalar type of unit which can be converted to and from a base 
@@ -542,6 +525,8 @@ type UnitType interface {
 	// Most of the time this is an SI unit, but not always (temperature is C,
 	// not K, for example)
 	Base() Unit
+	// Dimension returns the exponents of the SI base dimensions of this unit type
+	Dimension() Dimension
 	// Units returns all the supported units of this unit type
 	Units() []Unit
 	// UnitList returns all the supported units of this unit type as strings
@@ -565,14 +550,53 @@ return out`, "string", "removes whitespace and lower cases the string", "input s
 		"returns the Alaka string representing this particular unit and unit type combo",
 		"ut UnitType, u Unit"))
 
+	file = appends(file, "%s", `// ErrUnknownType is returned by LookupType when the input doesn't match any unit type
+type ErrUnknownType struct {
+	// Input is the sanitized string which failed to match
+	Input string
+}
+
+// Error implements the error interface
+func (e *ErrUnknownType) Error() string {
+	return fmt.Sprintf("units: unknown unit type %q", e.Input)
+}
+
+// ErrUnknownUnit is returned by LookupUnit and LookupTypeUnit when the input doesn't match any unit
+type ErrUnknownUnit struct {
+	// Input is the sanitized string which failed to match, or the AlakaTitle
+	// as given to LookupTypeUnit, which matches it exactly
+	Input string
+	// Type is the unit type that was searched, nil when searching by AlakaTitle
+	Type UnitType
+	// Suggestion is the symbol, or name, of the unit of Type closest to Input
+	// when the error was made, or empty when nothing is close
+	Suggestion string
+}
+
+// Error implements the error interface
+func (e *ErrUnknownUnit) Error() string {
+	if e.Type == nil {
+		return fmt.Sprintf("units: unknown unit %q", e.Input)
+	}
+	if e.Suggestion != "" {
+		return fmt.Sprintf("units: unknown %s unit %q; did you mean %q?", e.Type.Title(), e.Input, e.Suggestion)
+	}
+	return fmt.Sprintf("units: unknown %s unit %q", e.Type.Title(), e.Input)
+}`)
+
 	var allTypes []string
+	var allTypeVars []string
 	var allUnits []string
 	var allUnitTypes []string
 	// Provide a function for getting a unit and/or unit type
-	getTypeCode := `switch SanitizeString(input) {`
-	getUnitCode := `search := typeOf.Title() + "->" + SanitizeString(input)
-switch search {`
+	getTypeCode := `check := SanitizeString(input)
+switch check {`
+	exactUnitCode := `switch typeOf.Title() + "->" + WhitespaceRegex.ReplaceAllString(input, "") {`
+	getUnitCode := `check := SanitizeString(input)
+switch typeOf.Title() + "->" + check {`
 	getTypeUnitCode := `switch input {`
+	differenceTypeCode := `switch ut.Title() {`
+	decodeTypeCode := `switch string(text) {`
 
 	numberName := ""
 	numberUnitName := ""
@@ -590,10 +614,20 @@ switch search {`
 		}
 
 		allTypes = append(allTypes, d.StructName())
+		decodeTypeCode = appendText(1, decodeTypeCode, `case "%s":
+  return %s, nil`, d.StructName(), d.VarName())
+		allTypeVars = append(allTypeVars, d.VarName())
+
+		if d.DifferenceType != nil {
+			// load checked that it's declared
+			diff := uy.Definition(*d.DifferenceType)
+			differenceTypeCode = appendText(1, differenceTypeCode, `case "%s":
+  return %s`, d.StructName(), diff.VarName())
+		}
 
 		for _, match := range d.Matches {
 			getTypeCode = appendText(1, getTypeCode, `case "%s":
-  return %s`, match, d.VarName())
+  return %s, nil`, match, d.VarName())
 		}
 
 		unitMapWhitespace := " "
@@ -616,13 +650,17 @@ switch search {`
 			unitNames = append(unitNames, u.Title())
 			allUnitTypes = append(allUnitTypes, d.StructName()+"_"+u.Title())
 
+			for _, match := range u.ExactMatches {
+				exactUnitCode = appendText(1, exactUnitCode, `case "%s":
+  return %s, true`, d.StructName()+"->"+match, u.VarName(d.StructName()))
+			}
 			for _, match := range u.Matches {
 				getUnitCode = appendText(1, getUnitCode, `case "%s":
-  return %s`, d.StructName()+"->"+match, u.VarName(d.StructName()))
+  return %s, true`, d.StructName()+"->"+match, u.VarName(d.StructName()))
 			}
 
 			getTypeUnitCode = appendText(1, getTypeUnitCode, `case "%s":
-  return %s`, d.StructName()+"_"+u.Title(), fmt.Sprintf(`%s, %s`, d.VarName(), u.VarName(d.StructName())))
+  return %s, nil`, d.StructName()+"_"+u.Title(), fmt.Sprintf(`%s, %s`, d.VarName(), u.VarName(d.StructName())))
 		}
 
 		unitMap = fmt.Sprintf(`%s%s}`, unitMap, array(unitNames, true))
@@ -630,19 +668,31 @@ switch search {`
 
 	}
 	getTypeCode = appendText(1, getTypeCode, `default:
-  return %s
-}`, numberName)
+  return DefaultRegistry.LookupType(input)
+}`)
 	getUnitCode = appendText(1, getUnitCode, `default:
-  return %s
-}`, numberUnitName)
+  return DefaultRegistry.lookupUnit(input, typeOf)
+}`)
+	getUnitCode = appendText(1, exactUnitCode, "}\n%s", getUnitCode)
 	getTypeUnitCode = appendText(1, getTypeUnitCode, `default:
-  return %s, %s
-}`, numberName, numberUnitName)
+  return DefaultRegistry.LookupTypeUnit(input)
+}`)
+	differenceTypeCode = appendText(1, differenceTypeCode, `default:
+  return nil
+}`)
+	decodeTypeCode = appendText(1, decodeTypeCode, `default:
+  return DefaultRegistry.decodeUnitType(text)
+}`)
 
 	file = appends(file, `// AllTypes is a list of all available types below
 var AllTypes = [...]string{
     %s
 }`, arraySep(allTypes, true, "\n    "))
+	file = appends(file, `// UnitTypes is a list of all available unit types below,
+// in the same order as AllTypes
+var UnitTypes = [...]UnitType{
+    %s
+}`, arraySep(allTypeVars, false, "\n    "))
 	file = appends(file, `// AllUnits is a map of unit type -> units
 var AllUnits = map[string][]string{
 %s
@@ -656,27 +706,93 @@ var AllUnitTypes = [...]string{
 }`, arraySep(allUnitTypes, true, "\n    "))
 
 	file = appends(file, anonFn(
-		"GetType",
+		"LookupType",
 		getTypeCode,
+		"(UnitType, error)",
+		`returns the unit type which matches input or an *ErrUnknownType. Unit
+// types registered in DefaultRegistry are searched after the compiled-in ones`,
+		"input string"))
+	file = appends(file, anonFn(
+		"LookupUnit",
+		`if u, ok := findUnit(input, typeOf); ok {
+  return u, nil
+}
+return nil, DefaultRegistry.unknownUnit(input, typeOf)`,
+		"(Unit, error)",
+		`returns the unit of typeOf which matches input or an *ErrUnknownUnit.
+// Units registered in DefaultRegistry are searched after the compiled-in ones`,
+		"input string, typeOf UnitType"))
+	file = appends(file, anonFn(
+		"findUnit",
+		getUnitCode,
+		"(Unit, bool)",
+		"returns the unit of typeOf which matches input, or false",
+		"input string, typeOf UnitType"))
+	file = appends(file, anonFn(
+		"LookupTypeUnit",
+		getTypeUnitCode,
+		"(UnitType, Unit, error)",
+		`returns the unit type and unit which matches input or an *ErrUnknownUnit,
+// including those registered in DefaultRegistry. Opposite of AlakaTitle`,
+		"input string"))
+
+	file = appends(file, anonFn(
+		"GetType",
+		fmt.Sprintf(`ut, err := LookupType(input)
+if err != nil {
+  return %s
+}
+return ut`, numberName),
 		"UnitType",
 		fmt.Sprintf("returns the unit type which matches input or %s", numberName),
 		"input string"))
 	file = appends(file, anonFn(
 		"GetUnit",
-		getUnitCode,
+		fmt.Sprintf(`if u, ok := findUnit(input, typeOf); ok {
+  return u
+}
+return %s`, numberUnitName),
 		"Unit",
 		fmt.Sprintf("returns the unit which matches input or %s", numberUnitName),
 		"input string, typeOf UnitType"))
 	file = appends(file, anonFn(
 		"GetTypeUnit",
-		getTypeUnitCode,
+		fmt.Sprintf(`ut, u, err := LookupTypeUnit(input)
+if err != nil {
+  return %s, %s
+}
+return ut, u`, numberName, numberUnitName),
 		"(UnitType, Unit)",
 		fmt.Sprintf(`returns the unit type and unit which matches input or (%s, %s).
 // Opposite of AlakaTitle`, numberName, numberUnitName),
 		"input string"))
 
+	file = appends(file, anonFn(
+		"DifferenceType",
+		differenceTypeCode,
+		"UnitType",
+		`returns the unit type which measures differences between two values of ut,
+// or nil when ut is linear. Only unit types with an affine base, such as
+// Temperature, have a separate difference type.`,
+		"ut UnitType"))
+
+	file = appends(file, anonFn(
+		"DecodeUnitType",
+		decodeTypeCode,
+		"(UnitType, error)",
+		`returns the unit type whose Title is exactly text, as written by
+// AnyUnitType, or an *ErrUnknownType`,
+		"text []byte"))
+	file = appends(file, anonFn(
+		"DecodeUnit",
+		`_, u, err := LookupTypeUnit(string(text))
+return u, err`,
+		"(Unit, error)",
+		`returns the unit whose AlakaTitle is exactly text, as written by
+// AnyUnit, or an *ErrUnknownUnit`,
+		"text []byte"))
 	for _, d := range uy.Definitions {
-		file = appends(file, d.MakeGoCode())
+		file = appends(file, makeGoDefinition(&d))
 	}
 
 	// It's a pita to get % and use a lot of sprintf so we do that last replace here
@@ -685,7 +801,7 @@ var AllUnitTypes = [...]string{
 	return []byte(file)
 }
 
-func (uy *UnitsYaml) MakeJsFile() []byte {
+func makeJsFile(uy *schema.UnitsYaml, stamp string) []byte {
 	file := `// Package units provides a standard way of working with unit for
 // Alaka and Alakans alike. It's automatically generated via a
 // .yaml file with a format that makes it really easy to add new
@@ -694,8 +810,8 @@ func (uy *UnitsYaml) MakeJsFile() []byte {
 // of hand copying hundreds of methods across a bunch of permutations
 // of the same thing.`
 
-	file = appends(file, `// File autogenerated on %s.
-// Do not edit directly`, time.Now().String())
+	file = appends(file, `// File autogenerated from %s.
+// Do not edit directly`, stamp)
 
 	// Add the primary interfaces
 	file = appends(file, `// Helper Types
@@ -716,6 +832,9 @@ export class Unit {
 	public readonly symbol: string
 	// matchList is a list of matching strings which should represent this unit in userland
 	public readonly matchList: string[]
+	// exactMatchList is a list of matching strings which are compared case
+	// sensitively, such as prefixed symbols where "mPa" isn't "MPa"
+	public readonly exactMatchList: string[]
 	// type returns the UnitType of this unit. You can access the BaseUnit from there
 	public readonly type: UnitType
 	// base returns the base Unit of this UnitType directly
@@ -739,12 +858,14 @@ export class Unit {
 		base: Unit | null,
 		fromBase: conversion,
 		toBase: conversion,
-		matches: matcher
+		matches: matcher,
+		exactMatchList: string[] = []
 	) {
 		this.title = title
 		this.name = name
 		this.symbol = symbol
 		this.matchList = matchList
+		this.exactMatchList = exactMatchList
 		this.type = type
 		if (base != null) {
 			this.base = base
@@ -812,12 +933,43 @@ return input.toLowerCase().replace(WhitespaceRegex, replaceValue)`,
 		"ut: UnitType, u: Unit",
 	))
 
+	file = appends(file, "%s", `// ErrUnknownType is thrown by lookupType when the input doesn't match any unit type
+export class ErrUnknownType extends Error {
+	// input is the sanitized string which failed to match
+	public readonly input: string
+
+	constructor (input: string) {
+		super(`+"`units: unknown unit type \"${input}\"`"+`)
+		this.name = 'ErrUnknownType'
+		this.input = input
+	}
+}
+
+// ErrUnknownUnit is thrown by lookupUnit and lookupTypeUnit when the input doesn't match any unit
+export class ErrUnknownUnit extends Error {
+	// input is the sanitized string which failed to match, or the alakaTitle
+	// as given to lookupTypeUnit, which matches it exactly
+	public readonly input: string
+	// type is the unit type that was searched, null when searching by alakaTitle
+	public readonly type: UnitType | null
+
+	constructor (input: string, type: UnitType | null) {
+		super(type === null
+			? `+"`units: unknown unit \"${input}\"`"+`
+			: `+"`units: unknown ${type.title} unit \"${input}\"${didYouMean(input, type)}`"+`)
+		this.name = 'ErrUnknownUnit'
+		this.input = input
+		this.type = type
+	}
+}`)
+
 	var allTypes []string
 	var allUnits []string
 	var allUnitTypes []string
 
 	// Provide a function for getting a unit and/or unit type
 	getTypeCode := `switch (sanitizeString(input)) {`
+	exactUnitCode := `switch (typeOf.title + "->" + input.replace(WhitespaceRegex, '')) {`
 	getUnitCode := `const search = typeOf.title + "->" + sanitizeString(input)
 	switch (search) {`
 	getTypeUnitCode := `switch (input) {`
@@ -864,6 +1016,10 @@ return input.toLowerCase().replace(WhitespaceRegex, replaceValue)`,
 			unitNames = append(unitNames, u.Title())
 			allUnitTypes = append(allUnitTypes, d.StructName()+"_"+u.Title())
 
+			for _, match := range u.ExactMatches {
+				exactUnitCode = appendText(1, exactUnitCode, `case "%s":
+	return %s`, d.StructName()+"->"+match, u.VarName(d.StructName()))
+			}
 			for _, match := range u.Matches {
 				getUnitCode = appendText(1, getUnitCode, `case "%s":
 	return %s`, d.StructName()+"->"+match, u.VarName(d.StructName()))
@@ -878,14 +1034,15 @@ return input.toLowerCase().replace(WhitespaceRegex, replaceValue)`,
 	}
 
 	getTypeCode = appendText(1, getTypeCode, `default:
-	return %s
-}`, numberName)
+	return undefined
+}`)
 	getUnitCode = appendText(1, getUnitCode, `default:
-	return %s
-}`, numberUnitName)
+	return undefined
+}`)
+	getUnitCode = appendText(1, exactUnitCode, "}\n%s", getUnitCode)
 	getTypeUnitCode = appendText(1, getTypeUnitCode, `default:
-	return [%s, %s]
-}`, numberName, numberUnitName)
+	return undefined
+}`)
 
 	file = appends(file, `// AllTypes is a list of all available types below
 export const AllTypes: unitTypeTitle[] = [
@@ -902,81 +1059,501 @@ export const AllUnitTypes: alakaTitle[] = [
 	%s
 ]`, arraySep(allUnitTypes, true, "\n    "))
 
+	file = appends(file, fnJs(
+		"findType",
+		getTypeCode,
+		"UnitType | undefined",
+		"returns the unit type which matches input or undefined",
+		"input: string"))
+	file = appends(file, fnJs(
+		"findUnit",
+		getUnitCode,
+		"Unit | undefined",
+		"returns the unit which matches input or undefined",
+		"input: string, typeOf: UnitType"))
+	file = appends(file, fnJs(
+		"findTypeUnit",
+		getTypeUnitCode,
+		"[UnitType, Unit] | undefined",
+		"returns the unit type and unit which matches input or undefined",
+		"input: alakaTitle"))
+
+	file = appends(file, anonFnJs(
+		"lookupType",
+		`const ut = findType(input)
+if (ut === undefined) {
+	throw new ErrUnknownType(sanitizeString(input))
+}
+return ut`,
+		"UnitType",
+		"returns the unit type which matches input or throws an ErrUnknownType",
+		"input: string"))
+	file = appends(file, anonFnJs(
+		"lookupUnit",
+		`const u = findUnit(input, typeOf)
+if (u === undefined) {
+	throw new ErrUnknownUnit(sanitizeString(input), typeOf)
+}
+return u`,
+		"Unit",
+		"returns the unit of typeOf which matches input or throws an ErrUnknownUnit",
+		"input: string, typeOf: UnitType"))
+	file = appends(file, anonFnJs(
+		"lookupTypeUnit",
+		`const tu = findTypeUnit(input)
+if (tu === undefined) {
+	throw new ErrUnknownUnit(input, null)
+}
+return tu`,
+		"[UnitType, Unit]",
+		`returns the unit type and unit which matches input or throws an ErrUnknownUnit.
+// Opposite of AlakaTitle`,
+		"input: alakaTitle"))
+
 	file = appends(file, anonFnJs(
 		"getType",
-		getTypeCode,
+		fmt.Sprintf("return findType(input) ?? %s", numberName),
 		"UnitType",
 		fmt.Sprintf("returns the unit type which matches input or %s", numberName),
 		"input: string"))
 	file = appends(file, anonFnJs(
 		"getUnit",
-		getUnitCode,
+		fmt.Sprintf("return findUnit(input, typeOf) ?? %s", numberUnitName),
 		"Unit",
 		fmt.Sprintf("returns the unit which matches input or %s", numberUnitName),
 		"input: string, typeOf: UnitType"))
 	file = appends(file, anonFnJs(
 		"getTypeUnit",
-		getTypeUnitCode,
+		fmt.Sprintf("return findTypeUnit(input) ?? [%s, %s]", numberName, numberUnitName),
 		"[UnitType, Unit]",
 		fmt.Sprintf(`returns the unit type and unit which matches input or (%s, %s).
 // Opposite of AlakaTitle`, numberName, numberUnitName),
 		"input: alakaTitle"))
 
+	var typeVars []string
 	for _, d := range uy.Definitions {
-		file = appends(file, d.MakeJsCode())
+		file = appends(file, makeJsDefinition(&d))
+		typeVars = append(typeVars, d.VarName())
 	}
 
+	file = appends(file, `// UnitTypes is a list of all available unit types,
+// in the same order as AllTypes
+export const UnitTypes: UnitType[] = [
+    %s
+]`, arraySep(typeVars, false, "\n    "))
+	file = appends(file, `// findUnits returns every unit, of any unit type, whose matchList includes
+// input, so the caller can pick the type from context or treat the input as
+// ambiguous. 'volts' for example is found in ElectricPotential,
+// ElectricPotentialLoaded and ElectricPotentialUnloaded.
+export function findUnits (input: string): [UnitType, Unit][] {
+	const check = sanitizeString(input)
+	const out: [UnitType, Unit][] = []
+	for (const type of UnitTypes) {
+		const unit = type.units.find(u => u.exactMatchList.includes(input.replace(WhitespaceRegex, ''))) ??
+			type.units.find(u => u.matchList.includes(check))
+		if (unit !== undefined) out.push([type, unit])
+	}
+	return out
+}`)
+	file = appends(file, "%s", jsSuggest)
+
 	// It's a pita to get % and use a lot of sprintf so we do that last replace here
 	file = strings.ReplaceAll(file, "percentagesymbol", "%")
 
 	return []byte(file)
 }
 
-func (uy *UnitsYaml) ResolveUnitTypeCopies() {
-	cache := make(map[string]Definition)
+// jsSuggest is the ts version of suggest.go
+const jsSuggest = `// Suggestion is a unit which input may have been meant as, see suggestUnits
+export interface Suggestion {
+	type: UnitType
+	unit: Unit
+	// match is the match string, name or symbol which input was closest to
+	match: string
+	// confidence is from 0 to 1, where 1 is an exact match
+	confidence: number
+}
+
+// minSuggestionConfidence is the lowest confidence that suggestUnits returns
+export const minSuggestionConfidence = 0.5
+
+// suggestUnits returns up to n units of typeOf which input may have meant,
+// best first. Every unit's matchList, name and symbol are scored against the
+// sanitized input: prefixes score highly, so it works for autocomplete, and
+// anything else by edit distance, so "psia" still finds psi.
+export function suggestUnits (input: string, typeOf: UnitType, n: number): Suggestion[] {
+	return suggest(input, [typeOf], n)
+}
+
+// suggestAnyUnits is suggestUnits across every unit type
+export function suggestAnyUnits (input: string, n: number): Suggestion[] {
+	return suggest(input, UnitTypes, n)
+}
 
-	for idx, d := range uy.Definitions {
-		if d.CopyUnits != nil {
-			parent, ok := cache[*d.CopyUnits]
-			if !ok {
-				panic(fmt.Sprintf("Declared copy before parent: %s before %s", d.Type, d.CopyUnits))
+function suggest (input: string, types: UnitType[], n: number): Suggestion[] {
+	const check = sanitizeString(input)
+	if (check === '' || n <= 0) return []
+
+	const out: Suggestion[] = []
+	for (const type of types) {
+		for (const unit of type.units) {
+			const best: Suggestion = { type, unit, match: '', confidence: 0 }
+			const candidates = [unit.symbol, unit.name, ...unit.matchList.filter(m => m !== '*')]
+			for (const candidate of candidates) {
+				const c = similarity(check, sanitizeString(candidate))
+				if (c > best.confidence) {
+					best.match = candidate
+					best.confidence = c
+				}
 			}
+			if (best.confidence >= minSuggestionConfidence) out.push(best)
+		}
+	}
+
+	// Array.prototype.sort is stable, so ties keep their declared order
+	return out.sort((a, b) => b.confidence - a.confidence).slice(0, n)
+}
+
+// similarity scores how close input is to candidate from 0 to 1. A prefix
+// of candidate scores at least 0.6, growing with how much of it is typed.
+function similarity (input: string, candidate: string): number {
+	if (candidate === '') return 0
+	if (input === candidate) return 1
+
+	const a = Array.from(input)
+	const b = Array.from(candidate)
+	let score = 1 - editDistance(a, b) / Math.max(a.length, b.length)
+	if (candidate.startsWith(input)) {
+		score = Math.max(score, 0.6 + 0.39 * a.length / b.length)
+	}
+	return score
+}
 
-			d.Units = parent.Units
-			uy.Definitions[idx] = d
+// editDistance is the Levenshtein distance between a and b
+function editDistance (a: string[], b: string[]): number {
+	let prev = b.map((_, j) => j).concat(b.length)
+	let curr = new Array<number>(b.length + 1).fill(0)
+	for (let i = 1; i <= a.length; i++) {
+		curr[0] = i
+		for (let j = 1; j <= b.length; j++) {
+			const cost = a[i - 1] === b[j - 1] ? 0 : 1
+			curr[j] = Math.min(prev[j] + 1, curr[j - 1] + 1, prev[j - 1] + cost)
 		}
+		[prev, curr] = [curr, prev]
+	}
+	return prev[b.length]
+}
+
+// didYouMean returns the hint added to an ErrUnknownUnit, eg.
+// '; did you mean "kPa"?', or '' when nothing is close
+function didYouMean (input: string, typeOf: UnitType): string {
+	const [suggestion] = suggestUnits(input, typeOf, 1)
+	if (suggestion === undefined) return ''
+	return ` + "`; did you mean \"${suggestion.unit.symbol || suggestion.unit.name}\"?`" + `
+}`
+
+func makeGoTestFile(uy *schema.UnitsYaml, pkg, stamp string) []byte {
+	file := `package ` + pkg + `
+
+import (
+    "math"
+    "testing"
+)`
 
-		cache[d.Type] = d
+	file = appends(file, `// File autogenerated from %s.
+// Do not edit directly`, stamp)
+
+	var cases []string
+	for _, d := range uy.Definitions {
+		for _, u := range d.Units {
+			cases = append(cases, fmt.Sprintf(`{"%s", %s, %s}`, d.StructName()+"_"+u.Title(), d.VarName(), u.VarName(d.StructName())))
+		}
+	}
+
+	file = appends(file, `// roundTripSamples are converted to and from the base of every unit
+var roundTripSamples = [...]float64{-1e6, -40, -1, 0, 1e-6, 1e-3, 1, 37.5, 1e3, 1e6, 1e9}
+
+// roundTripTolerance is relative, or absolute for samples under 1
+const roundTripTolerance = %s`, strconv.FormatFloat(uy.ConversionTolerance(), 'g', -1, 64))
+
+	file = appends(file, `// generatedUnits is every unit of every unit type
+var generatedUnits = [...]struct {
+    alakaTitle string
+    typeOf     UnitType
+    unit       Unit
+}{
+    %s
+}`, arraySep(cases, false, "\n    "))
+
+	file = appends(file, "%s", `func TestGeneratedRoundTrip(t *testing.T) {
+	for _, tc := range generatedUnits {
+		for _, x := range roundTripSamples {
+			got := tc.unit.ToBase(tc.unit.FromBase(x))
+			if math.Abs(got-x) > roundTripTolerance*math.Max(1, math.Abs(x)) {
+				t.Errorf("%s: ToBase(FromBase(%g)) = %g", tc.alakaTitle, x, got)
+			}
+		}
+	}
+}
+
+func TestGeneratedMatches(t *testing.T) {
+	for _, tc := range generatedUnits {
+		for _, m := range append(tc.unit.MatchList(), ExactMatchList(tc.unit)...) {
+			if got := GetUnit(m, tc.typeOf); got != tc.unit {
+				t.Errorf("%s: GetUnit(%q) = %s", tc.alakaTitle, m, AlakaTitle(got.TypeOf(), got))
+			}
+		}
 	}
 }
 
+func TestGeneratedAlakaTitles(t *testing.T) {
+	if len(AllUnitTypes) != len(generatedUnits) {
+		t.Fatalf("AllUnitTypes has %d entries, want %d", len(AllUnitTypes), len(generatedUnits))
+	}
+	for idx, tc := range generatedUnits {
+		if AllUnitTypes[idx] != tc.alakaTitle {
+			t.Errorf("AllUnitTypes[%d] = %s, want %s", idx, AllUnitTypes[idx], tc.alakaTitle)
+		}
+		if got := AlakaTitle(tc.typeOf, tc.unit); got != tc.alakaTitle {
+			t.Errorf("AlakaTitle = %s, want %s", got, tc.alakaTitle)
+		}
+		ut, u := GetTypeUnit(tc.alakaTitle)
+		if ut != tc.typeOf || u != tc.unit {
+			t.Errorf("GetTypeUnit(%s) = %s", tc.alakaTitle, AlakaTitle(ut, u))
+		}
+	}
+}
+
+func TestGeneratedText(t *testing.T) {
+	for _, tc := range generatedUnits {
+		text, err := AnyUnit{tc.unit}.MarshalText()
+		if err != nil || string(text) != tc.alakaTitle {
+			t.Errorf("%s: MarshalText() = %s, %v", tc.alakaTitle, text, err)
+		}
+		if u, err := DecodeUnit(text); err != nil || u != tc.unit {
+			t.Errorf("%s: DecodeUnit(%s) = %v, %v", tc.alakaTitle, text, u, err)
+		}
+
+		text, err = AnyUnitType{tc.typeOf}.MarshalText()
+		if err != nil || string(text) != tc.typeOf.Title() {
+			t.Errorf("%s: type MarshalText() = %s, %v", tc.alakaTitle, text, err)
+		}
+		if ut, err := DecodeUnitType(text); err != nil || ut != tc.typeOf {
+			t.Errorf("%s: DecodeUnitType(%s) = %v, %v", tc.alakaTitle, text, ut, err)
+		}
+	}
+}`)
+
+	// It's a pita to get % and use a lot of sprintf so we do that last replace here
+	file = strings.ReplaceAll(file, "percentagesymbol", "%")
+
+	return []byte(file)
+}
+
+func makeJsTestFile(uy *schema.UnitsYaml, stamp string) []byte {
+	file := `import * as units from '../index'`
+
+	file = appends(file, `// File autogenerated from %s.
+// Do not edit directly`, stamp)
+
+	var cases []string
+	for _, d := range uy.Definitions {
+		for _, u := range d.Units {
+			cases = append(cases, fmt.Sprintf(`['%s', units.%s, units.%s]`, d.StructName()+"_"+u.Title(), d.VarName(), u.VarName(d.StructName())))
+		}
+	}
+
+	file = appends(file, `// roundTripSamples are converted to and from the base of every unit
+const roundTripSamples = [-1e6, -40, -1, 0, 1e-6, 1e-3, 1, 37.5, 1e3, 1e6, 1e9]
+
+// roundTripTolerance is relative, or absolute for samples under 1
+const roundTripTolerance = %s`, strconv.FormatFloat(uy.ConversionTolerance(), 'g', -1, 64))
+
+	file = appends(file, `// generatedUnits is every unit of every unit type
+const generatedUnits: Array<[units.alakaTitle, units.UnitType, units.Unit]> = [
+	%s
+]`, arraySep(cases, false, "\n\t"))
+
+	file = appends(file, "%s", `describe('generated units', () => {
+	test.each(generatedUnits)('%s round trips through its base', (_, _type, unit) => {
+		for (const x of roundTripSamples) {
+			const got = unit.toBase(unit.fromBase(x))
+			expect(Math.abs(got - x)).toBeLessThanOrEqual(roundTripTolerance * Math.max(1, Math.abs(x)))
+		}
+	})
+
+	test.each(generatedUnits)('%s resolves every match', (_, type, unit) => {
+		for (const m of [...unit.matchList, ...unit.exactMatchList]) {
+			expect(units.getUnit(m, type)).toBe(unit)
+		}
+	})
+
+	test.each(generatedUnits)('%s round trips through getTypeUnit', (title, type, unit) => {
+		expect(units.toAlakaTitle(type, unit)).toBe(title)
+		expect(units.getTypeUnit(title)).toEqual([type, unit])
+	})
+
+	test('AllUnitTypes lists every unit', () => {
+		expect(units.AllUnitTypes).toEqual(generatedUnits.map(([title]) => title))
+	})
+})`)
+
+	// It's a pita to get % and use a lot of sprintf so we do that last replace here
+	file = strings.ReplaceAll(file, "percentagesymbol", "%")
+
+	return []byte(file)
+}
+
+// listFlag is a flag which may be given more than once or as a comma
+// separated list
+type listFlag []string
+
+func (l *listFlag) String() string { return strings.Join(*l, ",") }
+
+func (l *listFlag) Set(value string) error {
+	*l = append(*l, strings.Split(value, ",")...)
+	return nil
+}
+
+// targets are the files the generator can write, by their -targets name
+var targets = []string{"go", "go-test", "ts", "ts-test"}
+
 func main() {
-	cwd, err := os.Getwd()
-	if err != nil {
-		panic(err)
+	log.SetFlags(0)
+	if err := run(os.Args[0], os.Args[1:]); err != nil {
+		log.Fatal(err)
 	}
+}
 
-	f, err := os.ReadFile(cwd + "/units.yaml")
-	if err != nil {
-		panic(err)
+// run writes the outputs selected by the command line args or, with -check,
+// returns an error naming each one which is out of date
+func run(name string, args []string) error {
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	var inputs, only listFlag
+	fs.Var(&inputs, "in", "input yaml `file`, repeat for more than one (default units.yaml)")
+	outputs := map[string]*string{
+		"go":      fs.String("go", "units.go", "Go output `file`"),
+		"go-test": fs.String("go-test", "units_generated_test.go", "Go test output `file`"),
+		"ts":      fs.String("ts", "node.js/src/index.ts", "TypeScript output `file`"),
+		"ts-test": fs.String("ts-test", "node.js/src/__tests__/index.test.ts", "TypeScript test output `file`"),
+	}
+	pkg := fs.String("package", "units", "Go package `name`")
+	fs.Var(&only, "targets", "comma separated outputs to write, any of "+strings.Join(targets, ", ")+" (default all)")
+	check := fs.Bool("check", false, "write nothing, exit with status 1 if any output is out of date")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if len(inputs) == 0 {
+		inputs = listFlag{"units.yaml"}
+	}
+	if len(only) == 0 {
+		only = targets
 	}
 
-	data := UnitsYaml{}
-	err = yaml.Unmarshal(f, &data)
+	data, stamp, err := load(inputs)
 	if err != nil {
-		panic(err)
+		return err
+	}
+
+	files := map[string]func() ([]byte, error){
+		"go":      func() ([]byte, error) { return format.Source(makeGoFile(data, *pkg, stamp)) },
+		"go-test": func() ([]byte, error) { return format.Source(makeGoTestFile(data, *pkg, stamp)) },
+		"ts":      func() ([]byte, error) { return makeJsFile(data, stamp), nil },
+		"ts-test": func() ([]byte, error) { return makeJsTestFile(data, stamp), nil },
+	}
+
+	var stale []string
+	for _, target := range only {
+		generate, ok := files[target]
+		if !ok {
+			return fmt.Errorf("unknown target %q, expected one of %s", target, strings.Join(targets, ", "))
+		}
+		out, err := generate()
+		if err != nil {
+			return fmt.Errorf("%s: %v", *outputs[target], err)
+		}
+
+		path := *outputs[target]
+		if *check {
+			current, err := os.ReadFile(path)
+			if err != nil || !bytes.Equal(current, out) {
+				stale = append(stale, path)
+			}
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, out, 0644); err != nil {
+			return err
+		}
 	}
 
-	data.ResolveUnitTypeCopies()
-	goFile := data.MakeGoFile()
-	jsFile := data.MakeJsFile()
+	if len(stale) > 0 {
+		return fmt.Errorf("%s out of date, run go generate to update", strings.Join(stale, ", "))
+	}
+	return nil
+}
 
-	if err := os.WriteFile(cwd+"/units.go", goFile, 0644); err != nil {
-		log.Panic(err)
+// load reads, checks and resolves every input, concatenating their
+// definitions in order. The stamp names the inputs and a hash of their
+// contents, so output only changes when they do.
+func load(inputs []string) (*schema.UnitsYaml, string, error) {
+	data := &schema.UnitsYaml{}
+	loc := newLocator()
+	hash := sha256.New()
+
+	for _, path := range inputs {
+		f, err := os.ReadFile(path)
+		if err != nil {
+			return nil, "", err
+		}
+		hash.Write(f)
+
+		var file schema.UnitsYaml
+		if err := yaml.Unmarshal(f, &file); err != nil {
+			return nil, "", fmt.Errorf("%s: %w", path, err)
+		}
+		var root yaml3.Node
+		if err := yaml3.Unmarshal(f, &root); err != nil {
+			return nil, "", fmt.Errorf("%s: %w", path, err)
+		}
+		loc.add(path, &root, len(data.Definitions))
+
+		if data.Version == "" {
+			data.Version = file.Version
+		}
+		if data.Tolerance == 0 {
+			data.Tolerance = file.Tolerance
+		}
+		data.Definitions = append(data.Definitions, file.Definitions...)
+	}
+
+	if err := data.ExpandPrefixes(); err != nil {
+		return nil, "", err
+	}
+	warnings, err := checkMatches(data, loc)
+	for _, w := range warnings {
+		log.Print(w)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := data.ResolveUnitTypeCopies(); err != nil {
+		return nil, "", err
+	}
+	if err := data.CheckDifferenceTypes(nil); err != nil {
+		return nil, "", err
+	}
+	if err := data.ParseConversions(); err != nil {
+		return nil, "", err
 	}
-	if err := os.WriteFile(cwd+"/node.js/src/index.ts", jsFile, 0644); err != nil {
-		log.Panic(err)
+	if err := data.ResolveFactors(nil); err != nil {
+		return nil, "", err
 	}
 
-	os.Exit(0)
+	stamp := fmt.Sprintf("%s (sha256 %x)", strings.Join(inputs, ", "), hash.Sum(nil)[:8])
+	return data, stamp, nil
 }
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// runArgs returns the output flags which put every target in dir
+func runArgs(dir string, args ...string) []string {
+	return append([]string{
+		"-in", filepath.Join("..", "units.yaml"),
+		"-go", filepath.Join(dir, "units.go"),
+		"-go-test", filepath.Join(dir, "units_generated_test.go"),
+		"-ts", filepath.Join(dir, "ts", "index.ts"),
+		"-ts-test", filepath.Join(dir, "ts", "index.test.ts"),
+	}, args...)
+}
+
+func TestRunCheck(t *testing.T) {
+	// Shared matches in units.yaml are logged as warnings
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	dir := t.TempDir()
+	if err := run("generate", runArgs(dir)); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := run("generate", runArgs(dir, "-check")); err != nil {
+			t.Fatalf("check %d of a fresh tree: %v", i+1, err)
+		}
+	}
+
+	// Another run writes the same files, stamp and all
+	again := t.TempDir()
+	if err := run("generate", runArgs(again)); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"units.go", "units_generated_test.go", filepath.Join("ts", "index.ts"), filepath.Join("ts", "index.test.ts")} {
+		first, err1 := os.ReadFile(filepath.Join(dir, name))
+		second, err2 := os.ReadFile(filepath.Join(again, name))
+		if err1 != nil || err2 != nil || !bytes.Equal(first, second) {
+			t.Errorf("%s differs between runs: %v, %v", name, err1, err2)
+		}
+	}
+	_, stamp1, err1 := load([]string{filepath.Join("..", "units.yaml")})
+	_, stamp2, err2 := load([]string{filepath.Join("..", "units.yaml")})
+	if err1 != nil || err2 != nil || stamp1 != stamp2 || !strings.HasPrefix(stamp1, filepath.Join("..", "units.yaml")+" (sha256 ") {
+		t.Errorf("stamps %q and %q, %v, %v", stamp1, stamp2, err1, err2)
+	}
+
+	edited := filepath.Join(dir, "ts", "index.ts")
+	f, err := os.OpenFile(edited, os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.WriteString("// edited by hand\n")
+	f.Close()
+
+	err = run("generate", runArgs(dir, "-check"))
+	if err == nil || !strings.Contains(err.Error(), edited) {
+		t.Errorf("check after editing %s = %v", edited, err)
+	}
+	if err != nil && strings.Contains(err.Error(), "units.go") {
+		t.Errorf("check blamed an untouched file: %v", err)
+	}
+	if err := run("generate", runArgs(dir, "-check", "-targets", "go,go-test")); err != nil {
+		t.Errorf("check of the untouched targets: %v", err)
+	}
+}
+
+func TestRunTargets(t *testing.T) {
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	dir := t.TempDir()
+	if err := run("generate", runArgs(dir, "-targets", "go", "-package", "other")); err != nil {
+		t.Fatal(err)
+	}
+	out, err := os.ReadFile(filepath.Join(dir, "units.go"))
+	if err != nil || !bytes.Contains(out, []byte("\npackage other\n")) {
+		t.Errorf("units.go = %.40q, %v", out, err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "ts", "index.ts")); !os.IsNotExist(err) {
+		t.Errorf("-targets go wrote index.ts: %v", err)
+	}
+
+	if err := run("generate", runArgs(dir, "-targets", "python")); err == nil || !strings.Contains(err.Error(), `unknown target "python"`) {
+		t.Errorf("-targets python = %v", err)
+	}
+	if err := run("generate", runArgs(dir, "-in", filepath.Join(dir, "missing.yaml"))); err == nil {
+		t.Error("-in of a missing file succeeded")
+	}
+}
@@ -0,0 +1,487 @@
+package schema
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// constants are the named constants which may be used in a conversion
+// expression alongside its bound variable
+var constants = map[string]string{
+	"pi": "3.141592653589793",
+	// standard gravity, m/s²
+	"g0": "9.80665",
+}
+
+// Conversion is a parsed fromBase or toBase expression of the form
+// "x => <expression of x>"
+type Conversion struct {
+	Var    string
+	Body   Expr
+	Source string
+}
+
+// Expr is a node of a conversion expression
+type Expr interface {
+	// Emit returns the expression as source code for target
+	Emit(t Target) string
+	// Eval returns the value of the expression when the bound variable is x
+	Eval(x float64) float64
+	// Affine returns the exact a and b where the expression is a*x + b, or
+	// false if it can't be written that way
+	Affine() (a, b *big.Rat, ok bool)
+}
+
+// Target controls how an expression is written out for a language
+type Target struct {
+	// Var is the name the bound variable is written as
+	Var string
+	// Number formats a number literal which has had its separators removed
+	Number func(literal string) string
+}
+
+// GoTarget writes every number as a float literal so that constant
+// sub-expressions like (9 / 5) aren't evaluated as integers
+func GoTarget(variable string) Target {
+	return Target{
+		Var: variable,
+		Number: func(literal string) string {
+			if strings.ContainsAny(literal, ".eE") {
+				return literal
+			}
+			return literal + ".0"
+		},
+	}
+}
+
+// jsReserved are the words which can't be used as a parameter name in ts
+var jsReserved = map[string]string{
+	"in": "inch",
+}
+
+// JsTarget renames variables that are reserved words in js
+func JsTarget(variable string) Target {
+	if renamed, ok := jsReserved[variable]; ok {
+		variable = renamed
+	}
+	return Target{
+		Var:    variable,
+		Number: func(literal string) string { return literal },
+	}
+}
+
+// Number is a literal number. Literal has its "," separators removed.
+type Number struct {
+	Literal string
+	Value   float64
+}
+
+func (n *Number) Emit(t Target) string   { return t.Number(n.Literal) }
+func (n *Number) Eval(_ float64) float64 { return n.Value }
+func (n *Number) Affine() (*big.Rat, *big.Rat, bool) {
+	r, _ := new(big.Rat).SetString(n.Literal)
+	return new(big.Rat), r, true
+}
+
+// Variable is the bound variable of the conversion
+type Variable struct{}
+
+func (v *Variable) Emit(t Target) string               { return t.Var }
+func (v *Variable) Eval(x float64) float64             { return x }
+func (v *Variable) Affine() (*big.Rat, *big.Rat, bool) { return big.NewRat(1, 1), new(big.Rat), true }
+
+// Constant is a named constant, see constants
+type Constant struct {
+	Name   string
+	Number Number
+}
+
+func (c *Constant) Emit(t Target) string               { return c.Number.Emit(t) }
+func (c *Constant) Eval(x float64) float64             { return c.Number.Eval(x) }
+func (c *Constant) Affine() (*big.Rat, *big.Rat, bool) { return c.Number.Affine() }
+
+// Group is a parenthesised expression, kept so output matches the yaml
+type Group struct {
+	X Expr
+}
+
+func (g *Group) Emit(t Target) string               { return "(" + g.X.Emit(t) + ")" }
+func (g *Group) Eval(x float64) float64             { return g.X.Eval(x) }
+func (g *Group) Affine() (*big.Rat, *big.Rat, bool) { return g.X.Affine() }
+
+// Negate is a unary minus
+type Negate struct {
+	X Expr
+}
+
+func (n *Negate) Emit(t Target) string   { return "-" + n.X.Emit(t) }
+func (n *Negate) Eval(x float64) float64 { return -n.X.Eval(x) }
+
+func (n *Negate) Affine() (*big.Rat, *big.Rat, bool) {
+	a, b, ok := n.X.Affine()
+	if !ok {
+		return nil, nil, false
+	}
+	return a.Neg(a), b.Neg(b), true
+}
+
+// Binary is one of + - * /
+type Binary struct {
+	Op          byte
+	Left, Right Expr
+}
+
+func (b *Binary) Emit(t Target) string {
+	return fmt.Sprintf("%s %c %s", b.Left.Emit(t), b.Op, b.Right.Emit(t))
+}
+
+func (b *Binary) Eval(x float64) float64 {
+	l, r := b.Left.Eval(x), b.Right.Eval(x)
+	switch b.Op {
+	case '+':
+		return l + r
+	case '-':
+		return l - r
+	case '*':
+		return l * r
+	default:
+		return l / r
+	}
+}
+
+func (b *Binary) Affine() (*big.Rat, *big.Rat, bool) {
+	la, lb, lok := b.Left.Affine()
+	ra, rb, rok := b.Right.Affine()
+	if !lok || !rok {
+		return nil, nil, false
+	}
+	switch {
+	case b.Op == '+':
+		return la.Add(la, ra), lb.Add(lb, rb), true
+	case b.Op == '-':
+		return la.Sub(la, ra), lb.Sub(lb, rb), true
+	case b.Op == '*' && la.Sign() == 0:
+		return ra.Mul(lb, ra), rb.Mul(lb, rb), true
+	case b.Op == '*' && ra.Sign() == 0:
+		return la.Mul(la, rb), lb.Mul(lb, rb), true
+	case b.Op == '/' && ra.Sign() == 0 && rb.Sign() != 0:
+		return la.Quo(la, rb), lb.Quo(lb, rb), true
+	default:
+		return nil, nil, false
+	}
+}
+
+// ParseConversion parses source, eg. "Pa => Pa * 0.000,145,038"
+func ParseConversion(source string) (*Conversion, error) {
+	components := strings.Split(source, "=>")
+	if len(components) != 2 {
+		return nil, fmt.Errorf("expected exactly one \"=>\" in %q", source)
+	}
+
+	variable := strings.TrimSpace(components[0])
+	if !isIdentifier(variable) {
+		return nil, fmt.Errorf("%q is not a valid variable name in %q", variable, source)
+	}
+	if _, ok := constants[variable]; ok {
+		return nil, fmt.Errorf("variable %q shadows a named constant in %q", variable, source)
+	}
+
+	p := parser{source: source, input: components[1], offset: len(components[0]) + 2, variable: variable}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	body, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+	if p.token.kind != tokenEOF {
+		return nil, p.errorf("unexpected %q", p.token.text)
+	}
+	return &Conversion{Var: variable, Body: body, Source: source}, nil
+}
+
+// Emit returns the body of the conversion for t
+func (c *Conversion) Emit(t Target) string {
+	return c.Body.Emit(t)
+}
+
+// Factor returns the exact k where the conversion is x * k, or false when it
+// isn't linear
+func (c *Conversion) Factor() (*big.Rat, bool) {
+	a, b, ok := c.Body.Affine()
+	if !ok || b.Sign() != 0 {
+		return nil, false
+	}
+	return a, true
+}
+
+// Inverse derives the opposite conversion of an affine conversion, binding
+// the result to variable. Eg. "C => (C * 1.8) + 32" is inverted to
+// "F => (F - 32) / 1.8", while a linear "m => m * 3.280,84" is inverted to
+// "ft => ft * 0.304,800,...", the float64 nearest to the exact inverse.
+func (c *Conversion) Inverse(variable string) (*Conversion, error) {
+	a, b, ok := c.Body.Affine()
+	if !ok || a.Sign() == 0 {
+		return nil, fmt.Errorf("%q is not linear or affine so it can't be inverted", c.Source)
+	}
+	if b.Sign() == 0 {
+		return LinearConversion(variable, new(big.Rat).Inv(a)), nil
+	}
+
+	var body Expr = &Variable{}
+	if b.Sign() > 0 {
+		body = &Group{X: &Binary{Op: '-', Left: body, Right: number(b)}}
+	} else {
+		body = &Group{X: &Binary{Op: '+', Left: body, Right: number(new(big.Rat).Neg(b))}}
+	}
+	if a.Cmp(big.NewRat(1, 1)) != 0 {
+		body = &Binary{Op: '/', Left: body, Right: number(a)}
+	} else {
+		body = body.(*Group).X
+	}
+	return newConversion(variable, body), nil
+}
+
+// LinearConversion returns the conversion "variable => variable * k" where k
+// is written as the float64 nearest to factor
+func LinearConversion(variable string, factor *big.Rat) *Conversion {
+	var body Expr = &Variable{}
+	if factor.Cmp(big.NewRat(1, 1)) != 0 {
+		body = &Binary{Op: '*', Left: body, Right: number(factor)}
+	}
+	return newConversion(variable, body)
+}
+
+// newConversion returns a conversion of body, with a source as it'd be
+// written in the yaml
+func newConversion(variable string, body Expr) *Conversion {
+	source := variable + " => " + body.Emit(Target{Var: variable, Number: func(literal string) string { return literal }})
+	return &Conversion{Var: variable, Body: body, Source: source}
+}
+
+// inverseSamples are the values VerifyInverse round trips, spread over the
+// magnitudes that readings tend to have
+var inverseSamples = []float64{-1e6, -273.15, -1, 0, 1e-6, 1e-3, 1, 37.5, 1e3, 1e6, 1e9}
+
+// VerifyInverse checks that inv undoes c, and c undoes inv, to within a
+// relative tolerance (absolute for values smaller than 1)
+func (c *Conversion) VerifyInverse(inv *Conversion, tolerance float64) error {
+	for _, x := range inverseSamples {
+		scale := math.Max(1, math.Abs(x))
+		if got := inv.Body.Eval(c.Body.Eval(x)); math.Abs(got-x) > tolerance*scale {
+			return fmt.Errorf("%q and %q are not inverses: %g round trips to %g", c.Source, inv.Source, x, got)
+		}
+		if got := c.Body.Eval(inv.Body.Eval(x)); math.Abs(got-x) > tolerance*scale {
+			return fmt.Errorf("%q and %q are not inverses: %g round trips to %g", inv.Source, c.Source, x, got)
+		}
+	}
+	return nil
+}
+
+// number returns a Number node for the float64 nearest to r
+func number(r *big.Rat) *Number {
+	v, _ := r.Float64()
+	return &Number{Literal: strconv.FormatFloat(v, 'g', -1, 64), Value: v}
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenNumber
+	tokenIdent
+	tokenOp
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// parser is a recursive descent parser over the grammar
+//
+//	expression = term { ("+" | "-") term }
+//	term       = unary { ("*" | "/") unary }
+//	unary      = "-" unary | primary
+//	primary    = number | identifier | "(" expression ")"
+type parser struct {
+	source   string
+	input    string
+	offset   int
+	pos      int
+	variable string
+	token    token
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%s at column %d of %q", fmt.Sprintf(format, args...), p.offset+p.token.pos+1, p.source)
+}
+
+func (p *parser) next() error {
+	for p.pos < len(p.input) && (p.input[p.pos] == ' ' || p.input[p.pos] == '\t') {
+		p.pos++
+	}
+	start := p.pos
+	if p.pos >= len(p.input) {
+		p.token = token{kind: tokenEOF, text: "end of expression", pos: start}
+		return nil
+	}
+
+	c := p.input[p.pos]
+	switch {
+	case isDigit(c) || c == '.':
+		for p.pos < len(p.input) && (isDigit(p.input[p.pos]) || p.input[p.pos] == ',' || p.input[p.pos] == '.') {
+			p.pos++
+		}
+		if p.pos < len(p.input) && (p.input[p.pos] == 'e' || p.input[p.pos] == 'E') {
+			p.pos++
+			if p.pos < len(p.input) && (p.input[p.pos] == '+' || p.input[p.pos] == '-') {
+				p.pos++
+			}
+			for p.pos < len(p.input) && isDigit(p.input[p.pos]) {
+				p.pos++
+			}
+		}
+		p.token = token{kind: tokenNumber, text: p.input[start:p.pos], pos: start}
+	case isLetter(c):
+		for p.pos < len(p.input) && (isLetter(p.input[p.pos]) || isDigit(p.input[p.pos])) {
+			p.pos++
+		}
+		p.token = token{kind: tokenIdent, text: p.input[start:p.pos], pos: start}
+	case strings.IndexByte("+-*/()^", c) >= 0:
+		p.pos++
+		p.token = token{kind: tokenOp, text: string(c), pos: start}
+	default:
+		p.token = token{pos: start}
+		return p.errorf("unexpected character %q", c)
+	}
+	return nil
+}
+
+func (p *parser) isOp(ops string) bool {
+	return p.token.kind == tokenOp && strings.Contains(ops, p.token.text)
+}
+
+func (p *parser) expression() (Expr, error) {
+	left, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp("+-") {
+		op := p.token.text[0]
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		right, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		left = &Binary{Op: op, Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) term() (Expr, error) {
+	left, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp("*/") {
+		op := p.token.text[0]
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		right, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		left = &Binary{Op: op, Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) unary() (Expr, error) {
+	if p.isOp("-") {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return &Negate{X: x}, nil
+	}
+	return p.primary()
+}
+
+func (p *parser) primary() (Expr, error) {
+	tok := p.token
+	switch {
+	case tok.kind == tokenNumber:
+		n, err := parseNumber(tok.text)
+		if err != nil {
+			return nil, p.errorf("%s", err)
+		}
+		return n, p.next()
+	case tok.kind == tokenIdent && tok.text == p.variable:
+		return &Variable{}, p.next()
+	case tok.kind == tokenIdent:
+		literal, ok := constants[tok.text]
+		if !ok {
+			return nil, p.errorf("unknown identifier %q, expected %q or a named constant", tok.text, p.variable)
+		}
+		n, _ := parseNumber(literal)
+		return &Constant{Name: tok.text, Number: *n}, p.next()
+	case p.isOp("("):
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		x, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		if !p.isOp(")") {
+			return nil, p.errorf("expected \")\" but found %q", p.token.text)
+		}
+		return &Group{X: x}, p.next()
+	default:
+		return nil, p.errorf("expected a number, %q or \"(\" but found %q", p.variable, tok.text)
+	}
+}
+
+// parseNumber parses a literal which may use "," as a digit separator
+func parseNumber(text string) (*Number, error) {
+	literal := strings.ReplaceAll(text, ",", "")
+	if strings.HasSuffix(literal, ".") {
+		literal += "0"
+	}
+	value, err := strconv.ParseFloat(literal, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid number %q", text)
+	}
+	return &Number{Literal: literal, Value: value}, nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isLetter(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentifier(s string) bool {
+	if s == "" || !isLetter(s[0]) {
+		return false
+	}
+	for i := 1; i < len(s); i++ {
+		if !isLetter(s[i]) && !isDigit(s[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,128 @@
+package schema
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestParseConversion(t *testing.T) {
+	tests := []struct {
+		source string
+		goCode string
+		jsCode string
+		x      float64
+		want   float64
+	}{
+		{"Pa => Pa * 0.000,145,038", "x * 0.000145038", "x * 0.000145038", 1000, 0.145038},
+		{"C => (C * 9 / 5) + 32", "(x * 9.0 / 5.0) + 32.0", "(x * 9 / 5) + 32", 100, 212},
+		{"v => v - 2 * 3", "x - 2.0 * 3.0", "x - 2 * 3", 10, 4},
+		{"v => -v / 2", "-x / 2.0", "-x / 2", 3, -1.5},
+		{"r => r * 2 * pi", "x * 2.0 * 3.141592653589793", "x * 2 * 3.141592653589793", 1, 2 * math.Pi},
+		{"a => a / g0", "x / 9.80665", "x / 9.80665", 9.80665, 1},
+		{"in => in * 1e-3", "x * 1e-3", "x * 1e-3", 1000, 1},
+	}
+	for _, tc := range tests {
+		c, err := ParseConversion(tc.source)
+		if err != nil {
+			t.Errorf("ParseConversion(%q): %v", tc.source, err)
+			continue
+		}
+		if got := c.Emit(GoTarget("x")); got != tc.goCode {
+			t.Errorf("ParseConversion(%q) emits %q for go, want %q", tc.source, got, tc.goCode)
+		}
+		if got := c.Emit(JsTarget("x")); got != tc.jsCode {
+			t.Errorf("ParseConversion(%q) emits %q for ts, want %q", tc.source, got, tc.jsCode)
+		}
+		if got := c.Body.Eval(tc.x); math.Abs(got-tc.want) > 1e-12 {
+			t.Errorf("ParseConversion(%q) of %v = %v, want %v", tc.source, tc.x, got, tc.want)
+		}
+	}
+
+	if got := JsTarget("in").Var; got != "inch" {
+		t.Errorf("JsTarget(in) binds %q, want a name that isn't reserved", got)
+	}
+
+	for _, source := range []string{
+		"Pa * 2",
+		"a => b => a",
+		"2x => 2x * 2",
+		"pi => pi * 2",
+		"x => y * 2",
+		"x => x *",
+		"x => (x * 2",
+		"x => x * 2)",
+		"x => x $ 2",
+		"x => 1.2.3 * x",
+	} {
+		if _, err := ParseConversion(source); err == nil {
+			t.Errorf("ParseConversion(%q) didn't fail", source)
+		}
+	}
+}
+
+func TestInverse(t *testing.T) {
+	tests := []struct {
+		source  string
+		inverse string
+	}{
+		{"m => m * 3.280,84", "ft => ft * 0.3047999902464003"},
+		{"C => (C * 1.8) + 32", "F => (F - 32) / 1.8"},
+		{"C => C + 273.15", "F => F - 273.15"},
+		{"K => K - 273.15", "F => F + 273.15"},
+		{"x => x", "F => F"},
+	}
+	for _, tc := range tests {
+		c, err := ParseConversion(tc.source)
+		if err != nil {
+			t.Fatal(err)
+		}
+		inv, err := c.Inverse(strings.Fields(tc.inverse)[0])
+		if err != nil {
+			t.Errorf("Inverse(%q): %v", tc.source, err)
+			continue
+		}
+		if inv.Source != tc.inverse {
+			t.Errorf("Inverse(%q) = %q, want %q", tc.source, inv.Source, tc.inverse)
+		}
+		if err := c.VerifyInverse(inv, 1e-9); err != nil {
+			t.Errorf("Inverse(%q): %v", tc.source, err)
+		}
+	}
+
+	for _, source := range []string{"x => x * x", "x => 1 / x", "x => 0 * x"} {
+		c, err := ParseConversion(source)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.Inverse("y"); err == nil {
+			t.Errorf("Inverse(%q) didn't fail", source)
+		}
+	}
+}
+
+func TestVerifyInverse(t *testing.T) {
+	tests := []struct {
+		from, to  string
+		tolerance float64
+		ok        bool
+	}{
+		{"Pa => Pa * 0.000,145,038", "psi => psi * 6,894.76", 1e-5, true},
+		{"Pa => Pa * 0.000,145,038", "psi => psi * 6,894.76", 1e-7, false},
+		{"C => (C * 9 / 5) + 32", "F => (F - 32) * 5 / 9", 1e-12, true},
+		{"C => (C * 9 / 5) + 32", "F => F * 5 / 9", 1e-3, false},
+	}
+	for _, tc := range tests {
+		from, err := ParseConversion(tc.from)
+		if err != nil {
+			t.Fatal(err)
+		}
+		to, err := ParseConversion(tc.to)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := from.VerifyInverse(to, tc.tolerance); (err == nil) != tc.ok {
+			t.Errorf("VerifyInverse(%q, %q, %g) = %v", tc.from, tc.to, tc.tolerance, err)
+		}
+	}
+}
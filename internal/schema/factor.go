@@ -0,0 +1,277 @@
+package schema
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+)
+
+// Factor is an exact amount in the coherent SI units of its dimension, eg.
+// 1 ft³ is 0.028316846592 of Dimension{Length: 3}
+type Factor struct {
+	Value     *big.Rat
+	Dimension Dimension
+}
+
+// Mul returns f × o
+func (f Factor) Mul(o Factor) Factor {
+	return Factor{Value: new(big.Rat).Mul(f.Value, o.Value), Dimension: f.Dimension.Mul(o.Dimension, 1)}
+}
+
+// Quo returns f ÷ o
+func (f Factor) Quo(o Factor) Factor {
+	return Factor{Value: new(big.Rat).Quo(f.Value, o.Value), Dimension: f.Dimension.Mul(o.Dimension, -1)}
+}
+
+// Pow returns f to the integer power n
+func (f Factor) Pow(n int) Factor {
+	result := Factor{Value: big.NewRat(1, 1)}
+	base := f
+	if n < 0 {
+		base = result.Quo(f)
+		n = -n
+	}
+	for i := 0; i < n; i++ {
+		result = result.Mul(base)
+	}
+	return result
+}
+
+// Mul returns the dimension of d × o^sign
+func (d Dimension) Mul(o Dimension, sign int) Dimension {
+	return Dimension{
+		Mass:        d.Mass + sign*o.Mass,
+		Length:      d.Length + sign*o.Length,
+		Time:        d.Time + sign*o.Time,
+		Temperature: d.Temperature + sign*o.Temperature,
+		Current:     d.Current + sign*o.Current,
+		Amount:      d.Amount + sign*o.Amount,
+	}
+}
+
+// FactorResolver returns the factor of a referenced unit, either an AlakaTitle
+// like "Length_Meters" or the title of a unit in the same definition
+type FactorResolver func(ref string) (Factor, error)
+
+// ParseFactor parses a definedAs expression, eg. "(0.3048 Length_Meters)^3"
+// or "42 GallonUSFluid". Juxtaposed terms are multiplied.
+func ParseFactor(source string, resolve FactorResolver) (Factor, error) {
+	p := factorParser{parser: parser{source: source, input: source}, resolve: resolve}
+	if err := p.next(); err != nil {
+		return Factor{}, err
+	}
+	f, err := p.quotient()
+	if err != nil {
+		return Factor{}, err
+	}
+	if p.token.kind != tokenEOF {
+		return Factor{}, p.errorf("unexpected %q", p.token.text)
+	}
+	return f, nil
+}
+
+// factorParser is a recursive descent parser over the grammar
+//
+//	quotient = product { ("*" | "/") product }
+//	product  = power { power }
+//	power    = primary [ "^" [ "-" ] integer ]
+//	primary  = number | reference | "(" quotient ")"
+type factorParser struct {
+	parser
+	resolve FactorResolver
+}
+
+func (p *factorParser) quotient() (Factor, error) {
+	left, err := p.product()
+	if err != nil {
+		return Factor{}, err
+	}
+	for p.isOp("*/") {
+		op := p.token.text
+		if err := p.next(); err != nil {
+			return Factor{}, err
+		}
+		right, err := p.product()
+		if err != nil {
+			return Factor{}, err
+		}
+		if op == "*" {
+			left = left.Mul(right)
+		} else if right.Value.Sign() == 0 {
+			return Factor{}, p.errorf("division by zero")
+		} else {
+			left = left.Quo(right)
+		}
+	}
+	return left, nil
+}
+
+func (p *factorParser) product() (Factor, error) {
+	left, err := p.power()
+	if err != nil {
+		return Factor{}, err
+	}
+	for p.token.kind == tokenNumber || p.token.kind == tokenIdent || p.isOp("(") {
+		right, err := p.power()
+		if err != nil {
+			return Factor{}, err
+		}
+		left = left.Mul(right)
+	}
+	return left, nil
+}
+
+func (p *factorParser) power() (Factor, error) {
+	base, err := p.primary()
+	if err != nil {
+		return Factor{}, err
+	}
+	if !p.isOp("^") {
+		return base, nil
+	}
+	if err := p.next(); err != nil {
+		return Factor{}, err
+	}
+
+	sign := 1
+	if p.isOp("-") {
+		sign = -1
+		if err := p.next(); err != nil {
+			return Factor{}, err
+		}
+	}
+	n, err := strconv.Atoi(p.token.text)
+	if p.token.kind != tokenNumber || err != nil {
+		return Factor{}, p.errorf("expected an integer exponent but found %q", p.token.text)
+	}
+	if base.Value.Sign() == 0 && sign < 0 {
+		return Factor{}, p.errorf("division by zero")
+	}
+	return base.Pow(sign * n), p.next()
+}
+
+func (p *factorParser) primary() (Factor, error) {
+	tok := p.token
+	switch {
+	case tok.kind == tokenNumber:
+		n, err := parseNumber(tok.text)
+		if err != nil {
+			return Factor{}, p.errorf("%s", err)
+		}
+		value, _ := new(big.Rat).SetString(n.Literal)
+		return Factor{Value: value}, p.next()
+	case tok.kind == tokenIdent:
+		f, err := p.resolve(tok.text)
+		if err != nil {
+			return Factor{}, p.errorf("%s", err)
+		}
+		return f, p.next()
+	case p.isOp("("):
+		if err := p.next(); err != nil {
+			return Factor{}, err
+		}
+		f, err := p.quotient()
+		if err != nil {
+			return Factor{}, err
+		}
+		if !p.isOp(")") {
+			return Factor{}, p.errorf("expected \")\" but found %q", p.token.text)
+		}
+		return f, p.next()
+	default:
+		return Factor{}, p.errorf("expected a number, unit or \"(\" but found %q", tok.text)
+	}
+}
+
+// ExternalResolver resolves a definedAs reference of a unit in d which isn't
+// declared in the same UnitsYaml, eg. a compiled-in unit at runtime
+type ExternalResolver func(d *Definition, ref string) (Factor, error)
+
+// ResolveFactors works out the exact factor to its base of every unit. Units
+// with a definedAs are resolved through the chain of units they reference and
+// given conversions using the nearest float64 in each direction. References
+// which aren't in uy are passed to external, if it isn't nil.
+func (uy *UnitsYaml) ResolveFactors(external ExternalResolver) error {
+	type key struct{ def, unit int }
+	const (
+		visiting = iota + 1
+		done
+	)
+	state := map[key]int{}
+
+	var resolveUnit func(di, ui int) error
+	reference := func(di, ui int) (Factor, error) {
+		if err := resolveUnit(di, ui); err != nil {
+			return Factor{}, err
+		}
+		d := &uy.Definitions[di]
+		u := &d.Units[ui]
+		if u.Factor == nil {
+			return Factor{}, fmt.Errorf("%s -> %s is not linear so it can't be referenced", d.Type, u.Name)
+		}
+		return Factor{Value: u.Factor, Dimension: d.Dimension}, nil
+	}
+
+	resolveUnit = func(di, ui int) error {
+		d := &uy.Definitions[di]
+		u := &d.Units[ui]
+		k := key{di, ui}
+
+		switch state[k] {
+		case visiting:
+			return fmt.Errorf("%s -> %s: definedAs refers back to itself", d.Type, u.Name)
+		case done:
+			return nil
+		}
+		state[k] = visiting
+		defer func() { state[k] = done }()
+
+		if u.DefinedAs == "" {
+			if factor, ok := u.From.Factor(); ok {
+				u.Factor = new(big.Rat).Inv(factor)
+			}
+			return nil
+		}
+
+		f, err := ParseFactor(u.DefinedAs, func(ref string) (Factor, error) {
+			for ddi := range uy.Definitions {
+				dd := &uy.Definitions[ddi]
+				for uui := range dd.Units {
+					title := dd.StructName() + "_" + dd.Units[uui].Title()
+					if ref == title || (ddi == di && ref == dd.Units[uui].Title()) {
+						return reference(ddi, uui)
+					}
+				}
+			}
+			if external != nil {
+				return external(d, ref)
+			}
+			return Factor{}, fmt.Errorf("unknown unit %q", ref)
+		})
+		if err != nil {
+			return fmt.Errorf("%s -> %s: definedAs: %w", d.Type, u.Name, err)
+		}
+		if f.Dimension != d.Dimension {
+			return fmt.Errorf("%s -> %s: definedAs %q has dimension %s, not %s",
+				d.Type, u.Name, u.DefinedAs, f.Dimension.GoLiteral(), d.Dimension.GoLiteral())
+		}
+		if f.Value.Sign() <= 0 {
+			return fmt.Errorf("%s -> %s: definedAs %q must be positive", d.Type, u.Name, u.DefinedAs)
+		}
+
+		u.Factor = f.Value
+		u.From = LinearConversion("v", new(big.Rat).Inv(f.Value))
+		u.To = LinearConversion("v", f.Value)
+		u.FromBase, u.ToBase = u.From.Source, u.To.Source
+		return nil
+	}
+
+	for di := range uy.Definitions {
+		for ui := range uy.Definitions[di].Units {
+			if err := resolveUnit(di, ui); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package schema
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+// parseYaml unmarshals source and parses its conversions as the generator does
+func parseYaml(t *testing.T, source string) *UnitsYaml {
+	t.Helper()
+	var uy UnitsYaml
+	if err := yaml.Unmarshal([]byte(source), &uy); err != nil {
+		t.Fatal(err)
+	}
+	if err := uy.ExpandPrefixes(); err != nil {
+		t.Fatal(err)
+	}
+	if err := uy.ParseConversions(); err != nil {
+		t.Fatal(err)
+	}
+	return &uy
+}
+
+const lengthYaml = `
+definitions:
+  - type: Length
+    baseUnit: Meters
+    dimension:
+      length: 1
+    units:
+      - name: Meters
+        fromBase: m => m
+      - name: Feet
+        definedAs: 0.3048 Meters
+      - name: Yards
+        definedAs: 3 Feet
+      - name: Miles
+        definedAs: 1760 Length_Yards
+  - type: Volume
+    baseUnit: Cubic Meters
+    dimension:
+      length: 3
+    units:
+      - name: Cubic Meters
+        fromBase: v => v
+      - name: Cubic Feet
+        definedAs: (Length_Feet)^3
+      - name: Steres
+        definedAs: Length_Meters^3 / Length_Feet^2 * Length_Feet^2
+`
+
+func TestResolveFactors(t *testing.T) {
+	uy := parseYaml(t, lengthYaml)
+	if err := uy.ResolveFactors(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"Meters":       "1",
+		"Feet":         "381/1250",
+		"Yards":        "1143/1250",
+		"Miles":        "201168/125",
+		"Cubic Meters": "1",
+		"Cubic Feet":   "55306341/1953125000",
+		"Steres":       "1",
+	}
+	for _, d := range uy.Definitions {
+		for _, u := range d.Units {
+			if u.Factor == nil || u.Factor.RatString() != want[u.Name] {
+				t.Errorf("%s factor = %v, want %s", u.Name, u.Factor, want[u.Name])
+			}
+		}
+	}
+	if miles := uy.Definitions[0].Units[3]; miles.ToBase != "v => v * 1609.344" {
+		t.Errorf("Miles toBase = %q", miles.ToBase)
+	}
+}
+
+func TestResolveFactorsErrors(t *testing.T) {
+	tests := map[string]string{
+		"refers back to itself": `
+definitions:
+  - type: Length
+    baseUnit: Meters
+    dimension:
+      length: 1
+    units:
+      - name: Meters
+        fromBase: m => m
+      - name: Feet
+        definedAs: 3 Yards
+      - name: Yards
+        definedAs: Feet / 3
+`,
+		"unknown unit \"Furlongs\"": `
+definitions:
+  - type: Length
+    baseUnit: Meters
+    dimension:
+      length: 1
+    units:
+      - name: Meters
+        fromBase: m => m
+      - name: Feet
+        definedAs: 0.001 Furlongs
+`,
+		"has dimension Dimension{Length: 2}": `
+definitions:
+  - type: Length
+    baseUnit: Meters
+    dimension:
+      length: 1
+    units:
+      - name: Meters
+        fromBase: m => m
+      - name: Feet
+        definedAs: Meters^2
+`,
+		"is not linear so it can't be referenced": `
+definitions:
+  - type: Temperature
+    baseUnit: Celsius
+    dimension:
+      temperature: 1
+    units:
+      - name: Celsius
+        fromBase: c => c
+      - name: Fahrenheit
+        fromBase: c => (c * 9 / 5) + 32
+      - name: Rankine
+        definedAs: Fahrenheit
+`,
+	}
+	for want, source := range tests {
+		uy := parseYaml(t, source)
+		if err := uy.ResolveFactors(nil); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("ResolveFactors() = %v, want %q", err, want)
+		}
+	}
+}
+
+func TestResolveFactorsExternal(t *testing.T) {
+	uy := parseYaml(t, `
+definitions:
+  - type: Length
+    baseUnit: Meters
+    dimension:
+      length: 1
+    units:
+      - name: Meters
+        fromBase: m => m
+      - name: Chains
+        definedAs: 22 Length_Yards
+`)
+	var asked []string
+	err := uy.ResolveFactors(func(d *Definition, ref string) (Factor, error) {
+		asked = append(asked, d.Type+" "+ref)
+		return Factor{Value: big.NewRat(1143, 1250), Dimension: Dimension{Length: 1}}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(asked) != 1 || asked[0] != "Length Length_Yards" {
+		t.Errorf("external resolver was asked for %q", asked)
+	}
+	if got := uy.Definitions[0].Units[1].Factor.RatString(); got != "12573/625" {
+		t.Errorf("Chains factor = %s", got)
+	}
+}
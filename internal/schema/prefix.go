@@ -0,0 +1,130 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Prefix is an SI prefix which a unit can declare in its prefixes
+type Prefix struct {
+	Symbol   string
+	Name     string
+	Exponent int
+}
+
+// Prefixes are the SI prefixes by symbol
+var Prefixes = map[string]Prefix{
+	"y":  {"y", "Yocto", -24},
+	"z":  {"z", "Zepto", -21},
+	"a":  {"a", "Atto", -18},
+	"f":  {"f", "Femto", -15},
+	"p":  {"p", "Pico", -12},
+	"n":  {"n", "Nano", -9},
+	"µ":  {"µ", "Micro", -6},
+	"m":  {"m", "Milli", -3},
+	"c":  {"c", "Centi", -2},
+	"d":  {"d", "Deci", -1},
+	"da": {"da", "Deca", 1},
+	"h":  {"h", "Hecto", 2},
+	"k":  {"k", "Kilo", 3},
+	"M":  {"M", "Mega", 6},
+	"G":  {"G", "Giga", 9},
+	"T":  {"T", "Tera", 12},
+	"P":  {"P", "Peta", 15},
+	"E":  {"E", "Exa", 18},
+	"Z":  {"Z", "Zetta", 21},
+	"Y":  {"Y", "Yotta", 24},
+}
+
+// ExpandPrefixes adds a unit for each of the prefixes of every unit, right
+// after it. A prefixed unit is definedAs a power of ten of its unit, so the
+// unit must be linear, and matches the prefixed symbol exactly, eg. "MPa",
+// as well as the prefixed name of each of the unit's matches which isn't
+// its symbol, eg. "megapascals". The prefixed symbol is also matched in lower
+// case, eg. "kpa", unless the prefix is the same letter as another SI prefix
+// in another case, so "mPa" and "MPa" never match each other's unit, even
+// when the unit only declares one of them.
+func (uy *UnitsYaml) ExpandPrefixes() error {
+	for di := range uy.Definitions {
+		d := &uy.Definitions[di]
+
+		titles := map[string]bool{}
+		for _, u := range d.Units {
+			titles[u.Title()] = true
+		}
+
+		var units []Unit
+		for _, u := range d.Units {
+			units = append(units, u)
+			if len(u.Prefixes) == 0 {
+				continue
+			}
+			if u.Symbol == "" {
+				return fmt.Errorf("%s -> %s: prefixes need a symbol to prefix", d.Type, u.Name)
+			}
+
+			for _, symbol := range u.Prefixes {
+				p, ok := Prefixes[symbol]
+				if !ok {
+					return fmt.Errorf("%s -> %s: unknown prefix %q", d.Type, u.Name, symbol)
+				}
+				pu := prefixUnit(&u, p)
+				if titles[pu.Title()] {
+					return fmt.Errorf("%s -> %s: prefix %q makes %s, which is already declared", d.Type, u.Name, symbol, pu.Name)
+				}
+				titles[pu.Title()] = true
+				units = append(units, pu)
+			}
+		}
+		d.Units = units
+	}
+	return nil
+}
+
+// prefixUnit returns u with the prefix p
+func prefixUnit(u *Unit, p Prefix) Unit {
+	pu := Unit{
+		Name:         p.Name + lowerFirst(u.Name),
+		Symbol:       p.Symbol + u.Symbol,
+		DefinedAs:    fmt.Sprintf("10^%d %s", p.Exponent, u.Title()),
+		ExactMatches: []string{strings.Join(strings.Fields(p.Symbol+u.Symbol), "")},
+		Prefix:       p.Symbol,
+		PrefixOf:     u.Name,
+	}
+
+	symbol := sanitize(u.Symbol)
+	if p.foldable() {
+		pu.Matches = append(pu.Matches, sanitize(pu.Symbol))
+	}
+	for _, m := range u.Matches {
+		if m != symbol && m != "*" {
+			pu.Matches = append(pu.Matches, strings.ToLower(p.Name)+m)
+		}
+	}
+	return pu
+}
+
+// foldable is true when no other SI prefix differs from p only by case, as
+// m and M, p and P, z and Z or y and Y do
+func (p Prefix) foldable() bool {
+	for symbol := range Prefixes {
+		if symbol != p.Symbol && strings.EqualFold(symbol, p.Symbol) {
+			return false
+		}
+	}
+	return true
+}
+
+// lowerFirst lower cases the first letter of a name so it can follow a
+// prefix, eg. "Volts" becomes "volts" in "Millivolts"
+func lowerFirst(name string) string {
+	r, size := utf8.DecodeRuneInString(name)
+	return string(unicode.ToLower(r)) + name[size:]
+}
+
+// sanitize is SanitizeString of the generated package
+func sanitize(input string) string {
+	return strings.Join(strings.Fields(strings.ToLower(input)), "")
+}
@@ -0,0 +1,223 @@
+// Package schema is the format of units.yaml, shared by the generator and
+// the runtime Registry so both read definitions the same way
+package schema
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Title returns the Go identifier form of a name, eg. "Gallons (U.S. Fluid)" is
+// "GallonsUSFluid"
+func Title(x string) string {
+	tmp := strings.ReplaceAll(x, "-", " ")
+	r := strings.NewReplacer(".", "", "(", "", ")", "")
+	tmp = r.Replace(tmp)
+	components := strings.Split(tmp, " ")
+	result := ""
+	for _, component := range components {
+		result = result + strings.Title(component)
+	}
+	return result
+}
+
+type Unit struct {
+	Name     string   `yaml:"name"`
+	Symbol   string   `yaml:"symbol"`
+	FromBase string   `yaml:"fromBase"`
+	ToBase   string   `yaml:"toBase"`
+	Matches  []string `yaml:"matches"`
+	// DefinedAs defines the unit relative to others instead of by FromBase,
+	// eg. "(0.3048 Length_Meters)^3", see ResolveFactors
+	DefinedAs string `yaml:"definedAs"`
+	// Prefixes are the SI prefixes the unit is also available with, eg.
+	// [m, k, M], see ExpandPrefixes
+	Prefixes []string `yaml:"prefixes"`
+	// ExactMatches are compared case sensitively once whitespace is removed,
+	// unlike Matches, so "mPa" and "MPa" can be told apart
+	ExactMatches []string `yaml:"-"`
+	// Prefix and PrefixOf are the prefix symbol and the name of the unit a
+	// unit made by ExpandPrefixes was made from, eg. "k" and "Pascals"
+	Prefix   string `yaml:"-"`
+	PrefixOf string `yaml:"-"`
+
+	// From and To are the parsed FromBase and ToBase, see ParseConversions
+	From *Conversion `yaml:"-"`
+	To   *Conversion `yaml:"-"`
+	// Factor is the exact number of base units in one of this unit, or nil
+	// when the unit is affine
+	Factor *big.Rat `yaml:"-"`
+}
+
+func (u *Unit) Title() string {
+	return Title(u.Name)
+}
+
+func (u *Unit) StructName(defName string) string {
+	return u.Title() + defName
+}
+
+func (u *Unit) VarName(defName string) string {
+	return u.StructName(defName) + "Unit"
+}
+
+// Dimension is the exponent of each SI base dimension of a Definition
+type Dimension struct {
+	Mass        int `yaml:"mass"`
+	Length      int `yaml:"length"`
+	Time        int `yaml:"time"`
+	Temperature int `yaml:"temperature"`
+	Current     int `yaml:"current"`
+	Amount      int `yaml:"amount"`
+}
+
+// GoLiteral returns the generated package's Dimension literal for d, eg.
+// "Dimension{Length: 3, Time: -1}", leaving out the zero exponents
+func (d Dimension) GoLiteral() string {
+	var fields []string
+	for _, f := range []struct {
+		name string
+		exp  int
+	}{
+		{"Mass", d.Mass},
+		{"Length", d.Length},
+		{"Time", d.Time},
+		{"Temperature", d.Temperature},
+		{"Current", d.Current},
+		{"Amount", d.Amount},
+	} {
+		if f.exp != 0 {
+			fields = append(fields, fmt.Sprintf("%s: %d", f.name, f.exp))
+		}
+	}
+	return "Dimension{" + strings.Join(fields, ", ") + "}"
+}
+
+type Definition struct {
+	Type           string    `yaml:"type"`
+	BaseUnit       string    `yaml:"baseUnit"`
+	Dimension      Dimension `yaml:"dimension"`
+	DifferenceType *string   `yaml:"differenceType"`
+	Matches        []string  `yaml:"matches"`
+	Units          []Unit    `yaml:"units"`
+	CopyUnits      *string   `yaml:"copyUnits"`
+	Base           Unit
+}
+
+func (d *Definition) StructName() string {
+	return Title(d.Type)
+}
+
+func (d *Definition) VarName() string {
+	return d.StructName() + "UnitType"
+}
+
+// defaultTolerance is used when units.yaml doesn't declare a tolerance
+const defaultTolerance = 1e-9
+
+type UnitsYaml struct {
+	Version     string       `yaml:"version"`
+	Tolerance   float64      `yaml:"tolerance"`
+	Definitions []Definition `yaml:"definitions"`
+}
+
+// ConversionTolerance returns the declared tolerance or defaultTolerance
+func (uy *UnitsYaml) ConversionTolerance() float64 {
+	if uy.Tolerance == 0 {
+		return defaultTolerance
+	}
+	return uy.Tolerance
+}
+
+// ParseConversions parses the fromBase and toBase of every unit, reporting
+// the first that is invalid along with where it's declared. A missing toBase
+// is derived from fromBase, otherwise the two are checked to be inverses.
+func (uy *UnitsYaml) ParseConversions() error {
+	tolerance := uy.ConversionTolerance()
+
+	for _, d := range uy.Definitions {
+		for idx := range d.Units {
+			u := &d.Units[idx]
+			if u.DefinedAs != "" {
+				if u.FromBase != "" || u.ToBase != "" {
+					return fmt.Errorf("%s -> %s: definedAs can't be combined with fromBase or toBase", d.Type, u.Name)
+				}
+				continue
+			}
+
+			from, err := ParseConversion(u.FromBase)
+			if err != nil {
+				return fmt.Errorf("%s -> %s: fromBase: %w", d.Type, u.Name, err)
+			}
+
+			var to *Conversion
+			if u.ToBase == "" {
+				to, err = from.Inverse("v")
+				if err != nil {
+					return fmt.Errorf("%s -> %s: toBase: %w", d.Type, u.Name, err)
+				}
+				u.ToBase = to.Source
+			} else {
+				to, err = ParseConversion(u.ToBase)
+				if err != nil {
+					return fmt.Errorf("%s -> %s: toBase: %w", d.Type, u.Name, err)
+				}
+				if err := from.VerifyInverse(to, tolerance); err != nil {
+					return fmt.Errorf("%s -> %s: %w", d.Type, u.Name, err)
+				}
+			}
+			u.From, u.To = from, to
+		}
+	}
+	return nil
+}
+
+// Definition returns the definition with the given type, or nil
+func (uy *UnitsYaml) Definition(typeName string) *Definition {
+	for idx := range uy.Definitions {
+		if uy.Definitions[idx].Type == typeName {
+			return &uy.Definitions[idx]
+		}
+	}
+	return nil
+}
+
+// CheckDifferenceTypes returns an error if the differenceType of a definition
+// is neither declared in uy nor, when known isn't nil, known to it, eg. as a
+// compiled-in type at runtime
+func (uy *UnitsYaml) CheckDifferenceTypes(known func(typeName string) bool) error {
+	for _, d := range uy.Definitions {
+		if d.DifferenceType == nil || uy.Definition(*d.DifferenceType) != nil {
+			continue
+		}
+		if known == nil || !known(*d.DifferenceType) {
+			return fmt.Errorf("%s: unknown differenceType %s", d.Type, *d.DifferenceType)
+		}
+	}
+	return nil
+}
+
+// ResolveUnitTypeCopies gives every definition with a copyUnits the units of
+// its parent, which must be declared before it
+func (uy *UnitsYaml) ResolveUnitTypeCopies() error {
+	cache := make(map[string]Definition)
+
+	for idx, d := range uy.Definitions {
+		if d.CopyUnits != nil {
+			parent, ok := cache[*d.CopyUnits]
+			if !ok {
+				return fmt.Errorf("%s: copyUnits %s must be declared before it", d.Type, *d.CopyUnits)
+			}
+
+			d.Units = parent.Units
+			if d.Dimension == (Dimension{}) {
+				d.Dimension = parent.Dimension
+			}
+			uy.Definitions[idx] = d
+		}
+
+		cache[d.Type] = d
+	}
+	return nil
+}
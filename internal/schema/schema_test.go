@@ -0,0 +1,35 @@
+package schema
+
+import "testing"
+
+func TestCheckDifferenceTypes(t *testing.T) {
+	uy := parseYaml(t, `
+definitions:
+  - type: Temperature
+    baseUnit: Degrees Celsius
+    differenceType: Temperature Difference
+    units:
+      - name: Degrees Celsius
+        fromBase: v => v
+  - type: Temperature Difference
+    baseUnit: Degrees Celsius
+    units:
+      - name: Degrees Celsius
+        fromBase: v => v
+  - type: Site Temperature
+    baseUnit: Site Degrees
+    differenceType: Site Temperature Difference
+    units:
+      - name: Site Degrees
+        fromBase: v => v
+`)
+	err := uy.CheckDifferenceTypes(nil)
+	if err == nil || err.Error() != "Site Temperature: unknown differenceType Site Temperature Difference" {
+		t.Errorf("CheckDifferenceTypes(nil) = %v", err)
+	}
+
+	known := func(typeName string) bool { return typeName == "Site Temperature Difference" }
+	if err := uy.CheckDifferenceTypes(known); err != nil {
+		t.Errorf("CheckDifferenceTypes(known) = %v", err)
+	}
+}
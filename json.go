@@ -0,0 +1,176 @@
+package units
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// quantityJSON is the canonical JSON and YAML form of a Quantity
+type quantityJSON struct {
+	Value float64 `json:"value" yaml:"value"`
+	Unit  string  `json:"unit" yaml:"unit"`
+}
+
+// quantityFields are the fields of the object form of a Quantity as they're
+// read, where either may be missing
+type quantityFields struct {
+	Value *float64 `json:"value" yaml:"value"`
+	Unit  string   `json:"unit" yaml:"unit"`
+}
+
+// MarshalJSON implements json.Marshaler. The unit is written as its
+// AlakaTitle, eg. {"value":150,"unit":"Pressure_PoundsPerSquareInch"}. A
+// Quantity without a unit is written as null.
+func (q Quantity) MarshalJSON() ([]byte, error) {
+	if q.Unit == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(quantityJSON{Value: q.Value, Unit: AlakaTitle(q.TypeOf(), q.Unit)})
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts the canonical object
+// written by MarshalJSON or a string such as "150 psi", which is parsed with
+// ParseAnyQuantity. Whatever q held before is ignored, so bare numbers are an
+// error; see QuantityIn and UnmarshalQuantitiesIn to read them in a default
+// unit. Unknown units are always an error, never Number.
+func (q *Quantity) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+	parsed, err := unmarshalQuantity(data, nil)
+	if err != nil {
+		return err
+	}
+	*q = parsed
+	return nil
+}
+
+// QuantityIn is a Quantity which is read from JSON with a default unit. Bare
+// numbers, and objects without a unit, are read in DefaultUnit, while
+// strings and objects with a unit must be of the same UnitType. Decoding
+// leaves DefaultUnit alone, so a QuantityIn can be reused for every record.
+type QuantityIn struct {
+	Quantity
+	DefaultUnit Unit
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (q *QuantityIn) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+	parsed, err := unmarshalQuantity(data, q.DefaultUnit)
+	if err != nil {
+		return err
+	}
+	q.Quantity = parsed
+	return nil
+}
+
+// UnmarshalQuantitiesIn decodes a JSON array or object of quantities into v,
+// which must be a *[]Quantity or a *map[string]Quantity, reading each element
+// as QuantityIn does with def as the default unit
+func UnmarshalQuantitiesIn(data []byte, v interface{}, def Unit) error {
+	switch v := v.(type) {
+	case *[]Quantity:
+		var raw []json.RawMessage
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return err
+		}
+		out := make([]Quantity, len(raw))
+		for idx, element := range raw {
+			q, err := unmarshalQuantityIn(element, def)
+			if err != nil {
+				return fmt.Errorf("units: element %d: %w", idx, err)
+			}
+			out[idx] = q
+		}
+		*v = out
+	case *map[string]Quantity:
+		var raw map[string]json.RawMessage
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return err
+		}
+		out := make(map[string]Quantity, len(raw))
+		for key, element := range raw {
+			q, err := unmarshalQuantityIn(element, def)
+			if err != nil {
+				return fmt.Errorf("units: element %q: %w", key, err)
+			}
+			out[key] = q
+		}
+		*v = out
+	default:
+		return fmt.Errorf("units: cannot unmarshal quantities into %T", v)
+	}
+	return nil
+}
+
+// unmarshalQuantityIn is QuantityIn.UnmarshalJSON for a single element, where
+// null is the zero Quantity
+func unmarshalQuantityIn(data []byte, def Unit) (Quantity, error) {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return Quantity{}, nil
+	}
+	return unmarshalQuantity(data, def)
+}
+
+// unmarshalQuantity reads any of the JSON forms of a Quantity, with def as the
+// default unit when it isn't nil
+func unmarshalQuantity(data []byte, def Unit) (Quantity, error) {
+	data = bytes.TrimSpace(data)
+	switch {
+	case len(data) > 0 && data[0] == '{':
+		return unmarshalObject(data, def)
+	case len(data) > 0 && data[0] == '"':
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return Quantity{}, err
+		}
+		if def != nil {
+			return ParseQuantity(s, def.TypeOf())
+		}
+		return ParseAnyQuantity(s)
+	default:
+		var v float64
+		if err := json.Unmarshal(data, &v); err != nil {
+			return Quantity{}, fmt.Errorf("units: cannot unmarshal %s into a Quantity", data)
+		}
+		if def == nil {
+			return Quantity{}, fmt.Errorf("units: cannot unmarshal bare number %s without a default unit", data)
+		}
+		return Quantity{Value: v, Unit: def}, nil
+	}
+}
+
+func unmarshalObject(data []byte, def Unit) (Quantity, error) {
+	var raw quantityFields
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return Quantity{}, err
+	}
+	return raw.quantity(string(data), def)
+}
+
+// quantity returns the Quantity of the fields, with def as the default unit
+// when it isn't nil. source describes the object in errors.
+func (raw quantityFields) quantity(source string, def Unit) (Quantity, error) {
+	if raw.Value == nil {
+		return Quantity{}, fmt.Errorf("units: quantity %s is missing a value", source)
+	}
+
+	u := def
+	if raw.Unit != "" {
+		var err error
+		if _, u, err = LookupTypeUnit(raw.Unit); err != nil {
+			return Quantity{}, err
+		}
+	}
+	if u == nil {
+		return Quantity{}, fmt.Errorf("units: quantity %s is missing a unit", source)
+	}
+	if def != nil && !SameType(def, u) {
+		return Quantity{}, &TypeMismatchError{From: u.TypeOf(), To: def.TypeOf()}
+	}
+	return Quantity{Value: *raw.Value, Unit: u}, nil
+}
@@ -0,0 +1,115 @@
+package units
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestQuantityJSON(t *testing.T) {
+	data, err := json.Marshal(NewQuantity(150, PoundsPerSquareInchPressureUnit))
+	if err != nil || string(data) != `{"value":150,"unit":"Pressure_PoundsPerSquareInch"}` {
+		t.Errorf("Marshal(150 psi) = %s, %v", data, err)
+	}
+
+	tests := map[string]Quantity{
+		`{"value":150,"unit":"Pressure_PoundsPerSquareInch"}`: NewQuantity(150, PoundsPerSquareInchPressureUnit),
+		`"12.5 kPa"`: NewQuantity(12.5, KilopascalsPressureUnit),
+		`null`:       {},
+	}
+	for input, want := range tests {
+		var q Quantity
+		if err := json.Unmarshal([]byte(input), &q); err != nil || q != want {
+			t.Errorf("Unmarshal(%s) = %v, %v, want %v", input, q, err, want)
+		}
+	}
+
+	// A unit left over from an earlier value isn't a default
+	q := NewQuantity(1, PascalsPressureUnit)
+	for _, input := range []string{`150`, `{"value":150}`, `"12.5 psig"`, `{"value":1,"unit":"Pressure_Nope"}`} {
+		if err := json.Unmarshal([]byte(input), &q); err == nil {
+			t.Errorf("Unmarshal(%s) = %v, want an error", input, q)
+		}
+	}
+}
+
+func TestQuantityInJSON(t *testing.T) {
+	var payload struct {
+		Reading QuantityIn `json:"reading"`
+	}
+	payload.Reading.DefaultUnit = KilopascalsPressureUnit
+
+	// Decoded in order into the same payload, so each must replace the last
+	tests := []struct {
+		input string
+		want  Quantity
+	}{
+		{`{"reading":150}`, NewQuantity(150, KilopascalsPressureUnit)},
+		{`{"reading":{"value":2,"unit":"Pressure_Pascals"}}`, NewQuantity(2, PascalsPressureUnit)},
+		{`{"reading":{"value":3}}`, NewQuantity(3, KilopascalsPressureUnit)},
+		{`{"reading":"5 psi"}`, NewQuantity(5, PoundsPerSquareInchPressureUnit)},
+		{`{"reading":7}`, NewQuantity(7, KilopascalsPressureUnit)},
+	}
+	for _, tc := range tests {
+		if err := json.Unmarshal([]byte(tc.input), &payload); err != nil || payload.Reading.Quantity != tc.want {
+			t.Errorf("Unmarshal(%s) = %v, %v, want %v", tc.input, payload.Reading.Quantity, err, tc.want)
+		}
+		if payload.Reading.DefaultUnit != KilopascalsPressureUnit {
+			t.Fatalf("Unmarshal(%s) changed the default unit", tc.input)
+		}
+	}
+
+	var mismatch *TypeMismatchError
+	if err := json.Unmarshal([]byte(`{"reading":{"value":1,"unit":"Length_Meters"}}`), &payload); !errors.As(err, &mismatch) {
+		t.Errorf("Unmarshal(1 m) = %v, want a TypeMismatchError", err)
+	}
+}
+
+func TestUnmarshalQuantitiesIn(t *testing.T) {
+	var list []Quantity
+	if err := UnmarshalQuantitiesIn([]byte(`[1, "2 psi", null, {"value":3}]`), &list, KilopascalsPressureUnit); err != nil {
+		t.Fatal(err)
+	}
+	want := []Quantity{NewQuantity(1, KilopascalsPressureUnit), NewQuantity(2, PoundsPerSquareInchPressureUnit), {}, NewQuantity(3, KilopascalsPressureUnit)}
+	if len(list) != len(want) {
+		t.Fatalf("UnmarshalQuantitiesIn() = %v, want %v", list, want)
+	}
+	for idx := range want {
+		if list[idx] != want[idx] {
+			t.Errorf("element %d = %v, want %v", idx, list[idx], want[idx])
+		}
+	}
+
+	var byWell map[string]Quantity
+	if err := UnmarshalQuantitiesIn([]byte(`{"A-1": 10, "B-2": "3 MPa"}`), &byWell, KilopascalsPressureUnit); err != nil {
+		t.Fatal(err)
+	}
+	if byWell["A-1"] != NewQuantity(10, KilopascalsPressureUnit) || byWell["B-2"] != NewQuantity(3, MegapascalsPressureUnit) {
+		t.Errorf("UnmarshalQuantitiesIn() = %v", byWell)
+	}
+
+	if err := UnmarshalQuantitiesIn([]byte(`[1, "2 m"]`), &list, KilopascalsPressureUnit); err == nil {
+		t.Error("UnmarshalQuantitiesIn() read a length as a pressure")
+	}
+	if err := UnmarshalQuantitiesIn([]byte(`[1]`), &byWell, KilopascalsPressureUnit); err == nil {
+		t.Error("UnmarshalQuantitiesIn() read an array into a map")
+	}
+	var q Quantity
+	if err := UnmarshalQuantitiesIn([]byte(`[1]`), &q, KilopascalsPressureUnit); err == nil {
+		t.Error("UnmarshalQuantitiesIn() accepted a *Quantity")
+	}
+}
+
+func TestGeneratedTypesJSON(t *testing.T) {
+	type reading struct {
+		P KilopascalsPressure
+	}
+	data, err := json.Marshal(reading{150})
+	if err != nil || string(data) != `{"P":150}` {
+		t.Errorf("Marshal(150 kPa) = %s, %v", data, err)
+	}
+	var got reading
+	if err := json.Unmarshal([]byte(`{"P":150}`), &got); err != nil || got.P != 150 {
+		t.Errorf("Unmarshal(150) = %v, %v", got, err)
+	}
+}
@@ -0,0 +1,105 @@
+import * as units from '../index'
+
+// File autogenerated from units.yaml (sha256 5bc3954acadf7f43).
+// Do not edit directly
+
+// roundTripSamples are converted to and from the base of every unit
+const roundTripSamples = [-1e6, -40, -1, 0, 1e-6, 1e-3, 1, 37.5, 1e3, 1e6, 1e9]
+
+// roundTripTolerance is relative, or absolute for samples under 1
+const roundTripTolerance = 1e-09
+
+// generatedUnits is every unit of every unit type
+const generatedUnits: Array<[units.alakaTitle, units.UnitType, units.Unit]> = [
+	['Pressure_Pascals', units.PressureUnitType, units.PascalsPressureUnit],
+	['Pressure_Kilopascals', units.PressureUnitType, units.KilopascalsPressureUnit],
+	['Pressure_Megapascals', units.PressureUnitType, units.MegapascalsPressureUnit],
+	['Pressure_PoundsPerSquareInch', units.PressureUnitType, units.PoundsPerSquareInchPressureUnit],
+	['Pressure_InchesOfWater', units.PressureUnitType, units.InchesOfWaterPressureUnit],
+	['Temperature_DegreesCelsius', units.TemperatureUnitType, units.DegreesCelsiusTemperatureUnit],
+	['Temperature_DegreesFahrenheit', units.TemperatureUnitType, units.DegreesFahrenheitTemperatureUnit],
+	['Temperature_Kelvins', units.TemperatureUnitType, units.KelvinsTemperatureUnit],
+	['TemperatureDifference_DegreesCelsius', units.TemperatureDifferenceUnitType, units.DegreesCelsiusTemperatureDifferenceUnit],
+	['TemperatureDifference_DegreesFahrenheit', units.TemperatureDifferenceUnitType, units.DegreesFahrenheitTemperatureDifferenceUnit],
+	['TemperatureDifference_Kelvins', units.TemperatureDifferenceUnitType, units.KelvinsTemperatureDifferenceUnit],
+	['Flow_CubicMetersPerSecond', units.FlowUnitType, units.CubicMetersPerSecondFlowUnit],
+	['Flow_CubicFeetPerSecond', units.FlowUnitType, units.CubicFeetPerSecondFlowUnit],
+	['Flow_ThousandCubicFeetPerDay', units.FlowUnitType, units.ThousandCubicFeetPerDayFlowUnit],
+	['Flow_GallonsUSFluidPerSecond', units.FlowUnitType, units.GallonsUSFluidPerSecondFlowUnit],
+	['Flow_GallonsUSFluidPerMinute', units.FlowUnitType, units.GallonsUSFluidPerMinuteFlowUnit],
+	['Flow_BarrelsPerSecond', units.FlowUnitType, units.BarrelsPerSecondFlowUnit],
+	['Flow_BarrelsPerMinute', units.FlowUnitType, units.BarrelsPerMinuteFlowUnit],
+	['Volume_CubicMeters', units.VolumeUnitType, units.CubicMetersVolumeUnit],
+	['Volume_CubicFeet', units.VolumeUnitType, units.CubicFeetVolumeUnit],
+	['Volume_ThousandsOfCubicFeet', units.VolumeUnitType, units.ThousandsOfCubicFeetVolumeUnit],
+	['Volume_CubicDecimeter', units.VolumeUnitType, units.CubicDecimeterVolumeUnit],
+	['Volume_Liter', units.VolumeUnitType, units.LiterVolumeUnit],
+	['Volume_GallonUSFluid', units.VolumeUnitType, units.GallonUSFluidVolumeUnit],
+	['Volume_BarrelsOfOil', units.VolumeUnitType, units.BarrelsOfOilVolumeUnit],
+	['Mass_Kilograms', units.MassUnitType, units.KilogramsMassUnit],
+	['Mass_Pounds', units.MassUnitType, units.PoundsMassUnit],
+	['MassFlow_KilogramsPerSecond', units.MassFlowUnitType, units.KilogramsPerSecondMassFlowUnit],
+	['MassFlow_PoundsPerSecond', units.MassFlowUnitType, units.PoundsPerSecondMassFlowUnit],
+	['MassFlow_PoundsPerMinute', units.MassFlowUnitType, units.PoundsPerMinuteMassFlowUnit],
+	['ElectricPotential_Volts', units.ElectricPotentialUnitType, units.VoltsElectricPotentialUnit],
+	['ElectricPotential_Millivolts', units.ElectricPotentialUnitType, units.MillivoltsElectricPotentialUnit],
+	['ElectricPotential_Kilovolts', units.ElectricPotentialUnitType, units.KilovoltsElectricPotentialUnit],
+	['ElectricPotentialLoaded_Volts', units.ElectricPotentialLoadedUnitType, units.VoltsElectricPotentialLoadedUnit],
+	['ElectricPotentialLoaded_Millivolts', units.ElectricPotentialLoadedUnitType, units.MillivoltsElectricPotentialLoadedUnit],
+	['ElectricPotentialLoaded_Kilovolts', units.ElectricPotentialLoadedUnitType, units.KilovoltsElectricPotentialLoadedUnit],
+	['ElectricPotentialUnloaded_Volts', units.ElectricPotentialUnloadedUnitType, units.VoltsElectricPotentialUnloadedUnit],
+	['ElectricPotentialUnloaded_Millivolts', units.ElectricPotentialUnloadedUnitType, units.MillivoltsElectricPotentialUnloadedUnit],
+	['ElectricPotentialUnloaded_Kilovolts', units.ElectricPotentialUnloadedUnitType, units.KilovoltsElectricPotentialUnloadedUnit],
+	['Percentage_Percent', units.PercentageUnitType, units.PercentPercentageUnit],
+	['Humidity_Percent', units.HumidityUnitType, units.PercentHumidityUnit],
+	['Alarm_Percent', units.AlarmUnitType, units.PercentAlarmUnit],
+	['Work_Joules', units.WorkUnitType, units.JoulesWorkUnit],
+	['Work_Kilojoules', units.WorkUnitType, units.KilojoulesWorkUnit],
+	['Work_Megajoules', units.WorkUnitType, units.MegajoulesWorkUnit],
+	['Work_InchPoundsForce', units.WorkUnitType, units.InchPoundsForceWorkUnit],
+	['Work_CubicFeetOfNaturalGas', units.WorkUnitType, units.CubicFeetOfNaturalGasWorkUnit],
+	['Work_BarrelsOfOilEquivalent', units.WorkUnitType, units.BarrelsOfOilEquivalentWorkUnit],
+	['Force_Newtons', units.ForceUnitType, units.NewtonsForceUnit],
+	['Force_Kilonewtons', units.ForceUnitType, units.KilonewtonsForceUnit],
+	['Force_PoundsForce', units.ForceUnitType, units.PoundsForceForceUnit],
+	['Force_KilogramsForce', units.ForceUnitType, units.KilogramsForceForceUnit],
+	['Length_Meters', units.LengthUnitType, units.MetersLengthUnit],
+	['Length_Millimeters', units.LengthUnitType, units.MillimetersLengthUnit],
+	['Length_Kilometers', units.LengthUnitType, units.KilometersLengthUnit],
+	['Length_Feet', units.LengthUnitType, units.FeetLengthUnit],
+	['Length_Inches', units.LengthUnitType, units.InchesLengthUnit],
+	['StrokeRate_StrokesPerSecond', units.StrokeRateUnitType, units.StrokesPerSecondStrokeRateUnit],
+	['Time_Seconds', units.TimeUnitType, units.SecondsTimeUnit],
+	['Time_Minutes', units.TimeUnitType, units.MinutesTimeUnit],
+	['Time_Hours', units.TimeUnitType, units.HoursTimeUnit],
+	['Time_Days', units.TimeUnitType, units.DaysTimeUnit],
+	['Number_Number', units.NumberUnitType, units.NumberNumberUnit],
+	['Overspeed_Number', units.OverspeedUnitType, units.NumberOverspeedUnit],
+	['Underspeed_Number', units.UnderspeedUnitType, units.NumberUnderspeedUnit],
+	['Totaliser_Number', units.TotaliserUnitType, units.NumberTotaliserUnit],
+	['WMLFlowRate_Number', units.WMLFlowRateUnitType, units.NumberWMLFlowRateUnit],
+]
+
+describe('generated units', () => {
+	test.each(generatedUnits)('%s round trips through its base', (_, _type, unit) => {
+		for (const x of roundTripSamples) {
+			const got = unit.toBase(unit.fromBase(x))
+			expect(Math.abs(got - x)).toBeLessThanOrEqual(roundTripTolerance * Math.max(1, Math.abs(x)))
+		}
+	})
+
+	test.each(generatedUnits)('%s resolves every match', (_, type, unit) => {
+		for (const m of [...unit.matchList, ...unit.exactMatchList]) {
+			expect(units.getUnit(m, type)).toBe(unit)
+		}
+	})
+
+	test.each(generatedUnits)('%s round trips through getTypeUnit', (title, type, unit) => {
+		expect(units.toAlakaTitle(type, unit)).toBe(title)
+		expect(units.getTypeUnit(title)).toEqual([type, unit])
+	})
+
+	test('AllUnitTypes lists every unit', () => {
+		expect(units.AllUnitTypes).toEqual(generatedUnits.map(([title]) => title))
+	})
+})
\ No newline at end of file
@@ -6,7 +6,7 @@
 // of hand copying hundreds of methods across a bunch of permutations
 // of the same thing.
 
-// File autogenerated on 2022-03-30 11:14:45.40214037 -0500 CDT m=+0.009060079.
+// File autogenerated from units.yaml (sha256 5bc3954acadf7f43).
 // Do not edit directly
 
 // Helper Types
@@ -27,6 +27,9 @@ export class Unit {
 	public readonly symbol: string
 	// matchList is a list of matching strings which should represent this unit in userland
 	public readonly matchList: string[]
+	// exactMatchList is a list of matching strings which are compared case
+	// sensitively, such as prefixed symbols where "mPa" isn't "MPa"
+	public readonly exactMatchList: string[]
 	// type returns the UnitType of this unit. You can access the BaseUnit from there
 	public readonly type: UnitType
 	// base returns the base Unit of this UnitType directly
@@ -50,12 +53,14 @@ export class Unit {
 		base: Unit | null,
 		fromBase: conversion,
 		toBase: conversion,
-		matches: matcher
+		matches: matcher,
+		exactMatchList: string[] = []
 	) {
 		this.title = title
 		this.name = name
 		this.symbol = symbol
 		this.matchList = matchList
+		this.exactMatchList = exactMatchList
 		this.type = type
 		if (base != null) {
 			this.base = base
@@ -118,10 +123,41 @@ export function toAlakaTitle (ut: UnitType, u: Unit): alakaTitle {
     return `${ut.title}_${u.title}`
 }
 
+// ErrUnknownType is thrown by lookupType when the input doesn't match any unit type
+export class ErrUnknownType extends Error {
+	// input is the sanitized string which failed to match
+	public readonly input: string
+
+	constructor (input: string) {
+		super(`units: unknown unit type "${input}"`)
+		this.name = 'ErrUnknownType'
+		this.input = input
+	}
+}
+
+// ErrUnknownUnit is thrown by lookupUnit and lookupTypeUnit when the input doesn't match any unit
+export class ErrUnknownUnit extends Error {
+	// input is the sanitized string which failed to match, or the alakaTitle
+	// as given to lookupTypeUnit, which matches it exactly
+	public readonly input: string
+	// type is the unit type that was searched, null when searching by alakaTitle
+	public readonly type: UnitType | null
+
+	constructor (input: string, type: UnitType | null) {
+		super(type === null
+			? `units: unknown unit "${input}"`
+			: `units: unknown ${type.title} unit "${input}"${didYouMean(input, type)}`)
+		this.name = 'ErrUnknownUnit'
+		this.input = input
+		this.type = type
+	}
+}
+
 // AllTypes is a list of all available types below
 export const AllTypes: unitTypeTitle[] = [
 	"Pressure",
     "Temperature",
+    "TemperatureDifference",
     "Flow",
     "Volume",
     "Mass",
@@ -136,6 +172,7 @@ export const AllTypes: unitTypeTitle[] = [
     "Force",
     "Length",
     "StrokeRate",
+    "Time",
     "Number",
     "Overspeed",
     "Underspeed",
@@ -147,20 +184,22 @@ export const AllTypes: unitTypeTitle[] = [
 export const AllUnits: { [index: unitTypeTitle]: unitTitle[] } = {
     "Pressure":                  ["Pascals","Kilopascals","Megapascals","PoundsPerSquareInch","InchesOfWater"],
     "Temperature":               ["DegreesCelsius","DegreesFahrenheit","Kelvins"],
+    "TemperatureDifference":     ["DegreesCelsius","DegreesFahrenheit","Kelvins"],
     "Flow":                      ["CubicMetersPerSecond","CubicFeetPerSecond","ThousandCubicFeetPerDay","GallonsUSFluidPerSecond","GallonsUSFluidPerMinute","BarrelsPerSecond","BarrelsPerMinute"],
     "Volume":                    ["CubicMeters","CubicFeet","ThousandsOfCubicFeet","CubicDecimeter","Liter","GallonUSFluid","BarrelsOfOil"],
     "Mass":                      ["Kilograms","Pounds"],
     "MassFlow":                  ["KilogramsPerSecond","PoundsPerSecond","PoundsPerMinute"],
-    "ElectricPotential":         ["Volts"],
-    "ElectricPotentialLoaded":   ["Volts"],
-    "ElectricPotentialUnloaded": ["Volts"],
+    "ElectricPotential":         ["Volts","Millivolts","Kilovolts"],
+    "ElectricPotentialLoaded":   ["Volts","Millivolts","Kilovolts"],
+    "ElectricPotentialUnloaded": ["Volts","Millivolts","Kilovolts"],
     "Percentage":                ["Percent"],
     "Humidity":                  ["Percent"],
     "Alarm":                     ["Percent"],
-    "Work":                      ["Joules","InchPoundsForce","CubicFeetOfNaturalGas","BarrelsOfOilEquivalent"],
-    "Force":                     ["Newtons","PoundsForce","KilogramsForce"],
-    "Length":                    ["Meters","Feet","Inches"],
+    "Work":                      ["Joules","Kilojoules","Megajoules","InchPoundsForce","CubicFeetOfNaturalGas","BarrelsOfOilEquivalent"],
+    "Force":                     ["Newtons","Kilonewtons","PoundsForce","KilogramsForce"],
+    "Length":                    ["Meters","Millimeters","Kilometers","Feet","Inches"],
     "StrokeRate":                ["StrokesPerSecond"],
+    "Time":                      ["Seconds","Minutes","Hours","Days"],
     "Number":                    ["Number"],
     "Overspeed":                 ["Number"],
     "Underspeed":                ["Number"],
@@ -179,6 +218,9 @@ export const AllUnitTypes: alakaTitle[] = [
     "Temperature_DegreesCelsius",
     "Temperature_DegreesFahrenheit",
     "Temperature_Kelvins",
+    "TemperatureDifference_DegreesCelsius",
+    "TemperatureDifference_DegreesFahrenheit",
+    "TemperatureDifference_Kelvins",
     "Flow_CubicMetersPerSecond",
     "Flow_CubicFeetPerSecond",
     "Flow_ThousandCubicFeetPerDay",
@@ -199,22 +241,37 @@ export const AllUnitTypes: alakaTitle[] = [
     "MassFlow_PoundsPerSecond",
     "MassFlow_PoundsPerMinute",
     "ElectricPotential_Volts",
+    "ElectricPotential_Millivolts",
+    "ElectricPotential_Kilovolts",
     "ElectricPotentialLoaded_Volts",
+    "ElectricPotentialLoaded_Millivolts",
+    "ElectricPotentialLoaded_Kilovolts",
     "ElectricPotentialUnloaded_Volts",
+    "ElectricPotentialUnloaded_Millivolts",
+    "ElectricPotentialUnloaded_Kilovolts",
     "Percentage_Percent",
     "Humidity_Percent",
     "Alarm_Percent",
     "Work_Joules",
+    "Work_Kilojoules",
+    "Work_Megajoules",
     "Work_InchPoundsForce",
     "Work_CubicFeetOfNaturalGas",
     "Work_BarrelsOfOilEquivalent",
     "Force_Newtons",
+    "Force_Kilonewtons",
     "Force_PoundsForce",
     "Force_KilogramsForce",
     "Length_Meters",
+    "Length_Millimeters",
+    "Length_Kilometers",
     "Length_Feet",
     "Length_Inches",
     "StrokeRate_StrokesPerSecond",
+    "Time_Seconds",
+    "Time_Minutes",
+    "Time_Hours",
+    "Time_Days",
     "Number_Number",
     "Overspeed_Number",
     "Underspeed_Number",
@@ -222,8 +279,8 @@ export const AllUnitTypes: alakaTitle[] = [
     "WMLFlowRate_Number",
 ]
 
-// getType returns the unit type which matches input or NumberUnitType
-export function getType (input: string): UnitType {
+// findType returns the unit type which matches input or undefined
+function findType (input: string): UnitType | undefined {
     switch (sanitizeString(input)) {
     case "pressure":
     	return PressureUnitType
@@ -231,6 +288,18 @@ export function getType (input: string): UnitType {
     	return TemperatureUnitType
     case "temp":
     	return TemperatureUnitType
+    case "temperaturedifference":
+    	return TemperatureDifferenceUnitType
+    case "tempdifference":
+    	return TemperatureDifferenceUnitType
+    case "temperaturedelta":
+    	return TemperatureDifferenceUnitType
+    case "tempdelta":
+    	return TemperatureDifferenceUnitType
+    case "deltat":
+    	return TemperatureDifferenceUnitType
+    case "δt":
+    	return TemperatureDifferenceUnitType
     case "flow":
     	return FlowUnitType
     case "flowrate":
@@ -287,6 +356,10 @@ export function getType (input: string): UnitType {
     	return StrokeRateUnitType
     case "stroke-rate":
     	return StrokeRateUnitType
+    case "time":
+    	return TimeUnitType
+    case "duration":
+    	return TimeUnitType
     case "*":
     	return NumberUnitType
     case "overspeed":
@@ -298,12 +371,40 @@ export function getType (input: string): UnitType {
     case "wmlflowrate":
     	return WMLFlowRateUnitType
     default:
-    	return NumberUnitType
+    	return undefined
     }
 }
 
-// getUnit returns the unit which matches input or NumberNumberUnit
-export function getUnit (input: string, typeOf: UnitType): Unit {
+// findUnit returns the unit which matches input or undefined
+function findUnit (input: string, typeOf: UnitType): Unit | undefined {
+    switch (typeOf.title + "->" + input.replace(WhitespaceRegex, '')) {
+    case "Pressure->kPa":
+    	return KilopascalsPressureUnit
+    case "Pressure->MPa":
+    	return MegapascalsPressureUnit
+    case "ElectricPotential->mV":
+    	return MillivoltsElectricPotentialUnit
+    case "ElectricPotential->kV":
+    	return KilovoltsElectricPotentialUnit
+    case "ElectricPotentialLoaded->mV":
+    	return MillivoltsElectricPotentialLoadedUnit
+    case "ElectricPotentialLoaded->kV":
+    	return KilovoltsElectricPotentialLoadedUnit
+    case "ElectricPotentialUnloaded->mV":
+    	return MillivoltsElectricPotentialUnloadedUnit
+    case "ElectricPotentialUnloaded->kV":
+    	return KilovoltsElectricPotentialUnloadedUnit
+    case "Work->kJ":
+    	return KilojoulesWorkUnit
+    case "Work->MJ":
+    	return MegajoulesWorkUnit
+    case "Force->kN":
+    	return KilonewtonsForceUnit
+    case "Length->mm":
+    	return MillimetersLengthUnit
+    case "Length->km":
+    	return KilometersLengthUnit
+    }
     const search = typeOf.title + "->" + sanitizeString(input)
     	switch (search) {
     case "Pressure->pa":
@@ -318,8 +419,6 @@ export function getUnit (input: string, typeOf: UnitType): Unit {
     	return KilopascalsPressureUnit
     case "Pressure->kilopascals":
     	return KilopascalsPressureUnit
-    case "Pressure->mpa":
-    	return MegapascalsPressureUnit
     case "Pressure->megapascal":
     	return MegapascalsPressureUnit
     case "Pressure->megapascals":
@@ -390,6 +489,44 @@ export function getUnit (input: string, typeOf: UnitType): Unit {
     	return KelvinsTemperatureUnit
     case "Temperature->degreekelvin":
     	return KelvinsTemperatureUnit
+    case "TemperatureDifference->δ°c":
+    	return DegreesCelsiusTemperatureDifferenceUnit
+    case "TemperatureDifference->δc":
+    	return DegreesCelsiusTemperatureDifferenceUnit
+    case "TemperatureDifference->deltac":
+    	return DegreesCelsiusTemperatureDifferenceUnit
+    case "TemperatureDifference->delta°c":
+    	return DegreesCelsiusTemperatureDifferenceUnit
+    case "TemperatureDifference->deltacelsius":
+    	return DegreesCelsiusTemperatureDifferenceUnit
+    case "TemperatureDifference->celsiusdifference":
+    	return DegreesCelsiusTemperatureDifferenceUnit
+    case "TemperatureDifference->degreescelsiusdifference":
+    	return DegreesCelsiusTemperatureDifferenceUnit
+    case "TemperatureDifference->δ°f":
+    	return DegreesFahrenheitTemperatureDifferenceUnit
+    case "TemperatureDifference->δf":
+    	return DegreesFahrenheitTemperatureDifferenceUnit
+    case "TemperatureDifference->deltaf":
+    	return DegreesFahrenheitTemperatureDifferenceUnit
+    case "TemperatureDifference->delta°f":
+    	return DegreesFahrenheitTemperatureDifferenceUnit
+    case "TemperatureDifference->deltafahrenheit":
+    	return DegreesFahrenheitTemperatureDifferenceUnit
+    case "TemperatureDifference->fahrenheitdifference":
+    	return DegreesFahrenheitTemperatureDifferenceUnit
+    case "TemperatureDifference->degreesfahrenheitdifference":
+    	return DegreesFahrenheitTemperatureDifferenceUnit
+    case "TemperatureDifference->δk":
+    	return KelvinsTemperatureDifferenceUnit
+    case "TemperatureDifference->deltak":
+    	return KelvinsTemperatureDifferenceUnit
+    case "TemperatureDifference->deltakelvin":
+    	return KelvinsTemperatureDifferenceUnit
+    case "TemperatureDifference->kelvindifference":
+    	return KelvinsTemperatureDifferenceUnit
+    case "TemperatureDifference->kelvinsdifference":
+    	return KelvinsTemperatureDifferenceUnit
     case "Flow->m³/s":
     	return CubicMetersPerSecondFlowUnit
     case "Flow->m³s":
@@ -496,6 +633,8 @@ export function getUnit (input: string, typeOf: UnitType): Unit {
     	return GallonsUSFluidPerSecondFlowUnit
     case "Flow->gallon/second":
     	return GallonsUSFluidPerSecondFlowUnit
+    case "Flow->gal/min":
+    	return GallonsUSFluidPerMinuteFlowUnit
     case "Flow->gal/m":
     	return GallonsUSFluidPerMinuteFlowUnit
     case "Flow->gals/m":
@@ -758,18 +897,48 @@ export function getUnit (input: string, typeOf: UnitType): Unit {
     	return VoltsElectricPotentialUnit
     case "ElectricPotential->v":
     	return VoltsElectricPotentialUnit
+    case "ElectricPotential->millivolt":
+    	return MillivoltsElectricPotentialUnit
+    case "ElectricPotential->millivolts":
+    	return MillivoltsElectricPotentialUnit
+    case "ElectricPotential->kv":
+    	return KilovoltsElectricPotentialUnit
+    case "ElectricPotential->kilovolt":
+    	return KilovoltsElectricPotentialUnit
+    case "ElectricPotential->kilovolts":
+    	return KilovoltsElectricPotentialUnit
     case "ElectricPotentialLoaded->volt":
     	return VoltsElectricPotentialLoadedUnit
     case "ElectricPotentialLoaded->volts":
     	return VoltsElectricPotentialLoadedUnit
     case "ElectricPotentialLoaded->v":
     	return VoltsElectricPotentialLoadedUnit
+    case "ElectricPotentialLoaded->millivolt":
+    	return MillivoltsElectricPotentialLoadedUnit
+    case "ElectricPotentialLoaded->millivolts":
+    	return MillivoltsElectricPotentialLoadedUnit
+    case "ElectricPotentialLoaded->kv":
+    	return KilovoltsElectricPotentialLoadedUnit
+    case "ElectricPotentialLoaded->kilovolt":
+    	return KilovoltsElectricPotentialLoadedUnit
+    case "ElectricPotentialLoaded->kilovolts":
+    	return KilovoltsElectricPotentialLoadedUnit
     case "ElectricPotentialUnloaded->volt":
     	return VoltsElectricPotentialUnloadedUnit
     case "ElectricPotentialUnloaded->volts":
     	return VoltsElectricPotentialUnloadedUnit
     case "ElectricPotentialUnloaded->v":
     	return VoltsElectricPotentialUnloadedUnit
+    case "ElectricPotentialUnloaded->millivolt":
+    	return MillivoltsElectricPotentialUnloadedUnit
+    case "ElectricPotentialUnloaded->millivolts":
+    	return MillivoltsElectricPotentialUnloadedUnit
+    case "ElectricPotentialUnloaded->kv":
+    	return KilovoltsElectricPotentialUnloadedUnit
+    case "ElectricPotentialUnloaded->kilovolt":
+    	return KilovoltsElectricPotentialUnloadedUnit
+    case "ElectricPotentialUnloaded->kilovolts":
+    	return KilovoltsElectricPotentialUnloadedUnit
     case "Percentage->%":
     	return PercentPercentageUnit
     case "Percentage->percent":
@@ -794,6 +963,16 @@ export function getUnit (input: string, typeOf: UnitType): Unit {
     	return JoulesWorkUnit
     case "Work->joules":
     	return JoulesWorkUnit
+    case "Work->kj":
+    	return KilojoulesWorkUnit
+    case "Work->kilojoule":
+    	return KilojoulesWorkUnit
+    case "Work->kilojoules":
+    	return KilojoulesWorkUnit
+    case "Work->megajoule":
+    	return MegajoulesWorkUnit
+    case "Work->megajoules":
+    	return MegajoulesWorkUnit
     case "Work->inlbf":
     	return InchPoundsForceWorkUnit
     case "Work->inch-poundsforce":
@@ -820,6 +999,12 @@ export function getUnit (input: string, typeOf: UnitType): Unit {
     	return NewtonsForceUnit
     case "Force->newtons":
     	return NewtonsForceUnit
+    case "Force->kn":
+    	return KilonewtonsForceUnit
+    case "Force->kilonewton":
+    	return KilonewtonsForceUnit
+    case "Force->kilonewtons":
+    	return KilonewtonsForceUnit
     case "Force->lbf":
     	return PoundsForceForceUnit
     case "Force->pounds-force":
@@ -842,6 +1027,16 @@ export function getUnit (input: string, typeOf: UnitType): Unit {
     	return MetersLengthUnit
     case "Length->meters":
     	return MetersLengthUnit
+    case "Length->millimeter":
+    	return MillimetersLengthUnit
+    case "Length->millimeters":
+    	return MillimetersLengthUnit
+    case "Length->km":
+    	return KilometersLengthUnit
+    case "Length->kilometer":
+    	return KilometersLengthUnit
+    case "Length->kilometers":
+    	return KilometersLengthUnit
     case "Length->ft":
     	return FeetLengthUnit
     case "Length->foot":
@@ -860,6 +1055,40 @@ export function getUnit (input: string, typeOf: UnitType): Unit {
     	return StrokesPerSecondStrokeRateUnit
     case "StrokeRate->s/s":
     	return StrokesPerSecondStrokeRateUnit
+    case "Time->s":
+    	return SecondsTimeUnit
+    case "Time->sec":
+    	return SecondsTimeUnit
+    case "Time->secs":
+    	return SecondsTimeUnit
+    case "Time->second":
+    	return SecondsTimeUnit
+    case "Time->seconds":
+    	return SecondsTimeUnit
+    case "Time->min":
+    	return MinutesTimeUnit
+    case "Time->mins":
+    	return MinutesTimeUnit
+    case "Time->minute":
+    	return MinutesTimeUnit
+    case "Time->minutes":
+    	return MinutesTimeUnit
+    case "Time->h":
+    	return HoursTimeUnit
+    case "Time->hr":
+    	return HoursTimeUnit
+    case "Time->hrs":
+    	return HoursTimeUnit
+    case "Time->hour":
+    	return HoursTimeUnit
+    case "Time->hours":
+    	return HoursTimeUnit
+    case "Time->d":
+    	return DaysTimeUnit
+    case "Time->day":
+    	return DaysTimeUnit
+    case "Time->days":
+    	return DaysTimeUnit
     case "Number->number":
     	return NumberNumberUnit
     case "Number->*":
@@ -881,13 +1110,12 @@ export function getUnit (input: string, typeOf: UnitType): Unit {
     case "WMLFlowRate->*":
     	return NumberWMLFlowRateUnit
     default:
-    	return NumberNumberUnit
+    	return undefined
     }
 }
 
-// getTypeUnit returns the unit type and unit which matches input or (NumberUnitType, NumberNumberUnit).
-// Opposite of AlakaTitle
-export function getTypeUnit (input: alakaTitle): [UnitType, Unit] {
+// findTypeUnit returns the unit type and unit which matches input or undefined
+function findTypeUnit (input: alakaTitle): [UnitType, Unit] | undefined {
     switch (input) {
     case "Pressure_Pascals":
     	return [PressureUnitType, PascalsPressureUnit]
@@ -905,6 +1133,12 @@ export function getTypeUnit (input: alakaTitle): [UnitType, Unit] {
     	return [TemperatureUnitType, DegreesFahrenheitTemperatureUnit]
     case "Temperature_Kelvins":
     	return [TemperatureUnitType, KelvinsTemperatureUnit]
+    case "TemperatureDifference_DegreesCelsius":
+    	return [TemperatureDifferenceUnitType, DegreesCelsiusTemperatureDifferenceUnit]
+    case "TemperatureDifference_DegreesFahrenheit":
+    	return [TemperatureDifferenceUnitType, DegreesFahrenheitTemperatureDifferenceUnit]
+    case "TemperatureDifference_Kelvins":
+    	return [TemperatureDifferenceUnitType, KelvinsTemperatureDifferenceUnit]
     case "Flow_CubicMetersPerSecond":
     	return [FlowUnitType, CubicMetersPerSecondFlowUnit]
     case "Flow_CubicFeetPerSecond":
@@ -945,10 +1179,22 @@ export function getTypeUnit (input: alakaTitle): [UnitType, Unit] {
     	return [MassFlowUnitType, PoundsPerMinuteMassFlowUnit]
     case "ElectricPotential_Volts":
     	return [ElectricPotentialUnitType, VoltsElectricPotentialUnit]
+    case "ElectricPotential_Millivolts":
+    	return [ElectricPotentialUnitType, MillivoltsElectricPotentialUnit]
+    case "ElectricPotential_Kilovolts":
+    	return [ElectricPotentialUnitType, KilovoltsElectricPotentialUnit]
     case "ElectricPotentialLoaded_Volts":
     	return [ElectricPotentialLoadedUnitType, VoltsElectricPotentialLoadedUnit]
+    case "ElectricPotentialLoaded_Millivolts":
+    	return [ElectricPotentialLoadedUnitType, MillivoltsElectricPotentialLoadedUnit]
+    case "ElectricPotentialLoaded_Kilovolts":
+    	return [ElectricPotentialLoadedUnitType, KilovoltsElectricPotentialLoadedUnit]
     case "ElectricPotentialUnloaded_Volts":
     	return [ElectricPotentialUnloadedUnitType, VoltsElectricPotentialUnloadedUnit]
+    case "ElectricPotentialUnloaded_Millivolts":
+    	return [ElectricPotentialUnloadedUnitType, MillivoltsElectricPotentialUnloadedUnit]
+    case "ElectricPotentialUnloaded_Kilovolts":
+    	return [ElectricPotentialUnloadedUnitType, KilovoltsElectricPotentialUnloadedUnit]
     case "Percentage_Percent":
     	return [PercentageUnitType, PercentPercentageUnit]
     case "Humidity_Percent":
@@ -957,6 +1203,10 @@ export function getTypeUnit (input: alakaTitle): [UnitType, Unit] {
     	return [AlarmUnitType, PercentAlarmUnit]
     case "Work_Joules":
     	return [WorkUnitType, JoulesWorkUnit]
+    case "Work_Kilojoules":
+    	return [WorkUnitType, KilojoulesWorkUnit]
+    case "Work_Megajoules":
+    	return [WorkUnitType, MegajoulesWorkUnit]
     case "Work_InchPoundsForce":
     	return [WorkUnitType, InchPoundsForceWorkUnit]
     case "Work_CubicFeetOfNaturalGas":
@@ -965,18 +1215,32 @@ export function getTypeUnit (input: alakaTitle): [UnitType, Unit] {
     	return [WorkUnitType, BarrelsOfOilEquivalentWorkUnit]
     case "Force_Newtons":
     	return [ForceUnitType, NewtonsForceUnit]
+    case "Force_Kilonewtons":
+    	return [ForceUnitType, KilonewtonsForceUnit]
     case "Force_PoundsForce":
     	return [ForceUnitType, PoundsForceForceUnit]
     case "Force_KilogramsForce":
     	return [ForceUnitType, KilogramsForceForceUnit]
     case "Length_Meters":
     	return [LengthUnitType, MetersLengthUnit]
+    case "Length_Millimeters":
+    	return [LengthUnitType, MillimetersLengthUnit]
+    case "Length_Kilometers":
+    	return [LengthUnitType, KilometersLengthUnit]
     case "Length_Feet":
     	return [LengthUnitType, FeetLengthUnit]
     case "Length_Inches":
     	return [LengthUnitType, InchesLengthUnit]
     case "StrokeRate_StrokesPerSecond":
     	return [StrokeRateUnitType, StrokesPerSecondStrokeRateUnit]
+    case "Time_Seconds":
+    	return [TimeUnitType, SecondsTimeUnit]
+    case "Time_Minutes":
+    	return [TimeUnitType, MinutesTimeUnit]
+    case "Time_Hours":
+    	return [TimeUnitType, HoursTimeUnit]
+    case "Time_Days":
+    	return [TimeUnitType, DaysTimeUnit]
     case "Number_Number":
     	return [NumberUnitType, NumberNumberUnit]
     case "Overspeed_Number":
@@ -988,17 +1252,61 @@ export function getTypeUnit (input: alakaTitle): [UnitType, Unit] {
     case "WMLFlowRate_Number":
     	return [WMLFlowRateUnitType, NumberWMLFlowRateUnit]
     default:
-    	return [NumberUnitType, NumberNumberUnit]
+    	return undefined
+    }
+}
+
+// lookupType returns the unit type which matches input or throws an ErrUnknownType
+export function lookupType (input: string): UnitType {
+    const ut = findType(input)
+    if (ut === undefined) {
+    	throw new ErrUnknownType(sanitizeString(input))
+    }
+    return ut
+}
+
+// lookupUnit returns the unit of typeOf which matches input or throws an ErrUnknownUnit
+export function lookupUnit (input: string, typeOf: UnitType): Unit {
+    const u = findUnit(input, typeOf)
+    if (u === undefined) {
+    	throw new ErrUnknownUnit(sanitizeString(input), typeOf)
+    }
+    return u
+}
+
+// lookupTypeUnit returns the unit type and unit which matches input or throws an ErrUnknownUnit.
+// Opposite of AlakaTitle
+export function lookupTypeUnit (input: alakaTitle): [UnitType, Unit] {
+    const tu = findTypeUnit(input)
+    if (tu === undefined) {
+    	throw new ErrUnknownUnit(input, null)
     }
+    return tu
+}
+
+// getType returns the unit type which matches input or NumberUnitType
+export function getType (input: string): UnitType {
+    return findType(input) ?? NumberUnitType
+}
+
+// getUnit returns the unit which matches input or NumberNumberUnit
+export function getUnit (input: string, typeOf: UnitType): Unit {
+    return findUnit(input, typeOf) ?? NumberNumberUnit
+}
+
+// getTypeUnit returns the unit type and unit which matches input or (NumberUnitType, NumberNumberUnit).
+// Opposite of AlakaTitle
+export function getTypeUnit (input: alakaTitle): [UnitType, Unit] {
+    return findTypeUnit(input) ?? [NumberUnitType, NumberNumberUnit]
 }
 
 // Pressure (UnitType)
 // Contains 5 units:
-//  - PascalsPressure             Pa => Pa                 = Pa
-//  - KilopascalsPressure         Pa => Pa * 0.001         = kPa
-//  - MegapascalsPressure         Pa => Pa * 0.000,001     = MPa
-//  - PoundsPerSquareInchPressure Pa => Pa * 0.000,145,038 = psi
-//  - InchesOfWaterPressure       Pa => Pa * 0.004,014,74  = inH₂O
+//  - PascalsPressure             Pa => Pa                       = Pa
+//  - KilopascalsPressure         v => v * 0.001                 = kPa
+//  - MegapascalsPressure         v => v * 1e-06                 = MPa
+//  - PoundsPerSquareInchPressure v => v * 0.0001450377377302092 = psi
+//  - InchesOfWaterPressure       v => v * 0.00401474213311279   = inH₂O
 // Base: PascalsPressure
 
 export const PressureUnitType = new UnitType(
@@ -1026,7 +1334,7 @@ export const PressureUnitType = new UnitType(
 // UnitType     : Pressure
 // UnitType.Base: PascalsPressure
 // Unit.FromBase: Pa => Pa = Pa
-// Unit.ToBase  : Pa => Pa = Pa
+// Unit.ToBase  : v => v   = Pa
 
 export const PascalsPressureUnit = new Unit(
 	// title
@@ -1046,8 +1354,8 @@ export const PascalsPressureUnit = new Unit(
 	    return Pa
 	},
 		// toBase converts Pa to Pa
-	function toBase (Pa: scalar): scalar {
-	    return Pa
+	function toBase (v: scalar): scalar {
+	    return v
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -1064,8 +1372,8 @@ export const PascalsPressureUnit = new Unit(
 // KilopascalsPressure (Unit)
 // UnitType     : Pressure
 // UnitType.Base: PascalsPressure
-// Unit.FromBase: Pa => Pa * 0.001   = kPa
-// Unit.ToBase  : kPa => kPa * 1,000 = Pa
+// Unit.FromBase: v => v * 0.001 = kPa
+// Unit.ToBase  : v => v * 1000  = Pa
 
 export const KilopascalsPressureUnit = new Unit(
 	// title
@@ -1081,30 +1389,34 @@ export const KilopascalsPressureUnit = new Unit(
 	// base
 	PascalsPressureUnit,
 		// fromBase converts Pa to kPa
-	function fromBase (Pa: scalar): scalar {
-	    return Pa * 0.001
+	function fromBase (v: scalar): scalar {
+	    return v * 0.001
 	},
 		// toBase converts kPa to Pa
-	function toBase (kPa: scalar): scalar {
-	    return kPa * 1000
+	function toBase (v: scalar): scalar {
+	    return v * 1000
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
 	// freehand, for example.
 	function matcher (this: Unit, check: string): boolean {
+	    const exact = check.replace(WhitespaceRegex, '')
+	    if (this.exactMatchList.includes(exact)) return true
 	    check = sanitizeString(check)
 	    for (const m of this.matchList) {
 	    	if (m === check || m === '*') return true
 	    }
 	    return false
-	}
+	},
+	// exactMatchList
+	["kPa"]
 )
 
 // MegapascalsPressure (Unit)
 // UnitType     : Pressure
 // UnitType.Base: PascalsPressure
-// Unit.FromBase: Pa => Pa * 0.000,001   = MPa
-// Unit.ToBase  : MPa => MPa * 1,000,000 = Pa
+// Unit.FromBase: v => v * 1e-06 = MPa
+// Unit.ToBase  : v => v * 1e+06 = Pa
 
 export const MegapascalsPressureUnit = new Unit(
 	// title
@@ -1114,36 +1426,40 @@ export const MegapascalsPressureUnit = new Unit(
 	// symbol
 	'MPa',
 	// matchList
-	["mpa","megapascal","megapascals"],
+	["megapascal","megapascals"],
 	// type
 	PressureUnitType,
 	// base
 	PascalsPressureUnit,
 		// fromBase converts Pa to MPa
-	function fromBase (Pa: scalar): scalar {
-	    return Pa * 0.000001
+	function fromBase (v: scalar): scalar {
+	    return v * 1e-06
 	},
 		// toBase converts MPa to Pa
-	function toBase (MPa: scalar): scalar {
-	    return MPa * 1000000
+	function toBase (v: scalar): scalar {
+	    return v * 1e+06
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
 	// freehand, for example.
 	function matcher (this: Unit, check: string): boolean {
+	    const exact = check.replace(WhitespaceRegex, '')
+	    if (this.exactMatchList.includes(exact)) return true
 	    check = sanitizeString(check)
 	    for (const m of this.matchList) {
 	    	if (m === check || m === '*') return true
 	    }
 	    return false
-	}
+	},
+	// exactMatchList
+	["MPa"]
 )
 
 // PoundsPerSquareInchPressure (Unit)
 // UnitType     : Pressure
 // UnitType.Base: PascalsPressure
-// Unit.FromBase: Pa => Pa * 0.000,145,038 = psi
-// Unit.ToBase  : psi => psi * 6,894.76    = Pa
+// Unit.FromBase: v => v * 0.0001450377377302092 = psi
+// Unit.ToBase  : v => v * 6894.757293168362     = Pa
 
 export const PoundsPerSquareInchPressureUnit = new Unit(
 	// title
@@ -1159,12 +1475,12 @@ export const PoundsPerSquareInchPressureUnit = new Unit(
 	// base
 	PascalsPressureUnit,
 		// fromBase converts Pa to psi
-	function fromBase (Pa: scalar): scalar {
-	    return Pa * 0.000145038
+	function fromBase (v: scalar): scalar {
+	    return v * 0.0001450377377302092
 	},
 		// toBase converts psi to Pa
-	function toBase (psi: scalar): scalar {
-	    return psi * 6894.76
+	function toBase (v: scalar): scalar {
+	    return v * 6894.757293168362
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -1181,8 +1497,8 @@ export const PoundsPerSquareInchPressureUnit = new Unit(
 // InchesOfWaterPressure (Unit)
 // UnitType     : Pressure
 // UnitType.Base: PascalsPressure
-// Unit.FromBase: Pa => Pa * 0.004,014,74  = inH₂O
-// Unit.ToBase  : inH2O => inH2O * 249.082 = Pa
+// Unit.FromBase: v => v * 0.00401474213311279 = inH₂O
+// Unit.ToBase  : v => v * 249.082             = Pa
 
 export const InchesOfWaterPressureUnit = new Unit(
 	// title
@@ -1198,12 +1514,12 @@ export const InchesOfWaterPressureUnit = new Unit(
 	// base
 	PascalsPressureUnit,
 		// fromBase converts Pa to inH₂O
-	function fromBase (Pa: scalar): scalar {
-	    return Pa * 0.00401474
+	function fromBase (v: scalar): scalar {
+	    return v * 0.00401474213311279
 	},
 		// toBase converts inH₂O to Pa
-	function toBase (inH2O: scalar): scalar {
-	    return inH2O * 249.082
+	function toBase (v: scalar): scalar {
+	    return v * 249.082
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -1222,9 +1538,9 @@ PressureUnitType.units = [PascalsPressureUnit,KilopascalsPressureUnit,Megapascal
 
 // Temperature (UnitType)
 // Contains 3 units:
-//  - DegreesCelsiusTemperature    C => C                  = °C
-//  - DegreesFahrenheitTemperature C => (C * (9 / 5)) + 32 = °F
-//  - KelvinsTemperature           C => C + 273.15         = K
+//  - DegreesCelsiusTemperature    C => C              = °C
+//  - DegreesFahrenheitTemperature C => (C * 1.8) + 32 = °F
+//  - KelvinsTemperature           C => C + 273.15     = K
 // Base: DegreesCelsiusTemperature
 
 export const TemperatureUnitType = new UnitType(
@@ -1252,7 +1568,7 @@ export const TemperatureUnitType = new UnitType(
 // UnitType     : Temperature
 // UnitType.Base: DegreesCelsiusTemperature
 // Unit.FromBase: C => C = °C
-// Unit.ToBase  : C => C = °C
+// Unit.ToBase  : v => v = °C
 
 export const DegreesCelsiusTemperatureUnit = new Unit(
 	// title
@@ -1272,8 +1588,8 @@ export const DegreesCelsiusTemperatureUnit = new Unit(
 	    return C
 	},
 		// toBase converts °C to °C
-	function toBase (C: scalar): scalar {
-	    return C
+	function toBase (v: scalar): scalar {
+	    return v
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -1290,8 +1606,8 @@ export const DegreesCelsiusTemperatureUnit = new Unit(
 // DegreesFahrenheitTemperature (Unit)
 // UnitType     : Temperature
 // UnitType.Base: DegreesCelsiusTemperature
-// Unit.FromBase: C => (C * (9 / 5)) + 32 = °F
-// Unit.ToBase  : F => (F - 32) * (5 / 9) = °C
+// Unit.FromBase: C => (C * 1.8) + 32 = °F
+// Unit.ToBase  : v => (v - 32) / 1.8 = °C
 
 export const DegreesFahrenheitTemperatureUnit = new Unit(
 	// title
@@ -1308,11 +1624,11 @@ export const DegreesFahrenheitTemperatureUnit = new Unit(
 	DegreesCelsiusTemperatureUnit,
 		// fromBase converts °C to °F
 	function fromBase (C: scalar): scalar {
-	    return (C * (9 / 5)) + 32
+	    return (C * 1.8) + 32
 	},
 		// toBase converts °F to °C
-	function toBase (F: scalar): scalar {
-	    return (F - 32) * (5 / 9)
+	function toBase (v: scalar): scalar {
+	    return (v - 32) / 1.8
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -1330,7 +1646,7 @@ export const DegreesFahrenheitTemperatureUnit = new Unit(
 // UnitType     : Temperature
 // UnitType.Base: DegreesCelsiusTemperature
 // Unit.FromBase: C => C + 273.15 = K
-// Unit.ToBase  : K => K - 273.15 = °C
+// Unit.ToBase  : v => v - 273.15 = °C
 
 export const KelvinsTemperatureUnit = new Unit(
 	// title
@@ -1350,8 +1666,8 @@ export const KelvinsTemperatureUnit = new Unit(
 	    return C + 273.15
 	},
 		// toBase converts K to °C
-	function toBase (K: scalar): scalar {
-	    return K - 273.15
+	function toBase (v: scalar): scalar {
+	    return v - 273.15
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -1368,15 +1684,163 @@ export const KelvinsTemperatureUnit = new Unit(
 TemperatureUnitType.base = DegreesCelsiusTemperatureUnit
 TemperatureUnitType.units = [DegreesCelsiusTemperatureUnit,DegreesFahrenheitTemperatureUnit,KelvinsTemperatureUnit]
 
+// TemperatureDifference (UnitType)
+// Contains 3 units:
+//  - DegreesCelsiusTemperatureDifference    C => C       = Δ°C
+//  - DegreesFahrenheitTemperatureDifference C => C * 1.8 = Δ°F
+//  - KelvinsTemperatureDifference           C => C       = ΔK
+// Base: DegreesCelsiusTemperatureDifference
+
+export const TemperatureDifferenceUnitType = new UnitType(
+	// title
+	'TemperatureDifference',
+	// name
+	'Temperature Difference',
+	// unitList
+	["Degrees Celsius","Degrees Fahrenheit","Kelvins"],
+	// matchList
+	["temperaturedifference","tempdifference","temperaturedelta","tempdelta","deltat","δt"],
+		// matcher returns true if check matches our possible names.
+	// Helpful when a user is allowed to enter in unit types
+	// freehand, for example.
+	function matcher (this: UnitType, check: string): boolean {
+	    check = sanitizeString(check)
+	    for (const m of this.matchList) {
+	    	if (m === check || m === '*') return true
+	    }
+	    return false
+	}
+)
+
+// DegreesCelsiusTemperatureDifference (Unit)
+// UnitType     : TemperatureDifference
+// UnitType.Base: DegreesCelsiusTemperatureDifference
+// Unit.FromBase: C => C = Δ°C
+// Unit.ToBase  : v => v = Δ°C
+
+export const DegreesCelsiusTemperatureDifferenceUnit = new Unit(
+	// title
+	'DegreesCelsius',
+	// name
+	'Degrees Celsius',
+	// symbol
+	'Δ°C',
+	// matchList
+	["δ°c","δc","deltac","delta°c","deltacelsius","celsiusdifference","degreescelsiusdifference"],
+	// type
+	TemperatureDifferenceUnitType,
+	// base
+	null,
+		// fromBase converts Δ°C to Δ°C
+	function fromBase (C: scalar): scalar {
+	    return C
+	},
+		// toBase converts Δ°C to Δ°C
+	function toBase (v: scalar): scalar {
+	    return v
+	},
+		// matcher returns true if check matches our possible names.
+	// Helpful when a user is allowed to enter in unit types
+	// freehand, for example.
+	function matcher (this: Unit, check: string): boolean {
+	    check = sanitizeString(check)
+	    for (const m of this.matchList) {
+	    	if (m === check || m === '*') return true
+	    }
+	    return false
+	}
+)
+
+// DegreesFahrenheitTemperatureDifference (Unit)
+// UnitType     : TemperatureDifference
+// UnitType.Base: DegreesCelsiusTemperatureDifference
+// Unit.FromBase: C => C * 1.8                = Δ°F
+// Unit.ToBase  : v => v * 0.5555555555555556 = Δ°C
+
+export const DegreesFahrenheitTemperatureDifferenceUnit = new Unit(
+	// title
+	'DegreesFahrenheit',
+	// name
+	'Degrees Fahrenheit',
+	// symbol
+	'Δ°F',
+	// matchList
+	["δ°f","δf","deltaf","delta°f","deltafahrenheit","fahrenheitdifference","degreesfahrenheitdifference"],
+	// type
+	TemperatureDifferenceUnitType,
+	// base
+	DegreesCelsiusTemperatureDifferenceUnit,
+		// fromBase converts Δ°C to Δ°F
+	function fromBase (C: scalar): scalar {
+	    return C * 1.8
+	},
+		// toBase converts Δ°F to Δ°C
+	function toBase (v: scalar): scalar {
+	    return v * 0.5555555555555556
+	},
+		// matcher returns true if check matches our possible names.
+	// Helpful when a user is allowed to enter in unit types
+	// freehand, for example.
+	function matcher (this: Unit, check: string): boolean {
+	    check = sanitizeString(check)
+	    for (const m of this.matchList) {
+	    	if (m === check || m === '*') return true
+	    }
+	    return false
+	}
+)
+
+// KelvinsTemperatureDifference (Unit)
+// UnitType     : TemperatureDifference
+// UnitType.Base: DegreesCelsiusTemperatureDifference
+// Unit.FromBase: C => C = ΔK
+// Unit.ToBase  : v => v = Δ°C
+
+export const KelvinsTemperatureDifferenceUnit = new Unit(
+	// title
+	'Kelvins',
+	// name
+	'Kelvins',
+	// symbol
+	'ΔK',
+	// matchList
+	["δk","deltak","deltakelvin","kelvindifference","kelvinsdifference"],
+	// type
+	TemperatureDifferenceUnitType,
+	// base
+	DegreesCelsiusTemperatureDifferenceUnit,
+		// fromBase converts Δ°C to ΔK
+	function fromBase (C: scalar): scalar {
+	    return C
+	},
+		// toBase converts ΔK to Δ°C
+	function toBase (v: scalar): scalar {
+	    return v
+	},
+		// matcher returns true if check matches our possible names.
+	// Helpful when a user is allowed to enter in unit types
+	// freehand, for example.
+	function matcher (this: Unit, check: string): boolean {
+	    check = sanitizeString(check)
+	    for (const m of this.matchList) {
+	    	if (m === check || m === '*') return true
+	    }
+	    return false
+	}
+)
+
+TemperatureDifferenceUnitType.base = DegreesCelsiusTemperatureDifferenceUnit
+TemperatureDifferenceUnitType.units = [DegreesCelsiusTemperatureDifferenceUnit,DegreesFahrenheitTemperatureDifferenceUnit,KelvinsTemperatureDifferenceUnit]
+
 // Flow (UnitType)
 // Contains 7 units:
-//  - CubicMetersPerSecondFlow    m3s => m3s            = m³/s
-//  - CubicFeetPerSecondFlow      m3s => m3s * 35.314,7 = ft³/s
-//  - ThousandCubicFeetPerDayFlow m3s => m3s * 3,051.19 = MCFD
-//  - GallonsUSFluidPerSecondFlow m3s => m3s * 264.172  = gal/s
-//  - GallonsUSFluidPerMinuteFlow m3s => m3s * 15850.3  = gal/min
-//  - BarrelsPerSecondFlow        m3s => m3s * 6.289,81 = bbl/s
-//  - BarrelsPerMinuteFlow        m3s => m3s * 377.389  = bbl/min
+//  - CubicMetersPerSecondFlow    m3s => m3s                  = m³/s
+//  - CubicFeetPerSecondFlow      v => v * 35.31466672148859  = ft³/s
+//  - ThousandCubicFeetPerDayFlow v => v * 3051.187204736614  = MCFD
+//  - GallonsUSFluidPerSecondFlow v => v * 264.1720523581484  = gal/s
+//  - GallonsUSFluidPerMinuteFlow v => v * 15850.323141488905 = gal/min
+//  - BarrelsPerSecondFlow        v => v * 6.289810770432105  = bbl/s
+//  - BarrelsPerMinuteFlow        v => v * 377.3886462259263  = bbl/min
 // Base: CubicMetersPerSecondFlow
 
 export const FlowUnitType = new UnitType(
@@ -1404,7 +1868,7 @@ export const FlowUnitType = new UnitType(
 // UnitType     : Flow
 // UnitType.Base: CubicMetersPerSecondFlow
 // Unit.FromBase: m3s => m3s = m³/s
-// Unit.ToBase  : m3s => m3s = m³/s
+// Unit.ToBase  : v => v     = m³/s
 
 export const CubicMetersPerSecondFlowUnit = new Unit(
 	// title
@@ -1424,8 +1888,8 @@ export const CubicMetersPerSecondFlowUnit = new Unit(
 	    return m3s
 	},
 		// toBase converts m³/s to m³/s
-	function toBase (m3s: scalar): scalar {
-	    return m3s
+	function toBase (v: scalar): scalar {
+	    return v
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -1442,8 +1906,8 @@ export const CubicMetersPerSecondFlowUnit = new Unit(
 // CubicFeetPerSecondFlow (Unit)
 // UnitType     : Flow
 // UnitType.Base: CubicMetersPerSecondFlow
-// Unit.FromBase: m3s => m3s * 35.314,7      = ft³/s
-// Unit.ToBase  : ft3s => ft3s * 0.028,316,8 = m³/s
+// Unit.FromBase: v => v * 35.31466672148859 = ft³/s
+// Unit.ToBase  : v => v * 0.028316846592    = m³/s
 
 export const CubicFeetPerSecondFlowUnit = new Unit(
 	// title
@@ -1459,12 +1923,12 @@ export const CubicFeetPerSecondFlowUnit = new Unit(
 	// base
 	CubicMetersPerSecondFlowUnit,
 		// fromBase converts m³/s to ft³/s
-	function fromBase (m3s: scalar): scalar {
-	    return m3s * 35.3147
+	function fromBase (v: scalar): scalar {
+	    return v * 35.31466672148859
 	},
 		// toBase converts ft³/s to m³/s
-	function toBase (ft3s: scalar): scalar {
-	    return ft3s * 0.0283168
+	function toBase (v: scalar): scalar {
+	    return v * 0.028316846592
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -1481,8 +1945,8 @@ export const CubicFeetPerSecondFlowUnit = new Unit(
 // ThousandCubicFeetPerDayFlow (Unit)
 // UnitType     : Flow
 // UnitType.Base: CubicMetersPerSecondFlow
-// Unit.FromBase: m3s => m3s * 3,051.19        = MCFD
-// Unit.ToBase  : MCFD => MCFD * 0.000,327,741 = m³/s
+// Unit.FromBase: v => v * 3051.187204736614 = MCFD
+// Unit.ToBase  : v => v * 0.00032774128     = m³/s
 
 export const ThousandCubicFeetPerDayFlowUnit = new Unit(
 	// title
@@ -1498,12 +1962,12 @@ export const ThousandCubicFeetPerDayFlowUnit = new Unit(
 	// base
 	CubicMetersPerSecondFlowUnit,
 		// fromBase converts m³/s to MCFD
-	function fromBase (m3s: scalar): scalar {
-	    return m3s * 3051.19
+	function fromBase (v: scalar): scalar {
+	    return v * 3051.187204736614
 	},
 		// toBase converts MCFD to m³/s
-	function toBase (MCFD: scalar): scalar {
-	    return MCFD * 0.000327741
+	function toBase (v: scalar): scalar {
+	    return v * 0.00032774128
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -1520,8 +1984,8 @@ export const ThousandCubicFeetPerDayFlowUnit = new Unit(
 // GallonsUSFluidPerSecondFlow (Unit)
 // UnitType     : Flow
 // UnitType.Base: CubicMetersPerSecondFlow
-// Unit.FromBase: m3s => m3s * 264.172        = gal/s
-// Unit.ToBase  : gals => gals * 0.003,785,41 = m³/s
+// Unit.FromBase: v => v * 264.1720523581484 = gal/s
+// Unit.ToBase  : v => v * 0.003785411784    = m³/s
 
 export const GallonsUSFluidPerSecondFlowUnit = new Unit(
 	// title
@@ -1537,12 +2001,12 @@ export const GallonsUSFluidPerSecondFlowUnit = new Unit(
 	// base
 	CubicMetersPerSecondFlowUnit,
 		// fromBase converts m³/s to gal/s
-	function fromBase (m3s: scalar): scalar {
-	    return m3s * 264.172
+	function fromBase (v: scalar): scalar {
+	    return v * 264.1720523581484
 	},
 		// toBase converts gal/s to m³/s
-	function toBase (gals: scalar): scalar {
-	    return gals * 0.00378541
+	function toBase (v: scalar): scalar {
+	    return v * 0.003785411784
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -1559,8 +2023,8 @@ export const GallonsUSFluidPerSecondFlowUnit = new Unit(
 // GallonsUSFluidPerMinuteFlow (Unit)
 // UnitType     : Flow
 // UnitType.Base: CubicMetersPerSecondFlow
-// Unit.FromBase: m3s => m3s * 15850.3           = gal/min
-// Unit.ToBase  : galm => galm * 0.000,063,090,2 = m³/s
+// Unit.FromBase: v => v * 15850.323141488905 = gal/min
+// Unit.ToBase  : v => v * 6.30901964e-05     = m³/s
 
 export const GallonsUSFluidPerMinuteFlowUnit = new Unit(
 	// title
@@ -1570,18 +2034,18 @@ export const GallonsUSFluidPerMinuteFlowUnit = new Unit(
 	// symbol
 	'gal/min',
 	// matchList
-	["gal/m","gals/m","galm","galsm","gpm","gallonsperminute","gallonperminute","gallonspermin","gallonpermin","gallons/minute","gallons/min","gallon/minute","gallon/min"],
+	["gal/min","gal/m","gals/m","galm","galsm","gpm","gallonsperminute","gallonperminute","gallonspermin","gallonpermin","gallons/minute","gallons/min","gallon/minute","gallon/min"],
 	// type
 	FlowUnitType,
 	// base
 	CubicMetersPerSecondFlowUnit,
 		// fromBase converts m³/s to gal/min
-	function fromBase (m3s: scalar): scalar {
-	    return m3s * 15850.3
+	function fromBase (v: scalar): scalar {
+	    return v * 15850.323141488905
 	},
 		// toBase converts gal/min to m³/s
-	function toBase (galm: scalar): scalar {
-	    return galm * 0.0000630902
+	function toBase (v: scalar): scalar {
+	    return v * 6.30901964e-05
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -1598,8 +2062,8 @@ export const GallonsUSFluidPerMinuteFlowUnit = new Unit(
 // BarrelsPerSecondFlow (Unit)
 // UnitType     : Flow
 // UnitType.Base: CubicMetersPerSecondFlow
-// Unit.FromBase: m3s => m3s * 6.289,81    = bbl/s
-// Unit.ToBase  : bbls => bbls * 0.158,987 = m³/s
+// Unit.FromBase: v => v * 6.289810770432105 = bbl/s
+// Unit.ToBase  : v => v * 0.158987294928    = m³/s
 
 export const BarrelsPerSecondFlowUnit = new Unit(
 	// title
@@ -1615,12 +2079,12 @@ export const BarrelsPerSecondFlowUnit = new Unit(
 	// base
 	CubicMetersPerSecondFlowUnit,
 		// fromBase converts m³/s to bbl/s
-	function fromBase (m3s: scalar): scalar {
-	    return m3s * 6.28981
+	function fromBase (v: scalar): scalar {
+	    return v * 6.289810770432105
 	},
 		// toBase converts bbl/s to m³/s
-	function toBase (bbls: scalar): scalar {
-	    return bbls * 0.158987
+	function toBase (v: scalar): scalar {
+	    return v * 0.158987294928
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -1637,8 +2101,8 @@ export const BarrelsPerSecondFlowUnit = new Unit(
 // BarrelsPerMinuteFlow (Unit)
 // UnitType     : Flow
 // UnitType.Base: CubicMetersPerSecondFlow
-// Unit.FromBase: m3s => m3s * 377.389        = bbl/min
-// Unit.ToBase  : bblm => bblm * 0.002,649,79 = m³/s
+// Unit.FromBase: v => v * 377.3886462259263 = bbl/min
+// Unit.ToBase  : v => v * 0.0026497882488   = m³/s
 
 export const BarrelsPerMinuteFlowUnit = new Unit(
 	// title
@@ -1654,12 +2118,12 @@ export const BarrelsPerMinuteFlowUnit = new Unit(
 	// base
 	CubicMetersPerSecondFlowUnit,
 		// fromBase converts m³/s to bbl/min
-	function fromBase (m3s: scalar): scalar {
-	    return m3s * 377.389
+	function fromBase (v: scalar): scalar {
+	    return v * 377.3886462259263
 	},
 		// toBase converts bbl/min to m³/s
-	function toBase (bblm: scalar): scalar {
-	    return bblm * 0.00264979
+	function toBase (v: scalar): scalar {
+	    return v * 0.0026497882488
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -1678,13 +2142,13 @@ FlowUnitType.units = [CubicMetersPerSecondFlowUnit,CubicFeetPerSecondFlowUnit,Th
 
 // Volume (UnitType)
 // Contains 7 units:
-//  - CubicMetersVolume          m3 => m3               = m³
-//  - CubicFeetVolume            m3 => m3 * 35.314,7    = cu ft
-//  - ThousandsOfCubicFeetVolume m3 => m3 * 0.035,314,7 = MCF
-//  - CubicDecimeterVolume       m3 => m3 * 1,000       = dm³
-//  - LiterVolume                m3 => m3 * 1,000       = L
-//  - GallonUSFluidVolume        m3 => m3 * 264.172     = gal (US)
-//  - BarrelsOfOilVolume         m3 => m3 * 6.289,81    = bbl
+//  - CubicMetersVolume          m3 => m3                     = m³
+//  - CubicFeetVolume            v => v * 35.31466672148859   = cu ft
+//  - ThousandsOfCubicFeetVolume v => v * 0.03531466672148859 = MCF
+//  - CubicDecimeterVolume       v => v * 1000                = dm³
+//  - LiterVolume                v => v * 1000                = L
+//  - GallonUSFluidVolume        v => v * 264.1720523581484   = gal (US)
+//  - BarrelsOfOilVolume         v => v * 6.289810770432105   = bbl
 // Base: CubicMetersVolume
 
 export const VolumeUnitType = new UnitType(
@@ -1712,7 +2176,7 @@ export const VolumeUnitType = new UnitType(
 // UnitType     : Volume
 // UnitType.Base: CubicMetersVolume
 // Unit.FromBase: m3 => m3 = m³
-// Unit.ToBase  : m3 => m3 = m³
+// Unit.ToBase  : v => v   = m³
 
 export const CubicMetersVolumeUnit = new Unit(
 	// title
@@ -1732,8 +2196,8 @@ export const CubicMetersVolumeUnit = new Unit(
 	    return m3
 	},
 		// toBase converts m³ to m³
-	function toBase (m3: scalar): scalar {
-	    return m3
+	function toBase (v: scalar): scalar {
+	    return v
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -1750,8 +2214,8 @@ export const CubicMetersVolumeUnit = new Unit(
 // CubicFeetVolume (Unit)
 // UnitType     : Volume
 // UnitType.Base: CubicMetersVolume
-// Unit.FromBase: m3 => m3 * 35.314,7        = cu ft
-// Unit.ToBase  : cuft => cuft * 0.028,316,8 = m³
+// Unit.FromBase: v => v * 35.31466672148859 = cu ft
+// Unit.ToBase  : v => v * 0.028316846592    = m³
 
 export const CubicFeetVolumeUnit = new Unit(
 	// title
@@ -1767,12 +2231,12 @@ export const CubicFeetVolumeUnit = new Unit(
 	// base
 	CubicMetersVolumeUnit,
 		// fromBase converts m³ to cu ft
-	function fromBase (m3: scalar): scalar {
-	    return m3 * 35.3147
+	function fromBase (v: scalar): scalar {
+	    return v * 35.31466672148859
 	},
 		// toBase converts cu ft to m³
-	function toBase (cuft: scalar): scalar {
-	    return cuft * 0.0283168
+	function toBase (v: scalar): scalar {
+	    return v * 0.028316846592
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -1789,8 +2253,8 @@ export const CubicFeetVolumeUnit = new Unit(
 // ThousandsOfCubicFeetVolume (Unit)
 // UnitType     : Volume
 // UnitType.Base: CubicMetersVolume
-// Unit.FromBase: m3 => m3 * 0.035,314,7 = MCF
-// Unit.ToBase  : MCF => MCF * 28.316,8  = m³
+// Unit.FromBase: v => v * 0.03531466672148859 = MCF
+// Unit.ToBase  : v => v * 28.316846592        = m³
 
 export const ThousandsOfCubicFeetVolumeUnit = new Unit(
 	// title
@@ -1806,12 +2270,12 @@ export const ThousandsOfCubicFeetVolumeUnit = new Unit(
 	// base
 	CubicMetersVolumeUnit,
 		// fromBase converts m³ to MCF
-	function fromBase (m3: scalar): scalar {
-	    return m3 * 0.0353147
+	function fromBase (v: scalar): scalar {
+	    return v * 0.03531466672148859
 	},
 		// toBase converts MCF to m³
-	function toBase (MCF: scalar): scalar {
-	    return MCF * 28.3168
+	function toBase (v: scalar): scalar {
+	    return v * 28.316846592
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -1828,8 +2292,8 @@ export const ThousandsOfCubicFeetVolumeUnit = new Unit(
 // CubicDecimeterVolume (Unit)
 // UnitType     : Volume
 // UnitType.Base: CubicMetersVolume
-// Unit.FromBase: m3 => m3 * 1,000   = dm³
-// Unit.ToBase  : dm3 => dm3 * 0.001 = m³
+// Unit.FromBase: v => v * 1000  = dm³
+// Unit.ToBase  : v => v * 0.001 = m³
 
 export const CubicDecimeterVolumeUnit = new Unit(
 	// title
@@ -1845,12 +2309,12 @@ export const CubicDecimeterVolumeUnit = new Unit(
 	// base
 	CubicMetersVolumeUnit,
 		// fromBase converts m³ to dm³
-	function fromBase (m3: scalar): scalar {
-	    return m3 * 1000
+	function fromBase (v: scalar): scalar {
+	    return v * 1000
 	},
 		// toBase converts dm³ to m³
-	function toBase (dm3: scalar): scalar {
-	    return dm3 * 0.001
+	function toBase (v: scalar): scalar {
+	    return v * 0.001
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -1867,8 +2331,8 @@ export const CubicDecimeterVolumeUnit = new Unit(
 // LiterVolume (Unit)
 // UnitType     : Volume
 // UnitType.Base: CubicMetersVolume
-// Unit.FromBase: m3 => m3 * 1,000 = L
-// Unit.ToBase  : L => L * 0.001   = m³
+// Unit.FromBase: v => v * 1000  = L
+// Unit.ToBase  : v => v * 0.001 = m³
 
 export const LiterVolumeUnit = new Unit(
 	// title
@@ -1884,12 +2348,12 @@ export const LiterVolumeUnit = new Unit(
 	// base
 	CubicMetersVolumeUnit,
 		// fromBase converts m³ to L
-	function fromBase (m3: scalar): scalar {
-	    return m3 * 1000
+	function fromBase (v: scalar): scalar {
+	    return v * 1000
 	},
 		// toBase converts L to m³
-	function toBase (L: scalar): scalar {
-	    return L * 0.001
+	function toBase (v: scalar): scalar {
+	    return v * 0.001
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -1906,8 +2370,8 @@ export const LiterVolumeUnit = new Unit(
 // GallonUSFluidVolume (Unit)
 // UnitType     : Volume
 // UnitType.Base: CubicMetersVolume
-// Unit.FromBase: m3 => m3 * 264.172        = gal (US)
-// Unit.ToBase  : gal => gal * 0.003,785,41 = m³
+// Unit.FromBase: v => v * 264.1720523581484 = gal (US)
+// Unit.ToBase  : v => v * 0.003785411784    = m³
 
 export const GallonUSFluidVolumeUnit = new Unit(
 	// title
@@ -1923,12 +2387,12 @@ export const GallonUSFluidVolumeUnit = new Unit(
 	// base
 	CubicMetersVolumeUnit,
 		// fromBase converts m³ to gal (US)
-	function fromBase (m3: scalar): scalar {
-	    return m3 * 264.172
+	function fromBase (v: scalar): scalar {
+	    return v * 264.1720523581484
 	},
 		// toBase converts gal (US) to m³
-	function toBase (gal: scalar): scalar {
-	    return gal * 0.00378541
+	function toBase (v: scalar): scalar {
+	    return v * 0.003785411784
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -1945,8 +2409,8 @@ export const GallonUSFluidVolumeUnit = new Unit(
 // BarrelsOfOilVolume (Unit)
 // UnitType     : Volume
 // UnitType.Base: CubicMetersVolume
-// Unit.FromBase: m3 => m3 * 6.289,81    = bbl
-// Unit.ToBase  : bbl => bbl * 0.158,987 = m³
+// Unit.FromBase: v => v * 6.289810770432105 = bbl
+// Unit.ToBase  : v => v * 0.158987294928    = m³
 
 export const BarrelsOfOilVolumeUnit = new Unit(
 	// title
@@ -1962,12 +2426,12 @@ export const BarrelsOfOilVolumeUnit = new Unit(
 	// base
 	CubicMetersVolumeUnit,
 		// fromBase converts m³ to bbl
-	function fromBase (m3: scalar): scalar {
-	    return m3 * 6.28981
+	function fromBase (v: scalar): scalar {
+	    return v * 6.289810770432105
 	},
 		// toBase converts bbl to m³
-	function toBase (bbl: scalar): scalar {
-	    return bbl * 0.158987
+	function toBase (v: scalar): scalar {
+	    return v * 0.158987294928
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -1986,8 +2450,8 @@ VolumeUnitType.units = [CubicMetersVolumeUnit,CubicFeetVolumeUnit,ThousandsOfCub
 
 // Mass (UnitType)
 // Contains 2 units:
-//  - KilogramsMass kg => kg            = kg
-//  - PoundsMass    kg => kg * 2.204,62 = lb
+//  - KilogramsMass kg => kg                    = kg
+//  - PoundsMass    v => v * 2.2046226218487757 = lb
 // Base: KilogramsMass
 
 export const MassUnitType = new UnitType(
@@ -2015,7 +2479,7 @@ export const MassUnitType = new UnitType(
 // UnitType     : Mass
 // UnitType.Base: KilogramsMass
 // Unit.FromBase: kg => kg = kg
-// Unit.ToBase  : kg => kg = kg
+// Unit.ToBase  : v => v   = kg
 
 export const KilogramsMassUnit = new Unit(
 	// title
@@ -2035,8 +2499,8 @@ export const KilogramsMassUnit = new Unit(
 	    return kg
 	},
 		// toBase converts kg to kg
-	function toBase (kg: scalar): scalar {
-	    return kg
+	function toBase (v: scalar): scalar {
+	    return v
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -2053,8 +2517,8 @@ export const KilogramsMassUnit = new Unit(
 // PoundsMass (Unit)
 // UnitType     : Mass
 // UnitType.Base: KilogramsMass
-// Unit.FromBase: kg => kg * 2.204,62  = lb
-// Unit.ToBase  : lb => lb * 0.453,592 = kg
+// Unit.FromBase: v => v * 2.2046226218487757 = lb
+// Unit.ToBase  : v => v * 0.45359237         = kg
 
 export const PoundsMassUnit = new Unit(
 	// title
@@ -2070,12 +2534,12 @@ export const PoundsMassUnit = new Unit(
 	// base
 	KilogramsMassUnit,
 		// fromBase converts kg to lb
-	function fromBase (kg: scalar): scalar {
-	    return kg * 2.20462
+	function fromBase (v: scalar): scalar {
+	    return v * 2.2046226218487757
 	},
 		// toBase converts lb to kg
-	function toBase (lb: scalar): scalar {
-	    return lb * 0.453592
+	function toBase (v: scalar): scalar {
+	    return v * 0.45359237
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -2094,9 +2558,9 @@ MassUnitType.units = [KilogramsMassUnit,PoundsMassUnit]
 
 // MassFlow (UnitType)
 // Contains 3 units:
-//  - KilogramsPerSecondMassFlow kgs => kgs            = kg/s
-//  - PoundsPerSecondMassFlow    kgs => kgs * 2.204,62 = lb/s
-//  - PoundsPerMinuteMassFlow    kgs => kgs * 132.277  = lb/min
+//  - KilogramsPerSecondMassFlow kgs => kgs                  = kg/s
+//  - PoundsPerSecondMassFlow    v => v * 2.2046226218487757 = lb/s
+//  - PoundsPerMinuteMassFlow    v => v * 132.27735731092656 = lb/min
 // Base: KilogramsPerSecondMassFlow
 
 export const MassFlowUnitType = new UnitType(
@@ -2124,7 +2588,7 @@ export const MassFlowUnitType = new UnitType(
 // UnitType     : MassFlow
 // UnitType.Base: KilogramsPerSecondMassFlow
 // Unit.FromBase: kgs => kgs = kg/s
-// Unit.ToBase  : kgs => kgs = kg/s
+// Unit.ToBase  : v => v     = kg/s
 
 export const KilogramsPerSecondMassFlowUnit = new Unit(
 	// title
@@ -2144,8 +2608,8 @@ export const KilogramsPerSecondMassFlowUnit = new Unit(
 	    return kgs
 	},
 		// toBase converts kg/s to kg/s
-	function toBase (kgs: scalar): scalar {
-	    return kgs
+	function toBase (v: scalar): scalar {
+	    return v
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -2162,8 +2626,8 @@ export const KilogramsPerSecondMassFlowUnit = new Unit(
 // PoundsPerSecondMassFlow (Unit)
 // UnitType     : MassFlow
 // UnitType.Base: KilogramsPerSecondMassFlow
-// Unit.FromBase: kgs => kgs * 2.204,62  = lb/s
-// Unit.ToBase  : lbs => lbs * 0.453,592 = kg/s
+// Unit.FromBase: v => v * 2.2046226218487757 = lb/s
+// Unit.ToBase  : v => v * 0.45359237         = kg/s
 
 export const PoundsPerSecondMassFlowUnit = new Unit(
 	// title
@@ -2179,12 +2643,12 @@ export const PoundsPerSecondMassFlowUnit = new Unit(
 	// base
 	KilogramsPerSecondMassFlowUnit,
 		// fromBase converts kg/s to lb/s
-	function fromBase (kgs: scalar): scalar {
-	    return kgs * 2.20462
+	function fromBase (v: scalar): scalar {
+	    return v * 2.2046226218487757
 	},
 		// toBase converts lb/s to kg/s
-	function toBase (lbs: scalar): scalar {
-	    return lbs * 0.453592
+	function toBase (v: scalar): scalar {
+	    return v * 0.45359237
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -2201,8 +2665,8 @@ export const PoundsPerSecondMassFlowUnit = new Unit(
 // PoundsPerMinuteMassFlow (Unit)
 // UnitType     : MassFlow
 // UnitType.Base: KilogramsPerSecondMassFlow
-// Unit.FromBase: kgs => kgs * 132.277          = lb/min
-// Unit.ToBase  : lbmin => lbmin * 0.007,559,87 = kg/s
+// Unit.FromBase: v => v * 132.27735731092656   = lb/min
+// Unit.ToBase  : v => v * 0.007559872833333333 = kg/s
 
 export const PoundsPerMinuteMassFlowUnit = new Unit(
 	// title
@@ -2218,12 +2682,12 @@ export const PoundsPerMinuteMassFlowUnit = new Unit(
 	// base
 	KilogramsPerSecondMassFlowUnit,
 		// fromBase converts kg/s to lb/min
-	function fromBase (kgs: scalar): scalar {
-	    return kgs * 132.277
+	function fromBase (v: scalar): scalar {
+	    return v * 132.27735731092656
 	},
 		// toBase converts lb/min to kg/s
-	function toBase (lbmin: scalar): scalar {
-	    return lbmin * 0.00755987
+	function toBase (v: scalar): scalar {
+	    return v * 0.007559872833333333
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -2241,8 +2705,10 @@ MassFlowUnitType.base = KilogramsPerSecondMassFlowUnit
 MassFlowUnitType.units = [KilogramsPerSecondMassFlowUnit,PoundsPerSecondMassFlowUnit,PoundsPerMinuteMassFlowUnit]
 
 // ElectricPotential (UnitType)
-// Contains 1 units:
-//  - VoltsElectricPotential V => V = V
+// Contains 3 units:
+//  - VoltsElectricPotential      V => V         = V
+//  - MillivoltsElectricPotential v => v * 1000  = mV
+//  - KilovoltsElectricPotential  v => v * 0.001 = kV
 // Base: VoltsElectricPotential
 
 export const ElectricPotentialUnitType = new UnitType(
@@ -2251,7 +2717,7 @@ export const ElectricPotentialUnitType = new UnitType(
 	// name
 	'Electric Potential',
 	// unitList
-	["Volts"],
+	["Volts","Millivolts","Kilovolts"],
 	// matchList
 	["electricpotential","voltage"],
 		// matcher returns true if check matches our possible names.
@@ -2270,7 +2736,7 @@ export const ElectricPotentialUnitType = new UnitType(
 // UnitType     : ElectricPotential
 // UnitType.Base: VoltsElectricPotential
 // Unit.FromBase: V => V = V
-// Unit.ToBase  : V => V = V
+// Unit.ToBase  : v => v = V
 
 export const VoltsElectricPotentialUnit = new Unit(
 	// title
@@ -2290,8 +2756,8 @@ export const VoltsElectricPotentialUnit = new Unit(
 	    return V
 	},
 		// toBase converts V to V
-	function toBase (V: scalar): scalar {
-	    return V
+	function toBase (v: scalar): scalar {
+	    return v
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -2305,91 +2771,111 @@ export const VoltsElectricPotentialUnit = new Unit(
 	}
 )
 
-ElectricPotentialUnitType.base = VoltsElectricPotentialUnit
-ElectricPotentialUnitType.units = [VoltsElectricPotentialUnit]
-
-// ElectricPotentialLoaded (UnitType)
-// Contains 1 units:
-//  - VoltsElectricPotentialLoaded V => V = V
-// Base: VoltsElectricPotentialLoaded
+// MillivoltsElectricPotential (Unit)
+// UnitType     : ElectricPotential
+// UnitType.Base: VoltsElectricPotential
+// Unit.FromBase: v => v * 1000  = mV
+// Unit.ToBase  : v => v * 0.001 = V
 
-export const ElectricPotentialLoadedUnitType = new UnitType(
+export const MillivoltsElectricPotentialUnit = new Unit(
 	// title
-	'ElectricPotentialLoaded',
+	'Millivolts',
 	// name
-	'Electric Potential Loaded',
-	// unitList
-	["Volts"],
+	'Millivolts',
+	// symbol
+	'mV',
 	// matchList
-	["electricpotentialloaded","voltageloaded"],
+	["millivolt","millivolts"],
+	// type
+	ElectricPotentialUnitType,
+	// base
+	VoltsElectricPotentialUnit,
+		// fromBase converts V to mV
+	function fromBase (v: scalar): scalar {
+	    return v * 1000
+	},
+		// toBase converts mV to V
+	function toBase (v: scalar): scalar {
+	    return v * 0.001
+	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
 	// freehand, for example.
-	function matcher (this: UnitType, check: string): boolean {
+	function matcher (this: Unit, check: string): boolean {
+	    const exact = check.replace(WhitespaceRegex, '')
+	    if (this.exactMatchList.includes(exact)) return true
 	    check = sanitizeString(check)
 	    for (const m of this.matchList) {
 	    	if (m === check || m === '*') return true
 	    }
 	    return false
-	}
+	},
+	// exactMatchList
+	["mV"]
 )
 
-// VoltsElectricPotentialLoaded (Unit)
-// UnitType     : ElectricPotentialLoaded
-// UnitType.Base: VoltsElectricPotentialLoaded
-// Unit.FromBase: V => V = V
-// Unit.ToBase  : V => V = V
+// KilovoltsElectricPotential (Unit)
+// UnitType     : ElectricPotential
+// UnitType.Base: VoltsElectricPotential
+// Unit.FromBase: v => v * 0.001 = kV
+// Unit.ToBase  : v => v * 1000  = V
 
-export const VoltsElectricPotentialLoadedUnit = new Unit(
+export const KilovoltsElectricPotentialUnit = new Unit(
 	// title
-	'Volts',
+	'Kilovolts',
 	// name
-	'Volts',
+	'Kilovolts',
 	// symbol
-	'V',
+	'kV',
 	// matchList
-	["volt","volts","v"],
+	["kv","kilovolt","kilovolts"],
 	// type
-	ElectricPotentialLoadedUnitType,
+	ElectricPotentialUnitType,
 	// base
-	null,
-		// fromBase converts V to V
-	function fromBase (V: scalar): scalar {
-	    return V
+	VoltsElectricPotentialUnit,
+		// fromBase converts V to kV
+	function fromBase (v: scalar): scalar {
+	    return v * 0.001
 	},
-		// toBase converts V to V
-	function toBase (V: scalar): scalar {
-	    return V
+		// toBase converts kV to V
+	function toBase (v: scalar): scalar {
+	    return v * 1000
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
 	// freehand, for example.
 	function matcher (this: Unit, check: string): boolean {
+	    const exact = check.replace(WhitespaceRegex, '')
+	    if (this.exactMatchList.includes(exact)) return true
 	    check = sanitizeString(check)
 	    for (const m of this.matchList) {
 	    	if (m === check || m === '*') return true
 	    }
 	    return false
-	}
+	},
+	// exactMatchList
+	["kV"]
 )
 
-ElectricPotentialLoadedUnitType.base = VoltsElectricPotentialLoadedUnit
-ElectricPotentialLoadedUnitType.units = [VoltsElectricPotentialLoadedUnit]
+ElectricPotentialUnitType.base = VoltsElectricPotentialUnit
+ElectricPotentialUnitType.units = [VoltsElectricPotentialUnit,MillivoltsElectricPotentialUnit,KilovoltsElectricPotentialUnit]
 
-// ElectricPotentialUnloaded (UnitType)
-// Contains 1 units:
-//  - VoltsElectricPotentialUnloaded V => V = V
-// Base: VoltsElectricPotentialUnloaded
+// ElectricPotentialLoaded (UnitType)
+// Contains 3 units:
+//  - VoltsElectricPotentialLoaded      V => V         = V
+//  - MillivoltsElectricPotentialLoaded v => v * 1000  = mV
+//  - KilovoltsElectricPotentialLoaded  v => v * 0.001 = kV
+// Base: VoltsElectricPotentialLoaded
 
-export const ElectricPotentialUnloadedUnitType = new UnitType(
+export const ElectricPotentialLoadedUnitType = new UnitType(
 	// title
-	'ElectricPotentialUnloaded',
+	'ElectricPotentialLoaded',
 	// name
-	'Electric Potential Unloaded',
+	'Electric Potential Loaded',
 	// unitList
-	["Volts"],
+	["Volts","Millivolts","Kilovolts"],
 	// matchList
-	["electricpotentialunloaded","voltageunloaded"],
+	["electricpotentialloaded","voltageloaded"],
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
 	// freehand, for example.
@@ -2402,13 +2888,13 @@ export const ElectricPotentialUnloadedUnitType = new UnitType(
 	}
 )
 
-// VoltsElectricPotentialUnloaded (Unit)
-// UnitType     : ElectricPotentialUnloaded
-// UnitType.Base: VoltsElectricPotentialUnloaded
+// VoltsElectricPotentialLoaded (Unit)
+// UnitType     : ElectricPotentialLoaded
+// UnitType.Base: VoltsElectricPotentialLoaded
 // Unit.FromBase: V => V = V
-// Unit.ToBase  : V => V = V
+// Unit.ToBase  : v => v = V
 
-export const VoltsElectricPotentialUnloadedUnit = new Unit(
+export const VoltsElectricPotentialLoadedUnit = new Unit(
 	// title
 	'Volts',
 	// name
@@ -2418,7 +2904,7 @@ export const VoltsElectricPotentialUnloadedUnit = new Unit(
 	// matchList
 	["volt","volts","v"],
 	// type
-	ElectricPotentialUnloadedUnitType,
+	ElectricPotentialLoadedUnitType,
 	// base
 	null,
 		// fromBase converts V to V
@@ -2426,8 +2912,8 @@ export const VoltsElectricPotentialUnloadedUnit = new Unit(
 	    return V
 	},
 		// toBase converts V to V
-	function toBase (V: scalar): scalar {
-	    return V
+	function toBase (v: scalar): scalar {
+	    return v
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -2441,20 +2927,262 @@ export const VoltsElectricPotentialUnloadedUnit = new Unit(
 	}
 )
 
-ElectricPotentialUnloadedUnitType.base = VoltsElectricPotentialUnloadedUnit
-ElectricPotentialUnloadedUnitType.units = [VoltsElectricPotentialUnloadedUnit]
-
-// Percentage (UnitType)
-// Contains 1 units:
-//  - PercentPercentage p => p = %
-// Base: PercentPercentage
+// MillivoltsElectricPotentialLoaded (Unit)
+// UnitType     : ElectricPotentialLoaded
+// UnitType.Base: VoltsElectricPotentialLoaded
+// Unit.FromBase: v => v * 1000  = mV
+// Unit.ToBase  : v => v * 0.001 = V
 
-export const PercentageUnitType = new UnitType(
+export const MillivoltsElectricPotentialLoadedUnit = new Unit(
 	// title
-	'Percentage',
+	'Millivolts',
 	// name
-	'Percentage',
-	// unitList
+	'Millivolts',
+	// symbol
+	'mV',
+	// matchList
+	["millivolt","millivolts"],
+	// type
+	ElectricPotentialLoadedUnitType,
+	// base
+	VoltsElectricPotentialLoadedUnit,
+		// fromBase converts V to mV
+	function fromBase (v: scalar): scalar {
+	    return v * 1000
+	},
+		// toBase converts mV to V
+	function toBase (v: scalar): scalar {
+	    return v * 0.001
+	},
+		// matcher returns true if check matches our possible names.
+	// Helpful when a user is allowed to enter in unit types
+	// freehand, for example.
+	function matcher (this: Unit, check: string): boolean {
+	    const exact = check.replace(WhitespaceRegex, '')
+	    if (this.exactMatchList.includes(exact)) return true
+	    check = sanitizeString(check)
+	    for (const m of this.matchList) {
+	    	if (m === check || m === '*') return true
+	    }
+	    return false
+	},
+	// exactMatchList
+	["mV"]
+)
+
+// KilovoltsElectricPotentialLoaded (Unit)
+// UnitType     : ElectricPotentialLoaded
+// UnitType.Base: VoltsElectricPotentialLoaded
+// Unit.FromBase: v => v * 0.001 = kV
+// Unit.ToBase  : v => v * 1000  = V
+
+export const KilovoltsElectricPotentialLoadedUnit = new Unit(
+	// title
+	'Kilovolts',
+	// name
+	'Kilovolts',
+	// symbol
+	'kV',
+	// matchList
+	["kv","kilovolt","kilovolts"],
+	// type
+	ElectricPotentialLoadedUnitType,
+	// base
+	VoltsElectricPotentialLoadedUnit,
+		// fromBase converts V to kV
+	function fromBase (v: scalar): scalar {
+	    return v * 0.001
+	},
+		// toBase converts kV to V
+	function toBase (v: scalar): scalar {
+	    return v * 1000
+	},
+		// matcher returns true if check matches our possible names.
+	// Helpful when a user is allowed to enter in unit types
+	// freehand, for example.
+	function matcher (this: Unit, check: string): boolean {
+	    const exact = check.replace(WhitespaceRegex, '')
+	    if (this.exactMatchList.includes(exact)) return true
+	    check = sanitizeString(check)
+	    for (const m of this.matchList) {
+	    	if (m === check || m === '*') return true
+	    }
+	    return false
+	},
+	// exactMatchList
+	["kV"]
+)
+
+ElectricPotentialLoadedUnitType.base = VoltsElectricPotentialLoadedUnit
+ElectricPotentialLoadedUnitType.units = [VoltsElectricPotentialLoadedUnit,MillivoltsElectricPotentialLoadedUnit,KilovoltsElectricPotentialLoadedUnit]
+
+// ElectricPotentialUnloaded (UnitType)
+// Contains 3 units:
+//  - VoltsElectricPotentialUnloaded      V => V         = V
+//  - MillivoltsElectricPotentialUnloaded v => v * 1000  = mV
+//  - KilovoltsElectricPotentialUnloaded  v => v * 0.001 = kV
+// Base: VoltsElectricPotentialUnloaded
+
+export const ElectricPotentialUnloadedUnitType = new UnitType(
+	// title
+	'ElectricPotentialUnloaded',
+	// name
+	'Electric Potential Unloaded',
+	// unitList
+	["Volts","Millivolts","Kilovolts"],
+	// matchList
+	["electricpotentialunloaded","voltageunloaded"],
+		// matcher returns true if check matches our possible names.
+	// Helpful when a user is allowed to enter in unit types
+	// freehand, for example.
+	function matcher (this: UnitType, check: string): boolean {
+	    check = sanitizeString(check)
+	    for (const m of this.matchList) {
+	    	if (m === check || m === '*') return true
+	    }
+	    return false
+	}
+)
+
+// VoltsElectricPotentialUnloaded (Unit)
+// UnitType     : ElectricPotentialUnloaded
+// UnitType.Base: VoltsElectricPotentialUnloaded
+// Unit.FromBase: V => V = V
+// Unit.ToBase  : v => v = V
+
+export const VoltsElectricPotentialUnloadedUnit = new Unit(
+	// title
+	'Volts',
+	// name
+	'Volts',
+	// symbol
+	'V',
+	// matchList
+	["volt","volts","v"],
+	// type
+	ElectricPotentialUnloadedUnitType,
+	// base
+	null,
+		// fromBase converts V to V
+	function fromBase (V: scalar): scalar {
+	    return V
+	},
+		// toBase converts V to V
+	function toBase (v: scalar): scalar {
+	    return v
+	},
+		// matcher returns true if check matches our possible names.
+	// Helpful when a user is allowed to enter in unit types
+	// freehand, for example.
+	function matcher (this: Unit, check: string): boolean {
+	    check = sanitizeString(check)
+	    for (const m of this.matchList) {
+	    	if (m === check || m === '*') return true
+	    }
+	    return false
+	}
+)
+
+// MillivoltsElectricPotentialUnloaded (Unit)
+// UnitType     : ElectricPotentialUnloaded
+// UnitType.Base: VoltsElectricPotentialUnloaded
+// Unit.FromBase: v => v * 1000  = mV
+// Unit.ToBase  : v => v * 0.001 = V
+
+export const MillivoltsElectricPotentialUnloadedUnit = new Unit(
+	// title
+	'Millivolts',
+	// name
+	'Millivolts',
+	// symbol
+	'mV',
+	// matchList
+	["millivolt","millivolts"],
+	// type
+	ElectricPotentialUnloadedUnitType,
+	// base
+	VoltsElectricPotentialUnloadedUnit,
+		// fromBase converts V to mV
+	function fromBase (v: scalar): scalar {
+	    return v * 1000
+	},
+		// toBase converts mV to V
+	function toBase (v: scalar): scalar {
+	    return v * 0.001
+	},
+		// matcher returns true if check matches our possible names.
+	// Helpful when a user is allowed to enter in unit types
+	// freehand, for example.
+	function matcher (this: Unit, check: string): boolean {
+	    const exact = check.replace(WhitespaceRegex, '')
+	    if (this.exactMatchList.includes(exact)) return true
+	    check = sanitizeString(check)
+	    for (const m of this.matchList) {
+	    	if (m === check || m === '*') return true
+	    }
+	    return false
+	},
+	// exactMatchList
+	["mV"]
+)
+
+// KilovoltsElectricPotentialUnloaded (Unit)
+// UnitType     : ElectricPotentialUnloaded
+// UnitType.Base: VoltsElectricPotentialUnloaded
+// Unit.FromBase: v => v * 0.001 = kV
+// Unit.ToBase  : v => v * 1000  = V
+
+export const KilovoltsElectricPotentialUnloadedUnit = new Unit(
+	// title
+	'Kilovolts',
+	// name
+	'Kilovolts',
+	// symbol
+	'kV',
+	// matchList
+	["kv","kilovolt","kilovolts"],
+	// type
+	ElectricPotentialUnloadedUnitType,
+	// base
+	VoltsElectricPotentialUnloadedUnit,
+		// fromBase converts V to kV
+	function fromBase (v: scalar): scalar {
+	    return v * 0.001
+	},
+		// toBase converts kV to V
+	function toBase (v: scalar): scalar {
+	    return v * 1000
+	},
+		// matcher returns true if check matches our possible names.
+	// Helpful when a user is allowed to enter in unit types
+	// freehand, for example.
+	function matcher (this: Unit, check: string): boolean {
+	    const exact = check.replace(WhitespaceRegex, '')
+	    if (this.exactMatchList.includes(exact)) return true
+	    check = sanitizeString(check)
+	    for (const m of this.matchList) {
+	    	if (m === check || m === '*') return true
+	    }
+	    return false
+	},
+	// exactMatchList
+	["kV"]
+)
+
+ElectricPotentialUnloadedUnitType.base = VoltsElectricPotentialUnloadedUnit
+ElectricPotentialUnloadedUnitType.units = [VoltsElectricPotentialUnloadedUnit,MillivoltsElectricPotentialUnloadedUnit,KilovoltsElectricPotentialUnloadedUnit]
+
+// Percentage (UnitType)
+// Contains 1 units:
+//  - PercentPercentage p => p = %
+// Base: PercentPercentage
+
+export const PercentageUnitType = new UnitType(
+	// title
+	'Percentage',
+	// name
+	'Percentage',
+	// unitList
 	["Percent"],
 	// matchList
 	["percentage"],
@@ -2474,7 +3202,7 @@ export const PercentageUnitType = new UnitType(
 // UnitType     : Percentage
 // UnitType.Base: PercentPercentage
 // Unit.FromBase: p => p = %
-// Unit.ToBase  : p => p = %
+// Unit.ToBase  : v => v = %
 
 export const PercentPercentageUnit = new Unit(
 	// title
@@ -2494,8 +3222,8 @@ export const PercentPercentageUnit = new Unit(
 	    return p
 	},
 		// toBase converts % to %
-	function toBase (p: scalar): scalar {
-	    return p
+	function toBase (v: scalar): scalar {
+	    return v
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -2542,7 +3270,7 @@ export const HumidityUnitType = new UnitType(
 // UnitType     : Humidity
 // UnitType.Base: PercentHumidity
 // Unit.FromBase: p => p = %
-// Unit.ToBase  : p => p = %
+// Unit.ToBase  : v => v = %
 
 export const PercentHumidityUnit = new Unit(
 	// title
@@ -2562,8 +3290,8 @@ export const PercentHumidityUnit = new Unit(
 	    return p
 	},
 		// toBase converts % to %
-	function toBase (p: scalar): scalar {
-	    return p
+	function toBase (v: scalar): scalar {
+	    return v
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -2610,7 +3338,7 @@ export const AlarmUnitType = new UnitType(
 // UnitType     : Alarm
 // UnitType.Base: PercentAlarm
 // Unit.FromBase: p => p = %
-// Unit.ToBase  : p => p = %
+// Unit.ToBase  : v => v = %
 
 export const PercentAlarmUnit = new Unit(
 	// title
@@ -2630,8 +3358,8 @@ export const PercentAlarmUnit = new Unit(
 	    return p
 	},
 		// toBase converts % to %
-	function toBase (p: scalar): scalar {
-	    return p
+	function toBase (v: scalar): scalar {
+	    return v
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -2649,11 +3377,13 @@ AlarmUnitType.base = PercentAlarmUnit
 AlarmUnitType.units = [PercentAlarmUnit]
 
 // Work (UnitType)
-// Contains 4 units:
-//  - JoulesWork                 J => J                         = J
-//  - InchPoundsForceWork        J => J * 8.850,74              = in lbf
-//  - CubicFeetOfNaturalGasWork  J => J * 0.000,000,947,817     = BTUᵢₜ
-//  - BarrelsOfOilEquivalentWork J => J * 0.000,000,000,163,399 = bboe
+// Contains 6 units:
+//  - JoulesWork                 J => J                          = J
+//  - KilojoulesWork             v => v * 0.001                  = kJ
+//  - MegajoulesWork             v => v * 1e-06                  = MJ
+//  - InchPoundsForceWork        v => v * 8.850745791327185      = in lbf
+//  - CubicFeetOfNaturalGasWork  v => v * 0.0009478171203133172  = BTUᵢₜ
+//  - BarrelsOfOilEquivalentWork v => v * 1.6339869281045752e-10 = bboe
 // Base: JoulesWork
 
 export const WorkUnitType = new UnitType(
@@ -2662,7 +3392,7 @@ export const WorkUnitType = new UnitType(
 	// name
 	'Work',
 	// unitList
-	["Joules","Inch-pounds Force","Cubic Feet of Natural Gas","Barrels of Oil Equivalent"],
+	["Joules","Kilojoules","Megajoules","Inch-pounds Force","Cubic Feet of Natural Gas","Barrels of Oil Equivalent"],
 	// matchList
 	["work"],
 		// matcher returns true if check matches our possible names.
@@ -2681,7 +3411,7 @@ export const WorkUnitType = new UnitType(
 // UnitType     : Work
 // UnitType.Base: JoulesWork
 // Unit.FromBase: J => J = J
-// Unit.ToBase  : J => J = J
+// Unit.ToBase  : v => v = J
 
 export const JoulesWorkUnit = new Unit(
 	// title
@@ -2701,8 +3431,8 @@ export const JoulesWorkUnit = new Unit(
 	    return J
 	},
 		// toBase converts J to J
-	function toBase (J: scalar): scalar {
-	    return J
+	function toBase (v: scalar): scalar {
+	    return v
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -2716,11 +3446,97 @@ export const JoulesWorkUnit = new Unit(
 	}
 )
 
+// KilojoulesWork (Unit)
+// UnitType     : Work
+// UnitType.Base: JoulesWork
+// Unit.FromBase: v => v * 0.001 = kJ
+// Unit.ToBase  : v => v * 1000  = J
+
+export const KilojoulesWorkUnit = new Unit(
+	// title
+	'Kilojoules',
+	// name
+	'Kilojoules',
+	// symbol
+	'kJ',
+	// matchList
+	["kj","kilojoule","kilojoules"],
+	// type
+	WorkUnitType,
+	// base
+	JoulesWorkUnit,
+		// fromBase converts J to kJ
+	function fromBase (v: scalar): scalar {
+	    return v * 0.001
+	},
+		// toBase converts kJ to J
+	function toBase (v: scalar): scalar {
+	    return v * 1000
+	},
+		// matcher returns true if check matches our possible names.
+	// Helpful when a user is allowed to enter in unit types
+	// freehand, for example.
+	function matcher (this: Unit, check: string): boolean {
+	    const exact = check.replace(WhitespaceRegex, '')
+	    if (this.exactMatchList.includes(exact)) return true
+	    check = sanitizeString(check)
+	    for (const m of this.matchList) {
+	    	if (m === check || m === '*') return true
+	    }
+	    return false
+	},
+	// exactMatchList
+	["kJ"]
+)
+
+// MegajoulesWork (Unit)
+// UnitType     : Work
+// UnitType.Base: JoulesWork
+// Unit.FromBase: v => v * 1e-06 = MJ
+// Unit.ToBase  : v => v * 1e+06 = J
+
+export const MegajoulesWorkUnit = new Unit(
+	// title
+	'Megajoules',
+	// name
+	'Megajoules',
+	// symbol
+	'MJ',
+	// matchList
+	["megajoule","megajoules"],
+	// type
+	WorkUnitType,
+	// base
+	JoulesWorkUnit,
+		// fromBase converts J to MJ
+	function fromBase (v: scalar): scalar {
+	    return v * 1e-06
+	},
+		// toBase converts MJ to J
+	function toBase (v: scalar): scalar {
+	    return v * 1e+06
+	},
+		// matcher returns true if check matches our possible names.
+	// Helpful when a user is allowed to enter in unit types
+	// freehand, for example.
+	function matcher (this: Unit, check: string): boolean {
+	    const exact = check.replace(WhitespaceRegex, '')
+	    if (this.exactMatchList.includes(exact)) return true
+	    check = sanitizeString(check)
+	    for (const m of this.matchList) {
+	    	if (m === check || m === '*') return true
+	    }
+	    return false
+	},
+	// exactMatchList
+	["MJ"]
+)
+
 // InchPoundsForceWork (Unit)
 // UnitType     : Work
 // UnitType.Base: JoulesWork
-// Unit.FromBase: J => J * 8.850,74          = in lbf
-// Unit.ToBase  : inlbf => inlbf * 0.112,985 = J
+// Unit.FromBase: v => v * 8.850745791327185  = in lbf
+// Unit.ToBase  : v => v * 0.1129848290276167 = J
 
 export const InchPoundsForceWorkUnit = new Unit(
 	// title
@@ -2736,12 +3552,12 @@ export const InchPoundsForceWorkUnit = new Unit(
 	// base
 	JoulesWorkUnit,
 		// fromBase converts J to in lbf
-	function fromBase (J: scalar): scalar {
-	    return J * 8.85074
+	function fromBase (v: scalar): scalar {
+	    return v * 8.850745791327185
 	},
 		// toBase converts in lbf to J
-	function toBase (inlbf: scalar): scalar {
-	    return inlbf * 0.112985
+	function toBase (v: scalar): scalar {
+	    return v * 0.1129848290276167
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -2758,8 +3574,8 @@ export const InchPoundsForceWorkUnit = new Unit(
 // CubicFeetOfNaturalGasWork (Unit)
 // UnitType     : Work
 // UnitType.Base: JoulesWork
-// Unit.FromBase: J => J * 0.000,000,947,817 = BTUᵢₜ
-// Unit.ToBase  : btu => btu * 1,055,060     = J
+// Unit.FromBase: v => v * 0.0009478171203133172 = BTUᵢₜ
+// Unit.ToBase  : v => v * 1055.05585262         = J
 
 export const CubicFeetOfNaturalGasWorkUnit = new Unit(
 	// title
@@ -2775,12 +3591,12 @@ export const CubicFeetOfNaturalGasWorkUnit = new Unit(
 	// base
 	JoulesWorkUnit,
 		// fromBase converts J to BTUᵢₜ
-	function fromBase (J: scalar): scalar {
-	    return J * 0.000000947817
+	function fromBase (v: scalar): scalar {
+	    return v * 0.0009478171203133172
 	},
 		// toBase converts BTUᵢₜ to J
-	function toBase (btu: scalar): scalar {
-	    return btu * 1055060
+	function toBase (v: scalar): scalar {
+	    return v * 1055.05585262
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -2797,8 +3613,8 @@ export const CubicFeetOfNaturalGasWorkUnit = new Unit(
 // BarrelsOfOilEquivalentWork (Unit)
 // UnitType     : Work
 // UnitType.Base: JoulesWork
-// Unit.FromBase: J => J * 0.000,000,000,163,399 = bboe
-// Unit.ToBase  : bboe => bboe * 6,120,000,000   = J
+// Unit.FromBase: v => v * 1.6339869281045752e-10 = bboe
+// Unit.ToBase  : v => v * 6.12e+09               = J
 
 export const BarrelsOfOilEquivalentWorkUnit = new Unit(
 	// title
@@ -2814,12 +3630,12 @@ export const BarrelsOfOilEquivalentWorkUnit = new Unit(
 	// base
 	JoulesWorkUnit,
 		// fromBase converts J to bboe
-	function fromBase (J: scalar): scalar {
-	    return J * 0.000000000163399
+	function fromBase (v: scalar): scalar {
+	    return v * 1.6339869281045752e-10
 	},
 		// toBase converts bboe to J
-	function toBase (bboe: scalar): scalar {
-	    return bboe * 6120000000
+	function toBase (v: scalar): scalar {
+	    return v * 6.12e+09
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -2834,13 +3650,14 @@ export const BarrelsOfOilEquivalentWorkUnit = new Unit(
 )
 
 WorkUnitType.base = JoulesWorkUnit
-WorkUnitType.units = [JoulesWorkUnit,InchPoundsForceWorkUnit,CubicFeetOfNaturalGasWorkUnit,BarrelsOfOilEquivalentWorkUnit]
+WorkUnitType.units = [JoulesWorkUnit,KilojoulesWorkUnit,MegajoulesWorkUnit,InchPoundsForceWorkUnit,CubicFeetOfNaturalGasWorkUnit,BarrelsOfOilEquivalentWorkUnit]
 
 // Force (UnitType)
-// Contains 3 units:
-//  - NewtonsForce        N => N             = N
-//  - PoundsForceForce    N => N * 0.224,809 = lbf
-//  - KilogramsForceForce N => N * 0.101,972 = kgf
+// Contains 4 units:
+//  - NewtonsForce        N => N                       = N
+//  - KilonewtonsForce    v => v * 0.001               = kN
+//  - PoundsForceForce    v => v * 0.22480894309971047 = lbf
+//  - KilogramsForceForce v => v * 0.10197162129779283 = kgf
 // Base: NewtonsForce
 
 export const ForceUnitType = new UnitType(
@@ -2849,7 +3666,7 @@ export const ForceUnitType = new UnitType(
 	// name
 	'Force',
 	// unitList
-	["Newtons","Pounds-force","Kilograms-force"],
+	["Newtons","Kilonewtons","Pounds-force","Kilograms-force"],
 	// matchList
 	["force"],
 		// matcher returns true if check matches our possible names.
@@ -2868,7 +3685,7 @@ export const ForceUnitType = new UnitType(
 // UnitType     : Force
 // UnitType.Base: NewtonsForce
 // Unit.FromBase: N => N = N
-// Unit.ToBase  : N => N = N
+// Unit.ToBase  : v => v = N
 
 export const NewtonsForceUnit = new Unit(
 	// title
@@ -2888,8 +3705,8 @@ export const NewtonsForceUnit = new Unit(
 	    return N
 	},
 		// toBase converts N to N
-	function toBase (N: scalar): scalar {
-	    return N
+	function toBase (v: scalar): scalar {
+	    return v
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -2903,11 +3720,54 @@ export const NewtonsForceUnit = new Unit(
 	}
 )
 
+// KilonewtonsForce (Unit)
+// UnitType     : Force
+// UnitType.Base: NewtonsForce
+// Unit.FromBase: v => v * 0.001 = kN
+// Unit.ToBase  : v => v * 1000  = N
+
+export const KilonewtonsForceUnit = new Unit(
+	// title
+	'Kilonewtons',
+	// name
+	'Kilonewtons',
+	// symbol
+	'kN',
+	// matchList
+	["kn","kilonewton","kilonewtons"],
+	// type
+	ForceUnitType,
+	// base
+	NewtonsForceUnit,
+		// fromBase converts N to kN
+	function fromBase (v: scalar): scalar {
+	    return v * 0.001
+	},
+		// toBase converts kN to N
+	function toBase (v: scalar): scalar {
+	    return v * 1000
+	},
+		// matcher returns true if check matches our possible names.
+	// Helpful when a user is allowed to enter in unit types
+	// freehand, for example.
+	function matcher (this: Unit, check: string): boolean {
+	    const exact = check.replace(WhitespaceRegex, '')
+	    if (this.exactMatchList.includes(exact)) return true
+	    check = sanitizeString(check)
+	    for (const m of this.matchList) {
+	    	if (m === check || m === '*') return true
+	    }
+	    return false
+	},
+	// exactMatchList
+	["kN"]
+)
+
 // PoundsForceForce (Unit)
 // UnitType     : Force
 // UnitType.Base: NewtonsForce
-// Unit.FromBase: N => N * 0.224,809    = lbf
-// Unit.ToBase  : lbf => lbf * 4.448,22 = N
+// Unit.FromBase: v => v * 0.22480894309971047 = lbf
+// Unit.ToBase  : v => v * 4.4482216152605     = N
 
 export const PoundsForceForceUnit = new Unit(
 	// title
@@ -2923,12 +3783,12 @@ export const PoundsForceForceUnit = new Unit(
 	// base
 	NewtonsForceUnit,
 		// fromBase converts N to lbf
-	function fromBase (N: scalar): scalar {
-	    return N * 0.224809
+	function fromBase (v: scalar): scalar {
+	    return v * 0.22480894309971047
 	},
 		// toBase converts lbf to N
-	function toBase (lbf: scalar): scalar {
-	    return lbf * 4.44822
+	function toBase (v: scalar): scalar {
+	    return v * 4.4482216152605
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -2945,8 +3805,8 @@ export const PoundsForceForceUnit = new Unit(
 // KilogramsForceForce (Unit)
 // UnitType     : Force
 // UnitType.Base: NewtonsForce
-// Unit.FromBase: N => N * 0.101,972    = kgf
-// Unit.ToBase  : kgf => kgf * 9.806,65 = N
+// Unit.FromBase: v => v * 0.10197162129779283 = kgf
+// Unit.ToBase  : v => v * 9.80665             = N
 
 export const KilogramsForceForceUnit = new Unit(
 	// title
@@ -2962,12 +3822,12 @@ export const KilogramsForceForceUnit = new Unit(
 	// base
 	NewtonsForceUnit,
 		// fromBase converts N to kgf
-	function fromBase (N: scalar): scalar {
-	    return N * 0.101972
+	function fromBase (v: scalar): scalar {
+	    return v * 0.10197162129779283
 	},
 		// toBase converts kgf to N
-	function toBase (kgf: scalar): scalar {
-	    return kgf * 9.80665
+	function toBase (v: scalar): scalar {
+	    return v * 9.80665
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -2982,13 +3842,15 @@ export const KilogramsForceForceUnit = new Unit(
 )
 
 ForceUnitType.base = NewtonsForceUnit
-ForceUnitType.units = [NewtonsForceUnit,PoundsForceForceUnit,KilogramsForceForceUnit]
+ForceUnitType.units = [NewtonsForceUnit,KilonewtonsForceUnit,PoundsForceForceUnit,KilogramsForceForceUnit]
 
 // Length (UnitType)
-// Contains 3 units:
-//  - MetersLength m => m            = m
-//  - FeetLength   m => m * 3.280,84 = ft
-//  - InchesLength m => m * 39.370,1 = in
+// Contains 5 units:
+//  - MetersLength      m => m                      = m
+//  - MillimetersLength v => v * 1000               = mm
+//  - KilometersLength  v => v * 0.001              = km
+//  - FeetLength        v => v * 3.2808398950131235 = ft
+//  - InchesLength      v => v * 39.37007874015748  = in
 // Base: MetersLength
 
 export const LengthUnitType = new UnitType(
@@ -2997,7 +3859,7 @@ export const LengthUnitType = new UnitType(
 	// name
 	'Length',
 	// unitList
-	["Meters","Feet","Inches"],
+	["Meters","Millimeters","Kilometers","Feet","Inches"],
 	// matchList
 	["l","length"],
 		// matcher returns true if check matches our possible names.
@@ -3016,7 +3878,7 @@ export const LengthUnitType = new UnitType(
 // UnitType     : Length
 // UnitType.Base: MetersLength
 // Unit.FromBase: m => m = m
-// Unit.ToBase  : m => m = m
+// Unit.ToBase  : v => v = m
 
 export const MetersLengthUnit = new Unit(
 	// title
@@ -3036,8 +3898,8 @@ export const MetersLengthUnit = new Unit(
 	    return m
 	},
 		// toBase converts m to m
-	function toBase (m: scalar): scalar {
-	    return m
+	function toBase (v: scalar): scalar {
+	    return v
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -3051,11 +3913,97 @@ export const MetersLengthUnit = new Unit(
 	}
 )
 
+// MillimetersLength (Unit)
+// UnitType     : Length
+// UnitType.Base: MetersLength
+// Unit.FromBase: v => v * 1000  = mm
+// Unit.ToBase  : v => v * 0.001 = m
+
+export const MillimetersLengthUnit = new Unit(
+	// title
+	'Millimeters',
+	// name
+	'Millimeters',
+	// symbol
+	'mm',
+	// matchList
+	["millimeter","millimeters"],
+	// type
+	LengthUnitType,
+	// base
+	MetersLengthUnit,
+		// fromBase converts m to mm
+	function fromBase (v: scalar): scalar {
+	    return v * 1000
+	},
+		// toBase converts mm to m
+	function toBase (v: scalar): scalar {
+	    return v * 0.001
+	},
+		// matcher returns true if check matches our possible names.
+	// Helpful when a user is allowed to enter in unit types
+	// freehand, for example.
+	function matcher (this: Unit, check: string): boolean {
+	    const exact = check.replace(WhitespaceRegex, '')
+	    if (this.exactMatchList.includes(exact)) return true
+	    check = sanitizeString(check)
+	    for (const m of this.matchList) {
+	    	if (m === check || m === '*') return true
+	    }
+	    return false
+	},
+	// exactMatchList
+	["mm"]
+)
+
+// KilometersLength (Unit)
+// UnitType     : Length
+// UnitType.Base: MetersLength
+// Unit.FromBase: v => v * 0.001 = km
+// Unit.ToBase  : v => v * 1000  = m
+
+export const KilometersLengthUnit = new Unit(
+	// title
+	'Kilometers',
+	// name
+	'Kilometers',
+	// symbol
+	'km',
+	// matchList
+	["km","kilometer","kilometers"],
+	// type
+	LengthUnitType,
+	// base
+	MetersLengthUnit,
+		// fromBase converts m to km
+	function fromBase (v: scalar): scalar {
+	    return v * 0.001
+	},
+		// toBase converts km to m
+	function toBase (v: scalar): scalar {
+	    return v * 1000
+	},
+		// matcher returns true if check matches our possible names.
+	// Helpful when a user is allowed to enter in unit types
+	// freehand, for example.
+	function matcher (this: Unit, check: string): boolean {
+	    const exact = check.replace(WhitespaceRegex, '')
+	    if (this.exactMatchList.includes(exact)) return true
+	    check = sanitizeString(check)
+	    for (const m of this.matchList) {
+	    	if (m === check || m === '*') return true
+	    }
+	    return false
+	},
+	// exactMatchList
+	["km"]
+)
+
 // FeetLength (Unit)
 // UnitType     : Length
 // UnitType.Base: MetersLength
-// Unit.FromBase: m => m * 3.280,84    = ft
-// Unit.ToBase  : ft => ft * 0.304,800 = m
+// Unit.FromBase: v => v * 3.2808398950131235 = ft
+// Unit.ToBase  : v => v * 0.3048             = m
 
 export const FeetLengthUnit = new Unit(
 	// title
@@ -3071,12 +4019,12 @@ export const FeetLengthUnit = new Unit(
 	// base
 	MetersLengthUnit,
 		// fromBase converts m to ft
-	function fromBase (m: scalar): scalar {
-	    return m * 3.28084
+	function fromBase (v: scalar): scalar {
+	    return v * 3.2808398950131235
 	},
 		// toBase converts ft to m
-	function toBase (ft: scalar): scalar {
-	    return ft * 0.304800
+	function toBase (v: scalar): scalar {
+	    return v * 0.3048
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -3093,8 +4041,8 @@ export const FeetLengthUnit = new Unit(
 // InchesLength (Unit)
 // UnitType     : Length
 // UnitType.Base: MetersLength
-// Unit.FromBase: m => m * 39.370,1     = in
-// Unit.ToBase  : in => in * 0.0254,000 = m
+// Unit.FromBase: v => v * 39.37007874015748 = in
+// Unit.ToBase  : v => v * 0.0254            = m
 
 export const InchesLengthUnit = new Unit(
 	// title
@@ -3110,12 +4058,12 @@ export const InchesLengthUnit = new Unit(
 	// base
 	MetersLengthUnit,
 		// fromBase converts m to in
-	function fromBase (m: scalar): scalar {
-	    return m * 39.3701
+	function fromBase (v: scalar): scalar {
+	    return v * 39.37007874015748
 	},
 		// toBase converts in to m
-	function toBase (inch: scalar): scalar {
-	    return inch
+	function toBase (v: scalar): scalar {
+	    return v * 0.0254
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -3130,7 +4078,7 @@ export const InchesLengthUnit = new Unit(
 )
 
 LengthUnitType.base = MetersLengthUnit
-LengthUnitType.units = [MetersLengthUnit,FeetLengthUnit,InchesLengthUnit]
+LengthUnitType.units = [MetersLengthUnit,MillimetersLengthUnit,KilometersLengthUnit,FeetLengthUnit,InchesLengthUnit]
 
 // StrokeRate (UnitType)
 // Contains 1 units:
@@ -3162,7 +4110,7 @@ export const StrokeRateUnitType = new UnitType(
 // UnitType     : StrokeRate
 // UnitType.Base: StrokesPerSecondStrokeRate
 // Unit.FromBase: ss => ss = strokes/s
-// Unit.ToBase  : ss => ss = strokes/s
+// Unit.ToBase  : v => v   = strokes/s
 
 export const StrokesPerSecondStrokeRateUnit = new Unit(
 	// title
@@ -3182,8 +4130,8 @@ export const StrokesPerSecondStrokeRateUnit = new Unit(
 	    return ss
 	},
 		// toBase converts strokes/s to strokes/s
-	function toBase (ss: scalar): scalar {
-	    return ss
+	function toBase (v: scalar): scalar {
+	    return v
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -3200,6 +4148,194 @@ export const StrokesPerSecondStrokeRateUnit = new Unit(
 StrokeRateUnitType.base = StrokesPerSecondStrokeRateUnit
 StrokeRateUnitType.units = [StrokesPerSecondStrokeRateUnit]
 
+// Time (UnitType)
+// Contains 4 units:
+//  - SecondsTime s => s                          = s
+//  - MinutesTime v => v * 0.016666666666666666   = min
+//  - HoursTime   v => v * 0.0002777777777777778  = h
+//  - DaysTime    v => v * 1.1574074074074073e-05 = d
+// Base: SecondsTime
+
+export const TimeUnitType = new UnitType(
+	// title
+	'Time',
+	// name
+	'Time',
+	// unitList
+	["Seconds","Minutes","Hours","Days"],
+	// matchList
+	["time","duration"],
+		// matcher returns true if check matches our possible names.
+	// Helpful when a user is allowed to enter in unit types
+	// freehand, for example.
+	function matcher (this: UnitType, check: string): boolean {
+	    check = sanitizeString(check)
+	    for (const m of this.matchList) {
+	    	if (m === check || m === '*') return true
+	    }
+	    return false
+	}
+)
+
+// SecondsTime (Unit)
+// UnitType     : Time
+// UnitType.Base: SecondsTime
+// Unit.FromBase: s => s = s
+// Unit.ToBase  : v => v = s
+
+export const SecondsTimeUnit = new Unit(
+	// title
+	'Seconds',
+	// name
+	'Seconds',
+	// symbol
+	's',
+	// matchList
+	["s","sec","secs","second","seconds"],
+	// type
+	TimeUnitType,
+	// base
+	null,
+		// fromBase converts s to s
+	function fromBase (s: scalar): scalar {
+	    return s
+	},
+		// toBase converts s to s
+	function toBase (v: scalar): scalar {
+	    return v
+	},
+		// matcher returns true if check matches our possible names.
+	// Helpful when a user is allowed to enter in unit types
+	// freehand, for example.
+	function matcher (this: Unit, check: string): boolean {
+	    check = sanitizeString(check)
+	    for (const m of this.matchList) {
+	    	if (m === check || m === '*') return true
+	    }
+	    return false
+	}
+)
+
+// MinutesTime (Unit)
+// UnitType     : Time
+// UnitType.Base: SecondsTime
+// Unit.FromBase: v => v * 0.016666666666666666 = min
+// Unit.ToBase  : v => v * 60                   = s
+
+export const MinutesTimeUnit = new Unit(
+	// title
+	'Minutes',
+	// name
+	'Minutes',
+	// symbol
+	'min',
+	// matchList
+	["min","mins","minute","minutes"],
+	// type
+	TimeUnitType,
+	// base
+	SecondsTimeUnit,
+		// fromBase converts s to min
+	function fromBase (v: scalar): scalar {
+	    return v * 0.016666666666666666
+	},
+		// toBase converts min to s
+	function toBase (v: scalar): scalar {
+	    return v * 60
+	},
+		// matcher returns true if check matches our possible names.
+	// Helpful when a user is allowed to enter in unit types
+	// freehand, for example.
+	function matcher (this: Unit, check: string): boolean {
+	    check = sanitizeString(check)
+	    for (const m of this.matchList) {
+	    	if (m === check || m === '*') return true
+	    }
+	    return false
+	}
+)
+
+// HoursTime (Unit)
+// UnitType     : Time
+// UnitType.Base: SecondsTime
+// Unit.FromBase: v => v * 0.0002777777777777778 = h
+// Unit.ToBase  : v => v * 3600                  = s
+
+export const HoursTimeUnit = new Unit(
+	// title
+	'Hours',
+	// name
+	'Hours',
+	// symbol
+	'h',
+	// matchList
+	["h","hr","hrs","hour","hours"],
+	// type
+	TimeUnitType,
+	// base
+	SecondsTimeUnit,
+		// fromBase converts s to h
+	function fromBase (v: scalar): scalar {
+	    return v * 0.0002777777777777778
+	},
+		// toBase converts h to s
+	function toBase (v: scalar): scalar {
+	    return v * 3600
+	},
+		// matcher returns true if check matches our possible names.
+	// Helpful when a user is allowed to enter in unit types
+	// freehand, for example.
+	function matcher (this: Unit, check: string): boolean {
+	    check = sanitizeString(check)
+	    for (const m of this.matchList) {
+	    	if (m === check || m === '*') return true
+	    }
+	    return false
+	}
+)
+
+// DaysTime (Unit)
+// UnitType     : Time
+// UnitType.Base: SecondsTime
+// Unit.FromBase: v => v * 1.1574074074074073e-05 = d
+// Unit.ToBase  : v => v * 86400                  = s
+
+export const DaysTimeUnit = new Unit(
+	// title
+	'Days',
+	// name
+	'Days',
+	// symbol
+	'd',
+	// matchList
+	["d","day","days"],
+	// type
+	TimeUnitType,
+	// base
+	SecondsTimeUnit,
+		// fromBase converts s to d
+	function fromBase (v: scalar): scalar {
+	    return v * 1.1574074074074073e-05
+	},
+		// toBase converts d to s
+	function toBase (v: scalar): scalar {
+	    return v * 86400
+	},
+		// matcher returns true if check matches our possible names.
+	// Helpful when a user is allowed to enter in unit types
+	// freehand, for example.
+	function matcher (this: Unit, check: string): boolean {
+	    check = sanitizeString(check)
+	    for (const m of this.matchList) {
+	    	if (m === check || m === '*') return true
+	    }
+	    return false
+	}
+)
+
+TimeUnitType.base = SecondsTimeUnit
+TimeUnitType.units = [SecondsTimeUnit,MinutesTimeUnit,HoursTimeUnit,DaysTimeUnit]
+
 // Number (UnitType)
 // Contains 1 units:
 //  - NumberNumber n => n = 
@@ -3230,7 +4366,7 @@ export const NumberUnitType = new UnitType(
 // UnitType     : Number
 // UnitType.Base: NumberNumber
 // Unit.FromBase: n => n = 
-// Unit.ToBase  : n => n = 
+// Unit.ToBase  : v => v = 
 
 export const NumberNumberUnit = new Unit(
 	// title
@@ -3250,8 +4386,8 @@ export const NumberNumberUnit = new Unit(
 	    return n
 	},
 		// toBase converts  to 
-	function toBase (n: scalar): scalar {
-	    return n
+	function toBase (v: scalar): scalar {
+	    return v
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -3298,7 +4434,7 @@ export const OverspeedUnitType = new UnitType(
 // UnitType     : Overspeed
 // UnitType.Base: NumberOverspeed
 // Unit.FromBase: n => n = 
-// Unit.ToBase  : n => n = 
+// Unit.ToBase  : v => v = 
 
 export const NumberOverspeedUnit = new Unit(
 	// title
@@ -3318,8 +4454,8 @@ export const NumberOverspeedUnit = new Unit(
 	    return n
 	},
 		// toBase converts  to 
-	function toBase (n: scalar): scalar {
-	    return n
+	function toBase (v: scalar): scalar {
+	    return v
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -3366,7 +4502,7 @@ export const UnderspeedUnitType = new UnitType(
 // UnitType     : Underspeed
 // UnitType.Base: NumberUnderspeed
 // Unit.FromBase: n => n = 
-// Unit.ToBase  : n => n = 
+// Unit.ToBase  : v => v = 
 
 export const NumberUnderspeedUnit = new Unit(
 	// title
@@ -3386,8 +4522,8 @@ export const NumberUnderspeedUnit = new Unit(
 	    return n
 	},
 		// toBase converts  to 
-	function toBase (n: scalar): scalar {
-	    return n
+	function toBase (v: scalar): scalar {
+	    return v
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -3434,7 +4570,7 @@ export const TotaliserUnitType = new UnitType(
 // UnitType     : Totaliser
 // UnitType.Base: NumberTotaliser
 // Unit.FromBase: n => n = 
-// Unit.ToBase  : n => n = 
+// Unit.ToBase  : v => v = 
 
 export const NumberTotaliserUnit = new Unit(
 	// title
@@ -3454,8 +4590,8 @@ export const NumberTotaliserUnit = new Unit(
 	    return n
 	},
 		// toBase converts  to 
-	function toBase (n: scalar): scalar {
-	    return n
+	function toBase (v: scalar): scalar {
+	    return v
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -3502,7 +4638,7 @@ export const WMLFlowRateUnitType = new UnitType(
 // UnitType     : WMLFlowRate
 // UnitType.Base: NumberWMLFlowRate
 // Unit.FromBase: n => n = 
-// Unit.ToBase  : n => n = 
+// Unit.ToBase  : v => v = 
 
 export const NumberWMLFlowRateUnit = new Unit(
 	// title
@@ -3522,8 +4658,8 @@ export const NumberWMLFlowRateUnit = new Unit(
 	    return n
 	},
 		// toBase converts  to 
-	function toBase (n: scalar): scalar {
-	    return n
+	function toBase (v: scalar): scalar {
+	    return v
 	},
 		// matcher returns true if check matches our possible names.
 	// Helpful when a user is allowed to enter in unit types
@@ -3538,4 +4674,135 @@ export const NumberWMLFlowRateUnit = new Unit(
 )
 
 WMLFlowRateUnitType.base = NumberWMLFlowRateUnit
-WMLFlowRateUnitType.units = [NumberWMLFlowRateUnit]
\ No newline at end of file
+WMLFlowRateUnitType.units = [NumberWMLFlowRateUnit]
+
+// UnitTypes is a list of all available unit types,
+// in the same order as AllTypes
+export const UnitTypes: UnitType[] = [
+    PressureUnitType,
+    TemperatureUnitType,
+    TemperatureDifferenceUnitType,
+    FlowUnitType,
+    VolumeUnitType,
+    MassUnitType,
+    MassFlowUnitType,
+    ElectricPotentialUnitType,
+    ElectricPotentialLoadedUnitType,
+    ElectricPotentialUnloadedUnitType,
+    PercentageUnitType,
+    HumidityUnitType,
+    AlarmUnitType,
+    WorkUnitType,
+    ForceUnitType,
+    LengthUnitType,
+    StrokeRateUnitType,
+    TimeUnitType,
+    NumberUnitType,
+    OverspeedUnitType,
+    UnderspeedUnitType,
+    TotaliserUnitType,
+    WMLFlowRateUnitType,
+]
+
+// findUnits returns every unit, of any unit type, whose matchList includes
+// input, so the caller can pick the type from context or treat the input as
+// ambiguous. 'volts' for example is found in ElectricPotential,
+// ElectricPotentialLoaded and ElectricPotentialUnloaded.
+export function findUnits (input: string): [UnitType, Unit][] {
+	const check = sanitizeString(input)
+	const out: [UnitType, Unit][] = []
+	for (const type of UnitTypes) {
+		const unit = type.units.find(u => u.exactMatchList.includes(input.replace(WhitespaceRegex, ''))) ??
+			type.units.find(u => u.matchList.includes(check))
+		if (unit !== undefined) out.push([type, unit])
+	}
+	return out
+}
+
+// Suggestion is a unit which input may have been meant as, see suggestUnits
+export interface Suggestion {
+	type: UnitType
+	unit: Unit
+	// match is the match string, name or symbol which input was closest to
+	match: string
+	// confidence is from 0 to 1, where 1 is an exact match
+	confidence: number
+}
+
+// minSuggestionConfidence is the lowest confidence that suggestUnits returns
+export const minSuggestionConfidence = 0.5
+
+// suggestUnits returns up to n units of typeOf which input may have meant,
+// best first. Every unit's matchList, name and symbol are scored against the
+// sanitized input: prefixes score highly, so it works for autocomplete, and
+// anything else by edit distance, so "psia" still finds psi.
+export function suggestUnits (input: string, typeOf: UnitType, n: number): Suggestion[] {
+	return suggest(input, [typeOf], n)
+}
+
+// suggestAnyUnits is suggestUnits across every unit type
+export function suggestAnyUnits (input: string, n: number): Suggestion[] {
+	return suggest(input, UnitTypes, n)
+}
+
+function suggest (input: string, types: UnitType[], n: number): Suggestion[] {
+	const check = sanitizeString(input)
+	if (check === '' || n <= 0) return []
+
+	const out: Suggestion[] = []
+	for (const type of types) {
+		for (const unit of type.units) {
+			const best: Suggestion = { type, unit, match: '', confidence: 0 }
+			const candidates = [unit.symbol, unit.name, ...unit.matchList.filter(m => m !== '*')]
+			for (const candidate of candidates) {
+				const c = similarity(check, sanitizeString(candidate))
+				if (c > best.confidence) {
+					best.match = candidate
+					best.confidence = c
+				}
+			}
+			if (best.confidence >= minSuggestionConfidence) out.push(best)
+		}
+	}
+
+	// Array.prototype.sort is stable, so ties keep their declared order
+	return out.sort((a, b) => b.confidence - a.confidence).slice(0, n)
+}
+
+// similarity scores how close input is to candidate from 0 to 1. A prefix
+// of candidate scores at least 0.6, growing with how much of it is typed.
+function similarity (input: string, candidate: string): number {
+	if (candidate === '') return 0
+	if (input === candidate) return 1
+
+	const a = Array.from(input)
+	const b = Array.from(candidate)
+	let score = 1 - editDistance(a, b) / Math.max(a.length, b.length)
+	if (candidate.startsWith(input)) {
+		score = Math.max(score, 0.6 + 0.39 * a.length / b.length)
+	}
+	return score
+}
+
+// editDistance is the Levenshtein distance between a and b
+function editDistance (a: string[], b: string[]): number {
+	let prev = b.map((_, j) => j).concat(b.length)
+	let curr = new Array<number>(b.length + 1).fill(0)
+	for (let i = 1; i <= a.length; i++) {
+		curr[0] = i
+		for (let j = 1; j <= b.length; j++) {
+			const cost = a[i - 1] === b[j - 1] ? 0 : 1
+			curr[j] = Math.min(prev[j] + 1, curr[j - 1] + 1, prev[j - 1] + cost)
+		}
+		[prev, curr] = [curr, prev]
+	}
+	return prev[b.length]
+}
+
+// didYouMean returns the hint added to an ErrUnknownUnit, eg.
+// '; did you mean "kPa"?', or '' when nothing is close
+function didYouMean (input: string, typeOf: UnitType): string {
+	const [suggestion] = suggestUnits(input, typeOf, 1)
+	if (suggestion === undefined) return ''
+	return `; did you mean "${suggestion.unit.symbol || suggestion.unit.name}"?`
+}
\ No newline at end of file
@@ -0,0 +1,71 @@
+package units
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// QuantityRegex splits free text into a leading number and a trailing unit.
+// The number may use thousands separators ("3,051"), a decimal point and
+// scientific notation ("1.2e-3"). Whitespace between the two is optional.
+var QuantityRegex = regexp.MustCompile(`^([+-]?(?:\d{1,3}(?:,\d{3})+(?:\.\d*)?|\d+(?:\.\d*)?|\.\d+)(?:[eE][+-]?\d+)?)\s*(.*)$`)
+
+// splitNumber returns the parsed number and the raw unit string of input,
+// which is empty when there is no unit
+func splitNumber(input string) (float64, string, error) {
+	parts := QuantityRegex.FindStringSubmatch(strings.TrimSpace(input))
+	if parts == nil {
+		return 0, "", fmt.Errorf("units: parsing %q: no leading number", input)
+	}
+
+	value, err := strconv.ParseFloat(strings.ReplaceAll(parts[1], ",", ""), 64)
+	if err != nil {
+		return 0, "", fmt.Errorf("units: parsing %q: %w", input, err)
+	}
+	return value, parts[2], nil
+}
+
+// splitQuantity is splitNumber with a required unit
+func splitQuantity(input string) (float64, string, error) {
+	value, unit, err := splitNumber(input)
+	if err == nil && unit == "" {
+		err = fmt.Errorf("units: parsing %q: missing unit", input)
+	}
+	return value, unit, err
+}
+
+// ParseQuantity parses free text such as "12.5 psi", "150psi" or "-10 °F"
+// into a Quantity whose unit belongs to typeOf. Unlike GetUnit there is no
+// fallback to Number; an unknown unit returns an error wrapping *ErrUnknownUnit.
+func ParseQuantity(input string, typeOf UnitType) (Quantity, error) {
+	value, unit, err := splitQuantity(input)
+	if err != nil {
+		return Quantity{}, err
+	}
+
+	u, err := LookupUnit(unit, typeOf)
+	if err != nil {
+		return Quantity{}, fmt.Errorf("units: parsing %q: %w", input, err)
+	}
+	return Quantity{Value: value, Unit: u}, nil
+}
+
+// ParseAnyQuantity is ParseQuantity without a known UnitType. Every type in
+// RegisteredTypes is searched in order and the first matching unit wins, so
+// inputs that are shared between types (eg. "V" or "percent") resolve to the
+// first type that declares them. Use ParseQuantity when the type is known.
+func ParseAnyQuantity(input string) (Quantity, error) {
+	value, unit, err := splitQuantity(input)
+	if err != nil {
+		return Quantity{}, err
+	}
+
+	for _, ut := range RegisteredTypes() {
+		if u, ok := findUnit(unit, ut); ok {
+			return Quantity{Value: value, Unit: u}, nil
+		}
+	}
+	return Quantity{}, fmt.Errorf("units: parsing %q: %w", input, &ErrUnknownUnit{Input: SanitizeString(unit)})
+}
@@ -0,0 +1,69 @@
+package units
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseQuantity(t *testing.T) {
+	tests := []struct {
+		input  string
+		typeOf UnitType
+		want   Quantity
+	}{
+		{"3,051 MCFD", FlowUnitType, NewQuantity(3051, ThousandCubicFeetPerDayFlowUnit)},
+		{"1.2e-3 psi", PressureUnitType, NewQuantity(1.2e-3, PoundsPerSquareInchPressureUnit)},
+		{"150psi", PressureUnitType, NewQuantity(150, PoundsPerSquareInchPressureUnit)},
+		{"  12.5   kPa ", PressureUnitType, NewQuantity(12.5, KilopascalsPressureUnit)},
+		{"2 ft³/s", FlowUnitType, NewQuantity(2, CubicFeetPerSecondFlowUnit)},
+		{"3 inH₂O", PressureUnitType, NewQuantity(3, InchesOfWaterPressureUnit)},
+		{"3 in H2O", PressureUnitType, NewQuantity(3, InchesOfWaterPressureUnit)},
+		{"-10 °F", TemperatureUnitType, NewQuantity(-10, DegreesFahrenheitTemperatureUnit)},
+		{".5 m", LengthUnitType, NewQuantity(0.5, MetersLengthUnit)},
+	}
+	for _, tc := range tests {
+		if got, err := ParseQuantity(tc.input, tc.typeOf); err != nil || got != tc.want {
+			t.Errorf("ParseQuantity(%q) = %v, %v, want %v", tc.input, got, err, tc.want)
+		}
+	}
+
+	errorTests := map[string]UnitType{
+		"°F":          TemperatureUnitType,
+		"psi 150":     PressureUnitType,
+		"":            PressureUnitType,
+		"150":         PressureUnitType,
+		"150 furlong": LengthUnitType,
+		"150 psi":     LengthUnitType,
+		"150 psi!!":   PressureUnitType,
+		"1,23 psi":    PressureUnitType,
+	}
+	for input, typeOf := range errorTests {
+		if got, err := ParseQuantity(input, typeOf); err == nil {
+			t.Errorf("ParseQuantity(%q, %s) = %v, want an error", input, typeOf.Title(), got)
+		}
+	}
+	var unknown *ErrUnknownUnit
+	if _, err := ParseQuantity("150 furlong", LengthUnitType); !errors.As(err, &unknown) || unknown.Input != "furlong" {
+		t.Errorf("ParseQuantity(150 furlong) = %v, want an *ErrUnknownUnit", err)
+	}
+}
+
+func TestParseAnyQuantity(t *testing.T) {
+	tests := map[string]Quantity{
+		"3,051 MCFD": NewQuantity(3051, ThousandCubicFeetPerDayFlowUnit),
+		"150psi":     NewQuantity(150, PoundsPerSquareInchPressureUnit),
+		"2 ft³/s":    NewQuantity(2, CubicFeetPerSecondFlowUnit),
+		"3 inH₂O":    NewQuantity(3, InchesOfWaterPressureUnit),
+		"-10 °F":     NewQuantity(-10, DegreesFahrenheitTemperatureUnit),
+	}
+	for input, want := range tests {
+		if got, err := ParseAnyQuantity(input); err != nil || got != want {
+			t.Errorf("ParseAnyQuantity(%q) = %v, %v, want %v", input, got, err, want)
+		}
+	}
+	for _, input := range []string{"°F", "150", "150 furlong", "150 psi ft"} {
+		if got, err := ParseAnyQuantity(input); err == nil {
+			t.Errorf("ParseAnyQuantity(%q) = %v, want an error", input, got)
+		}
+	}
+}
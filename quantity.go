@@ -0,0 +1,218 @@
+package units
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+)
+
+// Quantity is a scalar paired with the Unit it is measured in. It's the
+// unit-carrying counterpart to the generated float64 types, and the value
+// to pass around once a number has left a typed variable.
+type Quantity struct {
+	Value float64
+	Unit  Unit
+}
+
+// NewQuantity returns a Quantity of value measured in u
+func NewQuantity(value float64, u Unit) Quantity {
+	return Quantity{Value: value, Unit: u}
+}
+
+// TypeOf returns the UnitType of the quantity's unit
+func (q Quantity) TypeOf() UnitType {
+	return q.Unit.TypeOf()
+}
+
+// Base returns the quantity converted to the base unit of its UnitType
+func (q Quantity) Base() Quantity {
+	return Quantity{Value: q.Unit.ToBase(q.Value), Unit: q.Unit.Base()}
+}
+
+// In returns the quantity converted to u. A *TypeMismatchError is returned
+// when u belongs to a different UnitType.
+func (q Quantity) In(u Unit) (Quantity, error) {
+	v, err := Convert(q.Value, q.Unit, u)
+	if err != nil {
+		return Quantity{}, err
+	}
+	return Quantity{Value: v, Unit: u}, nil
+}
+
+// AbsoluteValueError is returned for arithmetic that makes no sense on
+// absolute values of an affine unit type, such as adding two temperatures
+type AbsoluteValueError struct {
+	Op   string
+	Type UnitType
+}
+
+// Error implements the error interface
+func (e *AbsoluteValueError) Error() string {
+	return fmt.Sprintf("units: cannot %s absolute %s values", e.Op, e.Type.Title())
+}
+
+// Add returns q + o in the unit of q, converting o as needed. Absolute values
+// of an affine unit type (see DifferenceType) can only have a difference
+// added to them, eg. 20 °C + 18 Δ°F is 30 °C.
+func (q Quantity) Add(o Quantity) (Quantity, error) {
+	if diff := DifferenceType(q.TypeOf()); diff != nil {
+		if SameType(q.Unit, o.Unit) {
+			return Quantity{}, &AbsoluteValueError{Op: "add", Type: q.TypeOf()}
+		}
+		return q.shift(o, diff, 1)
+	}
+	if DifferenceType(o.TypeOf()) != nil {
+		return o.Add(q)
+	}
+
+	v, err := Convert(o.Value, o.Unit, q.Unit)
+	if err != nil {
+		return Quantity{}, err
+	}
+	return Quantity{Value: q.Value + v, Unit: q.Unit}, nil
+}
+
+// Sub returns q - o in the unit of q, converting o as needed. Subtracting two
+// absolute values of an affine unit type returns their difference, eg.
+// 30 °C - 20 °C is 10 Δ°C.
+func (q Quantity) Sub(o Quantity) (Quantity, error) {
+	if diff := DifferenceType(q.TypeOf()); diff != nil {
+		if !SameType(q.Unit, o.Unit) {
+			return q.shift(o, diff, -1)
+		}
+		du, err := differenceUnit(q.Unit, diff)
+		if err != nil {
+			return Quantity{}, err
+		}
+		v, _ := Convert(o.Value, o.Unit, q.Unit)
+		return Quantity{Value: q.Value - v, Unit: du}, nil
+	}
+
+	v, err := Convert(o.Value, o.Unit, q.Unit)
+	if err != nil {
+		return Quantity{}, err
+	}
+	return Quantity{Value: q.Value - v, Unit: q.Unit}, nil
+}
+
+// shift moves the absolute value q by sign times the difference o
+func (q Quantity) shift(o Quantity, diff UnitType, sign float64) (Quantity, error) {
+	if o.TypeOf().Title() != diff.Title() {
+		return Quantity{}, &TypeMismatchError{From: o.TypeOf(), To: diff}
+	}
+
+	du, err := differenceUnit(q.Unit, diff)
+	if err != nil {
+		return Quantity{}, err
+	}
+	d, err := Convert(o.Value, o.Unit, du)
+	if err != nil {
+		return Quantity{}, err
+	}
+	return Quantity{Value: q.Value + sign*d, Unit: q.Unit}, nil
+}
+
+// differenceUnit returns the unit of diff which shares a title, and so a
+// scale, with the absolute unit u, eg. Δ°F for °F
+func differenceUnit(u Unit, diff UnitType) (Unit, error) {
+	_, du, err := LookupTypeUnit(AlakaTitle(diff, u))
+	return du, err
+}
+
+// Compare returns -1, 0 or 1 when q is less than, equal to or greater than o.
+// Both quantities are compared in their base unit.
+func (q Quantity) Compare(o Quantity) (int, error) {
+	if !SameType(q.Unit, o.Unit) {
+		return 0, &TypeMismatchError{From: q.TypeOf(), To: o.TypeOf()}
+	}
+	a, b := q.Unit.ToBase(q.Value), o.Unit.ToBase(o.Value)
+	switch {
+	case a < b:
+		return -1, nil
+	case a > b:
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+// Equal returns true if both quantities represent the same amount. Quantities
+// of different UnitTypes are never equal.
+func (q Quantity) Equal(o Quantity) bool {
+	c, err := q.Compare(o)
+	return err == nil && c == 0
+}
+
+// String returns the value followed by the unit symbol, eg. "12.5 psi"
+func (q Quantity) String() string {
+	value := strconv.FormatFloat(q.Value, 'g', -1, 64)
+	if q.Unit == nil || q.Unit.Symbol() == "" {
+		return value
+	}
+	return value + " " + q.Unit.Symbol()
+}
+
+// Multiply returns q × o. The product is worked out in base units and the
+// result is the base unit of whichever UnitType has the combined dimension,
+// so the units of the operands don't matter: psi × ft³ is a Work in Joules.
+// Percentages are fractions of one, so 50 % × 10 m is 5 m and 50 % × 50 %
+// is 25 %. A *DimensionError is returned when no UnitType matches.
+func (q Quantity) Multiply(o Quantity) (Quantity, error) {
+	if err := checkLinear("multiply", q, o); err != nil {
+		return Quantity{}, err
+	}
+	ut, err := TypeOfDimension(q.TypeOf().Dimension().Mul(o.TypeOf().Dimension()), q.TypeOf(), o.TypeOf())
+	if err != nil {
+		return Quantity{}, err
+	}
+	value := q.coherent() * o.coherent() / coherentScale(ut)
+	return Quantity{Value: value, Unit: ut.Base()}, nil
+}
+
+// Divide returns q ÷ o, see Multiply. Eg. a Volume divided by a Time is a Flow
+// in cubic meters per second.
+func (q Quantity) Divide(o Quantity) (Quantity, error) {
+	if err := checkLinear("divide", q, o); err != nil {
+		return Quantity{}, err
+	}
+	ut, err := TypeOfDimension(q.TypeOf().Dimension().Div(o.TypeOf().Dimension()), q.TypeOf(), o.TypeOf())
+	if err != nil {
+		return Quantity{}, err
+	}
+	value := q.coherent() / o.coherent() / coherentScale(ut)
+	return Quantity{Value: value, Unit: ut.Base()}, nil
+}
+
+// coherent returns the quantity's value in the coherent SI unit of its
+// dimension, which is its base unit for every type but percentages
+func (q Quantity) coherent() float64 {
+	return q.Base().Value * coherentScale(q.TypeOf())
+}
+
+// coherentScale is the amount of the coherent SI unit in one base unit of
+// ut, 0.01 for the types whose base unit is Percent and otherwise 1
+func coherentScale(ut UnitType) float64 {
+	if ut.Base().Symbol() == "%" {
+		return 0.01
+	}
+	return 1
+}
+
+// exactCoherentScale is coherentScale as an exact fraction
+func exactCoherentScale(ut UnitType) *big.Rat {
+	if ut.Base().Symbol() == "%" {
+		return big.NewRat(1, 100)
+	}
+	return big.NewRat(1, 1)
+}
+
+// checkLinear returns an *AbsoluteValueError if any of qs is the absolute
+// value of an affine unit type
+func checkLinear(op string, qs ...Quantity) error {
+	for _, q := range qs {
+		if DifferenceType(q.TypeOf()) != nil {
+			return &AbsoluteValueError{Op: op, Type: q.TypeOf()}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package units
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestQuantityIn(t *testing.T) {
+	tests := []struct {
+		q    Quantity
+		to   Unit
+		want float64
+	}{
+		{NewQuantity(1, PoundsPerSquareInchPressureUnit), KilopascalsPressureUnit, 6.894757293168361},
+		{NewQuantity(100, DegreesCelsiusTemperatureUnit), DegreesFahrenheitTemperatureUnit, 212},
+		{NewQuantity(2.5, KilometersLengthUnit), MetersLengthUnit, 2500},
+		{NewQuantity(7, MetersLengthUnit), MetersLengthUnit, 7},
+	}
+	for _, tc := range tests {
+		got, err := tc.q.In(tc.to)
+		if err != nil || got.Unit != tc.to || math.Abs(got.Value-tc.want) > 1e-9 {
+			t.Errorf("%s In %s = %v, %v, want %v", tc.q, tc.to.Symbol(), got, err, tc.want)
+		}
+	}
+
+	var mismatch *TypeMismatchError
+	if got, err := NewQuantity(1, PoundsPerSquareInchPressureUnit).In(MetersLengthUnit); !errors.As(err, &mismatch) || got != (Quantity{}) {
+		t.Errorf("1 psi In m = %v, %v, want a TypeMismatchError", got, err)
+	} else if mismatch.From != PressureUnitType || mismatch.To != LengthUnitType {
+		t.Errorf("1 psi In m mismatch = %s to %s", mismatch.From.Title(), mismatch.To.Title())
+	}
+
+	if got := NewQuantity(1, KilopascalsPressureUnit).Base(); got != NewQuantity(1000, PascalsPressureUnit) {
+		t.Errorf("1 kPa Base = %v", got)
+	}
+	if got := NewQuantity(32, DegreesFahrenheitTemperatureUnit).Base(); got.Unit != DegreesCelsiusTemperatureUnit || math.Abs(got.Value) > 1e-12 {
+		t.Errorf("32 °F Base = %v", got)
+	}
+}
+
+func TestCompareEqual(t *testing.T) {
+	tests := []struct {
+		q, o Quantity
+		want int
+	}{
+		{NewQuantity(1, KilometersLengthUnit), NewQuantity(1000, MetersLengthUnit), 0},
+		{NewQuantity(1, FeetLengthUnit), NewQuantity(13, InchesLengthUnit), -1},
+		{NewQuantity(1, FeetLengthUnit), NewQuantity(1, MetersLengthUnit), -1},
+		{NewQuantity(1, MegapascalsPressureUnit), NewQuantity(100, PoundsPerSquareInchPressureUnit), 1},
+		{NewQuantity(0, DegreesCelsiusTemperatureUnit), NewQuantity(32, DegreesFahrenheitTemperatureUnit), 0},
+		{NewQuantity(-40, DegreesCelsiusTemperatureUnit), NewQuantity(-39, DegreesFahrenheitTemperatureUnit), -1},
+	}
+	for _, tc := range tests {
+		got, err := tc.q.Compare(tc.o)
+		if err != nil || got != tc.want {
+			t.Errorf("%s Compare %s = %d, %v, want %d", tc.q, tc.o, got, err, tc.want)
+		}
+		if eq := tc.q.Equal(tc.o); eq != (tc.want == 0) {
+			t.Errorf("%s Equal %s = %v", tc.q, tc.o, eq)
+		}
+	}
+
+	var mismatch *TypeMismatchError
+	m, psi := NewQuantity(1, MetersLengthUnit), NewQuantity(1, PoundsPerSquareInchPressureUnit)
+	if _, err := m.Compare(psi); !errors.As(err, &mismatch) {
+		t.Errorf("1 m Compare 1 psi = %v, want a TypeMismatchError", err)
+	}
+	if m.Equal(psi) {
+		t.Error("1 m Equal 1 psi")
+	}
+}
+
+func TestQuantityString(t *testing.T) {
+	tests := map[string]Quantity{
+		"12.5 psi":  NewQuantity(12.5, PoundsPerSquareInchPressureUnit),
+		"-10 °F":    NewQuantity(-10, DegreesFahrenheitTemperatureUnit),
+		"1.2e-07 m": NewQuantity(1.2e-7, MetersLengthUnit),
+		"42":        NewQuantity(42, NumberNumberUnit),
+		"3":         {Value: 3},
+	}
+	for want, q := range tests {
+		if got := q.String(); got != want {
+			t.Errorf("String() = %q, want %q", got, want)
+		}
+	}
+}
+
+func TestMultiplyDivide(t *testing.T) {
+	tests := []struct {
+		name   string
+		q, o   Quantity
+		divide bool
+		want   Quantity
+	}{
+		{"flow × time", NewQuantity(2, CubicMetersPerSecondFlowUnit), NewQuantity(60, SecondsTimeUnit), false, NewQuantity(120, CubicMetersVolumeUnit)},
+		{"gpm × min", NewQuantity(10, GallonsUSFluidPerMinuteFlowUnit), NewQuantity(1, MinutesTimeUnit), false, NewQuantity(0.03785411784, CubicMetersVolumeUnit)},
+		{"percent × length", NewQuantity(50, PercentPercentageUnit), NewQuantity(10, MetersLengthUnit), false, NewQuantity(5, MetersLengthUnit)},
+		{"percent × percent", NewQuantity(50, PercentPercentageUnit), NewQuantity(50, PercentPercentageUnit), false, NewQuantity(25, PercentPercentageUnit)},
+		{"volume / time", NewQuantity(1, CubicMetersVolumeUnit), NewQuantity(60, SecondsTimeUnit), true, NewQuantity(1.0/60, CubicMetersPerSecondFlowUnit)},
+		{"length / length", NewQuantity(10, MetersLengthUnit), NewQuantity(20, MetersLengthUnit), true, NewQuantity(0.5, NumberNumberUnit)},
+		{"length / percent", NewQuantity(5, MetersLengthUnit), NewQuantity(50, PercentPercentageUnit), true, NewQuantity(10, MetersLengthUnit)},
+	}
+	for _, tc := range tests {
+		op := tc.q.Multiply
+		if tc.divide {
+			op = tc.q.Divide
+		}
+		got, err := op(tc.o)
+		if err != nil {
+			t.Errorf("%s: %v", tc.name, err)
+			continue
+		}
+		if got.Unit != tc.want.Unit || math.Abs(got.Value-tc.want.Value) > 1e-12 {
+			t.Errorf("%s: %v %v = %v, want %v", tc.name, tc.q, tc.o, got, tc.want)
+		}
+	}
+
+	var dimErr *DimensionError
+	if _, err := NewQuantity(1, MetersLengthUnit).Multiply(NewQuantity(1, SecondsTimeUnit)); !errors.As(err, &dimErr) {
+		t.Errorf("m × s = %v, want a DimensionError", err)
+	}
+	var absErr *AbsoluteValueError
+	if _, err := NewQuantity(20, DegreesCelsiusTemperatureUnit).Divide(NewQuantity(1, SecondsTimeUnit)); !errors.As(err, &absErr) {
+		t.Errorf("°C / s = %v, want an AbsoluteValueError", err)
+	}
+}
+
+func TestAffineAddSub(t *testing.T) {
+	tests := []struct {
+		name string
+		q, o Quantity
+		sub  bool
+		want Quantity
+	}{
+		{"absolute + difference", NewQuantity(20, DegreesCelsiusTemperatureUnit), NewQuantity(18, DegreesFahrenheitTemperatureDifferenceUnit), false, NewQuantity(30, DegreesCelsiusTemperatureUnit)},
+		{"difference + absolute", NewQuantity(10, KelvinsTemperatureDifferenceUnit), NewQuantity(50, DegreesFahrenheitTemperatureUnit), false, NewQuantity(68, DegreesFahrenheitTemperatureUnit)},
+		{"absolute - difference", NewQuantity(68, DegreesFahrenheitTemperatureUnit), NewQuantity(10, DegreesCelsiusTemperatureDifferenceUnit), true, NewQuantity(50, DegreesFahrenheitTemperatureUnit)},
+		{"absolute - absolute", NewQuantity(30, DegreesCelsiusTemperatureUnit), NewQuantity(50, DegreesFahrenheitTemperatureUnit), true, NewQuantity(20, DegreesCelsiusTemperatureDifferenceUnit)},
+		{"difference + difference", NewQuantity(10, DegreesCelsiusTemperatureDifferenceUnit), NewQuantity(9, DegreesFahrenheitTemperatureDifferenceUnit), false, NewQuantity(15, DegreesCelsiusTemperatureDifferenceUnit)},
+	}
+	for _, tc := range tests {
+		op := tc.q.Add
+		if tc.sub {
+			op = tc.q.Sub
+		}
+		got, err := op(tc.o)
+		if err != nil {
+			t.Errorf("%s: %v", tc.name, err)
+			continue
+		}
+		if got.Unit != tc.want.Unit || math.Abs(got.Value-tc.want.Value) > 1e-9 {
+			t.Errorf("%s: %v %v = %v, want %v", tc.name, tc.q, tc.o, got, tc.want)
+		}
+	}
+
+	var absErr *AbsoluteValueError
+	if _, err := NewQuantity(20, DegreesCelsiusTemperatureUnit).Add(NewQuantity(10, KelvinsTemperatureUnit)); !errors.As(err, &absErr) {
+		t.Errorf("20 °C + 10 K = %v, want an AbsoluteValueError", err)
+	}
+	var mismatch *TypeMismatchError
+	if _, err := NewQuantity(20, DegreesCelsiusTemperatureUnit).Add(NewQuantity(1, MetersLengthUnit)); !errors.As(err, &mismatch) {
+		t.Errorf("20 °C + 1 m = %v, want a TypeMismatchError", err)
+	}
+}
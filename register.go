@@ -0,0 +1,196 @@
+package units
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/AlakaCore/units/internal/schema"
+)
+
+// DefaultRegistry backs the package level lookups. Units and unit types
+// registered or loaded into it are found by LookupType, LookupUnit,
+// LookupTypeUnit, DecodeUnit and ParseAnyQuantity once the compiled-in ones
+// don't match.
+var DefaultRegistry *Registry
+
+func init() {
+	// The generated unit arrays are only reachable through interface methods,
+	// which don't count towards variable initialization order
+	DefaultRegistry = NewRegistry()
+}
+
+// UnitSpec describes a unit to register. A value x of the unit is
+// x*Factor + Offset of the base unit, so Offset is zero for linear units.
+type UnitSpec struct {
+	Name    string
+	Symbol  string
+	Factor  float64
+	Offset  float64
+	Matches []string
+}
+
+// TypeSpec describes a unit type to register. BaseUnit is the name of one of
+// Units, which must have a Factor of 1 and no Offset.
+type TypeSpec struct {
+	Name      string
+	BaseUnit  string
+	Dimension Dimension
+	Matches   []string
+	Units     []UnitSpec
+}
+
+// TypeUnit is a unit and its unit type, as found by FindUnits
+type TypeUnit struct {
+	Type UnitType
+	Unit Unit
+}
+
+// FindUnits returns every unit of the DefaultRegistry whose matches include
+// input, across all unit types, so the caller can pick the type from context
+// or treat the input as ambiguous. "volts" for example is found in
+// ElectricPotential, ElectricPotentialLoaded and ElectricPotentialUnloaded.
+func FindUnits(input string) []TypeUnit {
+	return DefaultRegistry.FindUnits(input)
+}
+
+// RegisterUnit adds a unit to typeOf in the DefaultRegistry, see
+// Registry.RegisterUnit
+func RegisterUnit(typeOf UnitType, spec UnitSpec) (Unit, error) {
+	return DefaultRegistry.RegisterUnit(typeOf, spec)
+}
+
+// RegisterType adds a unit type to the DefaultRegistry, see
+// Registry.RegisterType
+func RegisterType(spec TypeSpec) (UnitType, error) {
+	return DefaultRegistry.RegisterType(spec)
+}
+
+// RegisteredTypes returns every unit type of the DefaultRegistry, like
+// UnitTypes but including registered ones
+func RegisteredTypes() []UnitType {
+	return DefaultRegistry.UnitTypes()
+}
+
+// RegisteredUnitTypes returns the AlakaTitle of every unit of the
+// DefaultRegistry, like AllUnitTypes but including registered ones
+func RegisteredUnitTypes() []string {
+	return DefaultRegistry.AllUnitTypes()
+}
+
+// RegisterUnit adds a unit to typeOf, which may be compiled-in or registered.
+// It's rejected if its title or any of its match strings are already taken
+// within typeOf.
+func (r *Registry) RegisterUnit(typeOf UnitType, spec UnitSpec) (Unit, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rt, ok := r.byTitle[typeOf.Title()]
+	if !ok {
+		return nil, fmt.Errorf("units: registering %s: %w", spec.Name, &ErrUnknownType{Input: typeOf.Title()})
+	}
+	u, err := newSpecUnit(spec, rt.typeOf, rt.typeOf.Base())
+	if err != nil {
+		return nil, err
+	}
+
+	b := r.newBatch()
+	if err := b.addUnit(rt.typeOf, u); err != nil {
+		return nil, fmt.Errorf("units: registering %s: %w", spec.Name, err)
+	}
+	b.commit()
+	return u, nil
+}
+
+// RegisterType adds a new unit type and its units. It's rejected if its
+// title or a match string is taken by another unit type, if two of its units
+// share a title or match string, or if BaseUnit isn't one of its units.
+func (r *Registry) RegisterType(spec TypeSpec) (UnitType, error) {
+	t := &runtimeType{title: schema.Title(spec.Name), name: spec.Name, dimension: spec.Dimension, matches: sanitizeAll(spec.Matches)}
+	if t.title == "" {
+		return nil, fmt.Errorf("units: registering a unit type without a name")
+	}
+
+	var units []Unit
+	for _, us := range spec.Units {
+		if us.Name != spec.BaseUnit {
+			continue
+		}
+		if us.Factor != 1 || us.Offset != 0 {
+			return nil, fmt.Errorf("units: registering %s: base unit %s must have a factor of 1 and no offset", spec.Name, us.Name)
+		}
+		base, err := newSpecUnit(us, t, nil)
+		if err != nil {
+			return nil, err
+		}
+		t.base = base
+		units = append(units, base)
+	}
+	if t.base == nil {
+		return nil, fmt.Errorf("units: registering %s: base unit %q is not one of its units", spec.Name, spec.BaseUnit)
+	}
+	for _, us := range spec.Units {
+		if us.Name == spec.BaseUnit {
+			continue
+		}
+		u, err := newSpecUnit(us, t, t.base)
+		if err != nil {
+			return nil, err
+		}
+		units = append(units, u)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b := r.newBatch()
+	if err := b.addType(t); err != nil {
+		return nil, fmt.Errorf("units: registering %s: %w", spec.Name, err)
+	}
+	for _, u := range units {
+		if err := b.addUnit(t, u); err != nil {
+			return nil, fmt.Errorf("units: registering %s: %w", spec.Name, err)
+		}
+	}
+	b.commit()
+	return t, nil
+}
+
+// newSpecUnit returns the unit of typeOf described by spec. A nil base makes
+// it its own base.
+func newSpecUnit(spec UnitSpec, typeOf UnitType, base Unit) (*runtimeUnit, error) {
+	if schema.Title(spec.Name) == "" {
+		return nil, fmt.Errorf("units: registering a %s unit without a name", typeOf.Title())
+	}
+	if spec.Factor == 0 || math.IsNaN(spec.Factor) || math.IsInf(spec.Factor, 0) || math.IsNaN(spec.Offset) || math.IsInf(spec.Offset, 0) {
+		return nil, fmt.Errorf("units: registering %s: factor must be finite and non-zero, and offset finite", spec.Name)
+	}
+
+	factor, offset := spec.Factor, spec.Offset
+	u := &runtimeUnit{
+		title:   schema.Title(spec.Name),
+		name:    spec.Name,
+		symbol:  spec.Symbol,
+		matches: sanitizeAll(spec.Matches),
+		typeOf:  typeOf,
+		base:    base,
+		from:    func(x float64) float64 { return (x - offset) / factor },
+		to:      func(x float64) float64 { return x*factor + offset },
+	}
+	if offset == 0 {
+		u.factor = new(big.Rat).SetFloat64(factor)
+	}
+	return u, nil
+}
+
+// decodeUnitType finds a registered unit type by its exact Title, backing
+// DecodeUnitType
+func (r *Registry) decodeUnitType(text []byte) (UnitType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if rt, ok := r.byTitle[string(text)]; ok {
+		return rt.typeOf, nil
+	}
+	return nil, &ErrUnknownType{Input: string(text)}
+}
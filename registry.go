@@ -0,0 +1,624 @@
+package units
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/AlakaCore/units/internal/schema"
+	"gopkg.in/yaml.v2"
+)
+
+// Registry is a set of unit types and units which can be extended at runtime
+// from the same yaml schema as units.yaml, without regenerating the package.
+// It starts out with every compiled-in UnitType and is safe for concurrent
+// use. Its lookups mirror the package level ones of the same name.
+type Registry struct {
+	mu          sync.RWMutex
+	types       []*registryType
+	byTitle     map[string]*registryType
+	typeMatches map[string]UnitType
+}
+
+// registryType is a UnitType and the units the registry knows for it, which
+// for a compiled-in type may be more than its Units()
+type registryType struct {
+	typeOf  UnitType
+	units   []Unit
+	byTitle map[string]Unit
+	matches map[string]Unit
+	exact   map[string]Unit
+}
+
+// NewRegistry returns a Registry holding the compiled-in UnitTypes
+func NewRegistry() *Registry {
+	r := &Registry{byTitle: map[string]*registryType{}, typeMatches: map[string]UnitType{}}
+	for _, ut := range UnitTypes {
+		r.addType(ut)
+		for _, u := range ut.Units() {
+			r.addUnit(ut, u)
+		}
+	}
+	return r
+}
+
+// addType adds ut without checking for conflicts
+func (r *Registry) addType(ut UnitType) *registryType {
+	rt := &registryType{typeOf: ut, byTitle: map[string]Unit{}, matches: map[string]Unit{}, exact: map[string]Unit{}}
+	r.types = append(r.types, rt)
+	r.byTitle[ut.Title()] = rt
+	for _, m := range ut.MatchList() {
+		check := SanitizeString(m)
+		if _, ok := r.typeMatches[check]; !ok {
+			r.typeMatches[check] = ut
+		}
+	}
+	return rt
+}
+
+// addUnit adds u to ut without checking for conflicts. As with the generated
+// lookups, the first unit to declare a match keeps it.
+func (r *Registry) addUnit(ut UnitType, u Unit) {
+	rt := r.byTitle[ut.Title()]
+	rt.units = append(rt.units, u)
+	rt.byTitle[u.Title()] = u
+	for _, m := range u.MatchList() {
+		check := SanitizeString(m)
+		if _, ok := rt.matches[check]; !ok {
+			rt.matches[check] = u
+		}
+	}
+	for _, m := range ExactMatchList(u) {
+		if _, ok := rt.exact[m]; !ok {
+			rt.exact[m] = u
+		}
+	}
+}
+
+// LookupType returns the unit type which matches input or an *ErrUnknownType
+func (r *Registry) LookupType(input string) (UnitType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	check := SanitizeString(input)
+	if ut, ok := r.typeMatches[check]; ok {
+		return ut, nil
+	}
+	return nil, &ErrUnknownType{Input: check}
+}
+
+// LookupUnit returns the unit of typeOf which matches input or an
+// *ErrUnknownUnit, which suggests the closest unit of typeOf
+func (r *Registry) LookupUnit(input string, typeOf UnitType) (Unit, error) {
+	if u, ok := r.lookupUnit(input, typeOf); ok {
+		return u, nil
+	}
+	return nil, r.unknownUnit(input, typeOf)
+}
+
+// unknownUnit returns the *ErrUnknownUnit for input not matching a unit of
+// typeOf. The registry mustn't be locked, see suggest.
+func (r *Registry) unknownUnit(input string, typeOf UnitType) error {
+	return &ErrUnknownUnit{Input: SanitizeString(input), Type: typeOf, Suggestion: r.closestUnit(input, typeOf)}
+}
+
+func (r *Registry) lookupUnit(input string, typeOf UnitType) (Unit, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if rt, ok := r.byTitle[typeOf.Title()]; ok {
+		return rt.find(input, SanitizeString(input))
+	}
+	return nil, false
+}
+
+// LookupTypeUnit returns the unit type and unit which matches input or an
+// *ErrUnknownUnit. Opposite of AlakaTitle
+func (r *Registry) LookupTypeUnit(input string) (UnitType, Unit, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if ut, u, ok := r.typeUnit(input); ok {
+		return ut, u, nil
+	}
+	return nil, nil, &ErrUnknownUnit{Input: input}
+}
+
+// FindUnits returns every unit, of any unit type, whose matches include
+// input, in registry order
+func (r *Registry) FindUnits(input string) []TypeUnit {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	check := SanitizeString(input)
+	var out []TypeUnit
+	for _, rt := range r.types {
+		if u, ok := rt.find(input, check); ok {
+			out = append(out, TypeUnit{Type: rt.typeOf, Unit: u})
+		}
+	}
+	return out
+}
+
+// find returns the unit whose exact matches include input, or otherwise
+// whose matches include check, the sanitized input
+func (rt *registryType) find(input, check string) (Unit, bool) {
+	if u, ok := rt.exact[WhitespaceRegex.ReplaceAllString(input, "")]; ok {
+		return u, true
+	}
+	u, ok := rt.matches[check]
+	return u, ok
+}
+
+// typeUnit finds the unit with the AlakaTitle input. The caller must hold
+// the lock.
+func (r *Registry) typeUnit(input string) (UnitType, Unit, bool) {
+	idx := strings.Index(input, "_")
+	if idx < 0 {
+		return nil, nil, false
+	}
+	rt, ok := r.byTitle[input[:idx]]
+	if !ok {
+		return nil, nil, false
+	}
+	u, ok := rt.byTitle[input[idx+1:]]
+	return rt.typeOf, u, ok
+}
+
+// GetType returns the unit type which matches input or NumberUnitType
+func (r *Registry) GetType(input string) UnitType {
+	ut, err := r.LookupType(input)
+	if err != nil {
+		return NumberUnitType
+	}
+	return ut
+}
+
+// GetUnit returns the unit which matches input or NumberNumberUnit
+func (r *Registry) GetUnit(input string, typeOf UnitType) Unit {
+	if u, ok := r.lookupUnit(input, typeOf); ok {
+		return u
+	}
+	return NumberNumberUnit
+}
+
+// GetTypeUnit returns the unit type and unit which matches input or
+// (NumberUnitType, NumberNumberUnit). Opposite of AlakaTitle
+func (r *Registry) GetTypeUnit(input string) (UnitType, Unit) {
+	ut, u, err := r.LookupTypeUnit(input)
+	if err != nil {
+		return NumberUnitType, NumberNumberUnit
+	}
+	return ut, u
+}
+
+// ConvertAlaka is ConvertAlaka for the units of the registry
+func (r *Registry) ConvertAlaka(value float64, fromTitle, toTitle string) (float64, error) {
+	_, from, err := r.LookupTypeUnit(fromTitle)
+	if err != nil {
+		return 0, err
+	}
+	_, to, err := r.LookupTypeUnit(toTitle)
+	if err != nil {
+		return 0, err
+	}
+	return Convert(value, from, to)
+}
+
+// UnitTypes returns every unit type in the registry, compiled-in ones first
+func (r *Registry) UnitTypes() []UnitType {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := make([]UnitType, len(r.types))
+	for idx, rt := range r.types {
+		out[idx] = rt.typeOf
+	}
+	return out
+}
+
+// Units returns every unit the registry knows of typeOf
+func (r *Registry) Units(typeOf UnitType) []Unit {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if rt, ok := r.byTitle[typeOf.Title()]; ok {
+		return append([]Unit(nil), rt.units...)
+	}
+	return nil
+}
+
+// AllUnitTypes is AllUnitTypes for the registry, the AlakaTitle of every unit
+func (r *Registry) AllUnitTypes() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var out []string
+	for _, rt := range r.types {
+		for _, u := range rt.units {
+			out = append(out, AlakaTitle(rt.typeOf, u))
+		}
+	}
+	return out
+}
+
+// LoadFile is Load for the yaml file at path
+func (r *Registry) LoadFile(path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := r.load(data); err != nil {
+		return fmt.Errorf("units: loading %s: %w", path, err)
+	}
+	return nil
+}
+
+// Load reads definitions in the units.yaml schema and adds them to the
+// registry. A definition whose type is already registered adds its units to
+// that type, and can leave out baseUnit and dimension. A definedAs can refer
+// to any unit already in the registry by its AlakaTitle. Nothing is added if
+// any definition is invalid or a unit title is already taken.
+func (r *Registry) Load(reader io.Reader) error {
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	if err := r.load(data); err != nil {
+		return fmt.Errorf("units: loading: %w", err)
+	}
+	return nil
+}
+
+func (r *Registry) load(data []byte) error {
+	var uy schema.UnitsYaml
+	if err := yaml.Unmarshal(data, &uy); err != nil {
+		return err
+	}
+	if err := uy.ExpandPrefixes(); err != nil {
+		return err
+	}
+	if err := uy.ResolveUnitTypeCopies(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for idx := range uy.Definitions {
+		d := &uy.Definitions[idx]
+		rt, ok := r.byTitle[d.StructName()]
+		if !ok {
+			continue
+		}
+		dim := schema.Dimension(rt.typeOf.Dimension())
+		if d.Dimension != (schema.Dimension{}) && d.Dimension != dim {
+			return fmt.Errorf("%s: dimension %s doesn't match the registered %s", d.Type, d.Dimension.GoLiteral(), dim.GoLiteral())
+		}
+		d.Dimension = dim
+	}
+
+	known := func(typeName string) bool {
+		_, ok := r.byTitle[schema.Title(typeName)]
+		return ok
+	}
+	if err := uy.CheckDifferenceTypes(known); err != nil {
+		return err
+	}
+	if err := uy.ParseConversions(); err != nil {
+		return err
+	}
+	if err := uy.ResolveFactors(r.resolveFactor); err != nil {
+		return err
+	}
+
+	b := r.newBatch()
+	for idx := range uy.Definitions {
+		d := &uy.Definitions[idx]
+		var ut UnitType
+		if rt, ok := r.byTitle[d.StructName()]; ok {
+			ut = rt.typeOf
+		} else {
+			t := &runtimeType{title: d.StructName(), name: d.Type, dimension: Dimension(d.Dimension), matches: sanitizeAll(d.Matches)}
+			for ui := range d.Units {
+				if d.Units[ui].Name == d.BaseUnit {
+					t.base = newRuntimeUnit(&d.Units[ui], t, nil)
+				}
+			}
+			if t.base == nil {
+				return fmt.Errorf("%s: baseUnit %q is not one of its units", d.Type, d.BaseUnit)
+			}
+			if err := b.addType(t); err != nil {
+				return err
+			}
+			ut = t
+		}
+
+		for ui := range d.Units {
+			spec := &d.Units[ui]
+			var u Unit
+			if t, ok := ut.(*runtimeType); ok && spec.Name == d.BaseUnit {
+				u = t.base
+			} else {
+				u = newRuntimeUnit(spec, ut, ut.Base())
+			}
+			if err := b.addUnit(ut, u); err != nil {
+				return err
+			}
+		}
+	}
+
+	b.commit()
+	return nil
+}
+
+// batch stages new types and units so they're checked for conflicts with
+// the registry and each other, then added all at once. The registry's lock
+// must be held throughout.
+type batch struct {
+	r           *Registry
+	types       []UnitType
+	units       []stagedUnit
+	typeTitles  map[string]bool
+	typeMatches map[string]string
+	unitTitles  map[string]bool
+	unitMatches map[string]string
+	unitExact   map[string]string
+}
+
+// stagedUnit is a unit waiting in a batch
+type stagedUnit struct {
+	typeOf UnitType
+	unit   Unit
+}
+
+func (r *Registry) newBatch() *batch {
+	return &batch{
+		r:           r,
+		typeTitles:  map[string]bool{},
+		typeMatches: map[string]string{},
+		unitTitles:  map[string]bool{},
+		unitMatches: map[string]string{},
+		unitExact:   map[string]string{},
+	}
+}
+
+// addType stages ut, rejecting a title or match string which is taken
+func (b *batch) addType(ut UnitType) error {
+	if _, ok := b.r.byTitle[ut.Title()]; ok || b.typeTitles[ut.Title()] {
+		return fmt.Errorf("unit type %s is already registered", ut.Title())
+	}
+	for _, m := range ut.MatchList() {
+		check := SanitizeString(m)
+		owner, ok := b.typeMatches[check]
+		if taken, found := b.r.typeMatches[check]; found {
+			owner, ok = taken.Title(), true
+		}
+		if ok {
+			return fmt.Errorf("unit type %s: match %q is already claimed by %s", ut.Title(), check, owner)
+		}
+		b.typeMatches[check] = ut.Title()
+	}
+
+	b.typeTitles[ut.Title()] = true
+	b.types = append(b.types, ut)
+	return nil
+}
+
+// addUnit stages u in ut, rejecting a title or match string which is
+// already taken within ut
+func (b *batch) addUnit(ut UnitType, u Unit) error {
+	title := AlakaTitle(ut, u)
+	if _, _, ok := b.r.typeUnit(title); ok || b.unitTitles[title] {
+		return fmt.Errorf("unit %s is already registered", title)
+	}
+	for _, m := range u.MatchList() {
+		check := SanitizeString(m)
+		owner, ok := b.unitMatches[ut.Title()+"->"+check]
+		if rt, found := b.r.byTitle[ut.Title()]; found {
+			if taken, found := rt.matches[check]; found {
+				owner, ok = AlakaTitle(ut, taken), true
+			}
+		}
+		if ok {
+			return fmt.Errorf("unit %s: match %q is already claimed by %s", title, check, owner)
+		}
+		b.unitMatches[ut.Title()+"->"+check] = title
+	}
+	for _, m := range ExactMatchList(u) {
+		owner, ok := b.unitExact[ut.Title()+"->"+m]
+		if rt, found := b.r.byTitle[ut.Title()]; found {
+			if taken, found := rt.exact[m]; found {
+				owner, ok = AlakaTitle(ut, taken), true
+			}
+		}
+		if ok {
+			return fmt.Errorf("unit %s: exact match %q is already claimed by %s", title, m, owner)
+		}
+		b.unitExact[ut.Title()+"->"+m] = title
+	}
+
+	b.unitTitles[title] = true
+	b.units = append(b.units, stagedUnit{typeOf: ut, unit: u})
+	return nil
+}
+
+// commit adds everything staged to the registry
+func (b *batch) commit() {
+	for _, ut := range b.types {
+		b.r.addType(ut)
+	}
+	for _, p := range b.units {
+		b.r.addUnit(p.typeOf, p.unit)
+		if t, ok := p.typeOf.(*runtimeType); ok {
+			t.mu.Lock()
+			t.units = append(t.units, p.unit)
+			t.mu.Unlock()
+		}
+	}
+}
+
+// resolveFactor resolves a definedAs reference to a registered unit, either
+// by AlakaTitle or by the title of a unit of the same type. The caller must
+// hold the lock.
+func (r *Registry) resolveFactor(d *schema.Definition, ref string) (schema.Factor, error) {
+	ut, u, ok := r.typeUnit(ref)
+	if !ok {
+		if rt, found := r.byTitle[d.StructName()]; found {
+			ut, u, ok = rt.typeOf, rt.byTitle[ref], rt.byTitle[ref] != nil
+		}
+	}
+	if !ok {
+		return schema.Factor{}, fmt.Errorf("unknown unit %q", ref)
+	}
+
+	factor, exact := ExactFactor(u)
+	if !exact {
+		return schema.Factor{}, fmt.Errorf("%s is not linear so it can't be referenced", AlakaTitle(ut, u))
+	}
+	return schema.Factor{Value: factor, Dimension: schema.Dimension(ut.Dimension())}, nil
+}
+
+// runtimeType is a UnitType loaded into a Registry. Later loads can add to
+// its units, so they're guarded by mu.
+type runtimeType struct {
+	title     string
+	name      string
+	base      Unit
+	dimension Dimension
+	matches   []string
+
+	mu    sync.RWMutex
+	units []Unit
+}
+
+func (t *runtimeType) Title() string        { return t.title }
+func (t *runtimeType) Name() string         { return t.name }
+func (t *runtimeType) Base() Unit           { return t.base }
+func (t *runtimeType) Dimension() Dimension { return t.dimension }
+func (t *runtimeType) MatchList() []string  { return t.matches }
+
+func (t *runtimeType) Units() []Unit {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return append([]Unit(nil), t.units...)
+}
+
+func (t *runtimeType) UnitList() []string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	var out []string
+	for _, u := range t.units {
+		out = append(out, u.Name())
+	}
+	return out
+}
+
+func (t *runtimeType) Matches(check string) bool {
+	return matchesAny(t.matches, check)
+}
+
+// runtimeUnit is a Unit loaded into a Registry
+type runtimeUnit struct {
+	title   string
+	name    string
+	symbol  string
+	matches []string
+	exact   []string
+	typeOf  UnitType
+	base    Unit
+	from    func(float64) float64
+	to      func(float64) float64
+	factor  *big.Rat
+}
+
+// newRuntimeUnit returns the unit of typeOf described by spec. A nil base
+// makes it its own base.
+func newRuntimeUnit(spec *schema.Unit, typeOf UnitType, base Unit) *runtimeUnit {
+	return &runtimeUnit{
+		title:   spec.Title(),
+		name:    spec.Name,
+		symbol:  spec.Symbol,
+		matches: sanitizeAll(spec.Matches),
+		exact:   spec.ExactMatches,
+		typeOf:  typeOf,
+		base:    base,
+		from:    spec.From.Body.Eval,
+		to:      spec.To.Body.Eval,
+		factor:  spec.Factor,
+	}
+}
+
+func (u *runtimeUnit) Title() string              { return u.title }
+func (u *runtimeUnit) Name() string               { return u.name }
+func (u *runtimeUnit) Symbol() string             { return u.symbol }
+func (u *runtimeUnit) FromBase(x float64) float64 { return u.from(x) }
+func (u *runtimeUnit) ToBase(x float64) float64   { return u.to(x) }
+func (u *runtimeUnit) MatchList() []string        { return u.matches }
+func (u *runtimeUnit) ExactMatchList() []string   { return u.exact }
+func (u *runtimeUnit) TypeOf() UnitType           { return u.typeOf }
+
+func (u *runtimeUnit) Matches(check string) bool {
+	exact := WhitespaceRegex.ReplaceAllString(check, "")
+	for _, m := range u.exact {
+		if m == exact {
+			return true
+		}
+	}
+	return matchesAny(u.matches, check)
+}
+
+func (u *runtimeUnit) Base() Unit {
+	if u.base == nil {
+		return u
+	}
+	return u.base
+}
+
+func (u *runtimeUnit) ExactFactor() (*big.Rat, bool) {
+	if u.factor == nil {
+		return nil, false
+	}
+	return new(big.Rat).Set(u.factor), true
+}
+
+// matchesAny is the generated Matches for a runtime match list
+func matchesAny(matches []string, check string) bool {
+	check = SanitizeString(check)
+	for _, m := range matches {
+		if m == check || m == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// ExactMatcher is implemented by units with matches which are compared case
+// sensitively, as prefixed units are
+type ExactMatcher interface {
+	// ExactMatchList is a list of matching strings which are compared case
+	// sensitively once whitespace is removed, such as prefixed symbols where
+	// "mPa" isn't "MPa"
+	ExactMatchList() []string
+}
+
+// ExactMatchList returns the exact matches of u, or nil when it doesn't
+// implement ExactMatcher
+func ExactMatchList(u Unit) []string {
+	if m, ok := u.(ExactMatcher); ok {
+		return m.ExactMatchList()
+	}
+	return nil
+}
+
+// sanitizeAll returns every match run through SanitizeString
+func sanitizeAll(matches []string) []string {
+	out := make([]string, len(matches))
+	for idx, m := range matches {
+		out[idx] = SanitizeString(m)
+	}
+	return out
+}
@@ -0,0 +1,371 @@
+package units
+
+import (
+	"math"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const siteUnits = `
+definitions:
+  - type: Volume
+    units:
+      - name: Texas Barrels
+        symbol: txbbl
+        definedAs: 43 Volume_GallonUSFluid
+        matches:
+          - txbbl
+  - type: Flow Index
+    baseUnit: Index Points
+    dimension:
+      length: 3
+      time: -1
+    matches:
+      - flowindex
+    units:
+      - name: Index Points
+        symbol: ip
+        definedAs: 2 Flow_CubicMetersPerSecond
+        matches:
+          - ip
+      - name: Offset Points
+        symbol: op
+        fromBase: v => (v * 10) + 5
+        matches:
+          - op
+`
+
+func TestRegistryLoad(t *testing.T) {
+	r := NewRegistry()
+	if err := r.Load(strings.NewReader(siteUnits)); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := r.ConvertAlaka(1, "Volume_TexasBarrels", "Volume_BarrelsOfOil")
+	if err != nil || math.Abs(got-43.0/42) > 1e-12 {
+		t.Errorf("1 txbbl = %v bbl, %v", got, err)
+	}
+	if u := r.GetUnit("TXBBL", VolumeUnitType); u.Symbol() != "txbbl" {
+		t.Errorf("GetUnit(TXBBL) = %s", u.Symbol())
+	}
+	if _, err := LookupUnit("txbbl", VolumeUnitType); err == nil {
+		t.Error("loading a registry changed the package level lookups")
+	}
+
+	ut := r.GetType("flowindex")
+	if ut.Title() != "FlowIndex" || ut.Base().Symbol() != "ip" || len(ut.Units()) != 2 {
+		t.Fatalf("GetType(flowindex) = %s with %d units", ut.Title(), len(ut.Units()))
+	}
+	ut, op := r.GetTypeUnit("FlowIndex_OffsetPoints")
+	if op.TypeOf() != ut || op.Base() != ut.Base() {
+		t.Errorf("OffsetPoints isn't part of %s", ut.Title())
+	}
+	if v := op.ToBase(op.FromBase(3)); math.Abs(v-3) > 1e-12 {
+		t.Errorf("OffsetPoints round trip = %v", v)
+	}
+	if _, exact := ExactFactor(op); exact {
+		t.Error("OffsetPoints is affine but has an exact factor")
+	}
+
+	q, err := NewQuantity(1, ut.Base()).Divide(NewQuantity(2, CubicMetersPerSecondFlowUnit))
+	if err != nil || q.Value != 1 || q.TypeOf() != NumberUnitType {
+		t.Errorf("1 ip / 2 m³/s = %v, %v", q, err)
+	}
+}
+
+func TestRegistryLoadMixedCase(t *testing.T) {
+	r := NewRegistry()
+	err := r.Load(strings.NewReader(`
+definitions:
+  - type: Gauge Pressure
+    baseUnit: Kilopascals Gauge
+    dimension:
+      mass: 1
+      length: -1
+      time: -2
+    matches:
+      - GaugePressure
+    units:
+      - name: Kilopascals Gauge
+        symbol: kPag
+        definedAs: 1000 Pressure_Pascals
+        matches:
+          - kPag
+          - Kilopascals Gauge
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ut, err := r.LookupType("gauge pressure")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, input := range []string{"kPag", "KPAG", "kilopascals gauge"} {
+		if u, err := r.LookupUnit(input, ut); err != nil || u.Symbol() != "kPag" {
+			t.Errorf("LookupUnit(%q) = %v, %v", input, u, err)
+		}
+	}
+}
+
+func TestRegistryLoadErrors(t *testing.T) {
+	tests := map[string]string{
+		"duplicate title": `
+definitions:
+  - type: Volume
+    units:
+      - name: Barrels of Oil
+        symbol: bbl2
+        definedAs: 42 Volume_GallonUSFluid
+`,
+		"missing base": `
+definitions:
+  - type: Widgets
+    baseUnit: Widgets
+    units:
+      - name: Gadgets
+        fromBase: v => v
+`,
+		"wrong dimension": `
+definitions:
+  - type: Volume
+    units:
+      - name: Long Feet
+        definedAs: 2 Length_Feet
+`,
+		"unknown reference": `
+definitions:
+  - type: Volume
+    units:
+      - name: Mystery
+        definedAs: 2 Volume_Nope
+`,
+		"unknown differenceType": `
+definitions:
+  - type: Site Temperature
+    baseUnit: Site Degrees
+    differenceType: Site Temperature Difference
+    units:
+      - name: Site Degrees
+        fromBase: v => v
+`,
+	}
+	for name, yaml := range tests {
+		r := NewRegistry()
+		before := len(r.AllUnitTypes())
+		if err := r.Load(strings.NewReader(yaml)); err == nil {
+			t.Errorf("%s: Load succeeded", name)
+		}
+		if after := len(r.AllUnitTypes()); after != before {
+			t.Errorf("%s: failed Load added %d units", name, after-before)
+		}
+	}
+}
+
+func TestRegistryConcurrent(t *testing.T) {
+	r := NewRegistry()
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				r.GetTypeUnit("Pressure_Pascals")
+				r.UnitTypes()
+			}
+		}()
+	}
+	if err := r.Load(strings.NewReader(siteUnits)); err != nil {
+		t.Error(err)
+	}
+	wg.Wait()
+}
+
+func TestRegisterConflicts(t *testing.T) {
+	r := NewRegistry()
+	tests := map[string]func() error{
+		"claimed match": func() error {
+			_, err := r.RegisterUnit(VolumeUnitType, UnitSpec{Name: "Big Barrels", Factor: 1, Matches: []string{"BBL"}})
+			return err
+		},
+		"duplicate title": func() error {
+			_, err := r.RegisterUnit(VolumeUnitType, UnitSpec{Name: "Barrels of Oil", Factor: 1})
+			return err
+		},
+		"zero factor": func() error {
+			_, err := r.RegisterUnit(VolumeUnitType, UnitSpec{Name: "Nothing"})
+			return err
+		},
+		"missing base": func() error {
+			_, err := r.RegisterType(TypeSpec{Name: "Widgets", BaseUnit: "Widgets", Units: []UnitSpec{{Name: "Gadgets", Factor: 1}}})
+			return err
+		},
+		"duplicate type": func() error {
+			_, err := r.RegisterType(TypeSpec{Name: "Pressure", BaseUnit: "P", Units: []UnitSpec{{Name: "P", Factor: 1}}})
+			return err
+		},
+		"matches within a type": func() error {
+			_, err := r.RegisterType(TypeSpec{Name: "Widgets", BaseUnit: "Widgets", Units: []UnitSpec{
+				{Name: "Widgets", Factor: 1, Matches: []string{"w"}},
+				{Name: "Dozen Widgets", Factor: 12, Matches: []string{"W"}},
+			}})
+			return err
+		},
+	}
+	before := len(r.AllUnitTypes())
+	for name, register := range tests {
+		if register() == nil {
+			t.Errorf("%s: registered", name)
+		}
+	}
+	if after := len(r.AllUnitTypes()); after != before {
+		t.Errorf("rejected registrations added %d units", after-before)
+	}
+}
+
+func TestRegisterDefault(t *testing.T) {
+	defer func(old *Registry) { DefaultRegistry = old }(DefaultRegistry)
+	DefaultRegistry = NewRegistry()
+
+	ut, err := RegisterType(TypeSpec{
+		Name:     "Test Proprietary Index",
+		BaseUnit: "Test Index Points",
+		Matches:  []string{"testindex"},
+		Units: []UnitSpec{
+			{Name: "Test Index Points", Symbol: "tip", Factor: 1, Matches: []string{"tip"}},
+			{Name: "Test Shifted Points", Symbol: "tsp", Factor: 2, Offset: 10, Matches: []string{"tsp"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	bbl, err := RegisterUnit(VolumeUnitType, UnitSpec{Name: "Test Regional Barrels", Symbol: "trb", Factor: 0.2, Matches: []string{"trb"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, err := LookupType("TestIndex"); err != nil || got != ut {
+		t.Errorf("LookupType(TestIndex) = %v, %v", got, err)
+	}
+	if got, err := LookupUnit("TRB", VolumeUnitType); err != nil || got != bbl {
+		t.Errorf("LookupUnit(TRB) = %v, %v", got, err)
+	}
+	if _, got, err := LookupTypeUnit("TestProprietaryIndex_TestShiftedPoints"); err != nil || got.Symbol() != "tsp" {
+		t.Errorf("LookupTypeUnit(TestProprietaryIndex_TestShiftedPoints) = %v, %v", got, err)
+	}
+	if got, err := DecodeUnitType([]byte("TestProprietaryIndex")); err != nil || got != ut {
+		t.Errorf("DecodeUnitType(TestProprietaryIndex) = %v, %v", got, err)
+	}
+	if v, err := ConvertAlaka(20, "TestProprietaryIndex_TestIndexPoints", "TestProprietaryIndex_TestShiftedPoints"); err != nil || v != 5 {
+		t.Errorf("20 tip = %v tsp, %v", v, err)
+	}
+
+	q, err := ParseAnyQuantity("5 trb")
+	if err != nil || q.Unit != bbl {
+		t.Errorf("ParseAnyQuantity(5 trb) = %v, %v", q, err)
+	}
+	found := false
+	for _, title := range RegisteredUnitTypes() {
+		found = found || title == "Volume_TestRegionalBarrels"
+	}
+	if !found {
+		t.Error("RegisteredUnitTypes is missing Volume_TestRegionalBarrels")
+	}
+}
+
+func TestFindUnits(t *testing.T) {
+	var got []string
+	for _, tu := range FindUnits(" Volts ") {
+		got = append(got, AlakaTitle(tu.Type, tu.Unit))
+	}
+	want := "ElectricPotential_Volts ElectricPotentialLoaded_Volts ElectricPotentialUnloaded_Volts"
+	if strings.Join(got, " ") != want {
+		t.Errorf("FindUnits(Volts) = %v", got)
+	}
+	if got := FindUnits("percent"); len(got) < 3 {
+		t.Errorf("FindUnits(percent) found %d units", len(got))
+	}
+	if got := FindUnits("psi"); len(got) != 1 || got[0].Unit != PoundsPerSquareInchPressureUnit {
+		t.Errorf("FindUnits(psi) = %v", got)
+	}
+	if got := FindUnits("nope"); got != nil {
+		t.Errorf("FindUnits(nope) = %v", got)
+	}
+}
+
+func TestPrefixes(t *testing.T) {
+	tests := map[string]Unit{
+		"kPa":         KilopascalsPressureUnit,
+		"k Pa":        KilopascalsPressureUnit,
+		"KPA":         KilopascalsPressureUnit,
+		"MPa":         MegapascalsPressureUnit,
+		"megapascals": MegapascalsPressureUnit,
+		"mV":          MillivoltsElectricPotentialUnit,
+		"kN":          KilonewtonsForceUnit,
+		"MJ":          MegajoulesWorkUnit,
+		"mm":          MillimetersLengthUnit,
+		"km":          KilometersLengthUnit,
+	}
+	for input, want := range tests {
+		if got, err := LookupUnit(input, want.TypeOf()); err != nil || got != want {
+			t.Errorf("LookupUnit(%q) = %v, %v", input, got, err)
+		}
+	}
+	unknown := map[string]UnitType{
+		"mPa": PressureUnitType,
+		"mpa": PressureUnitType,
+		"MPA": PressureUnitType,
+		"mJ":  WorkUnitType,
+		"MV":  ElectricPotentialUnitType,
+		"MM":  LengthUnitType,
+		"Mm":  LengthUnitType,
+	}
+	for input, ut := range unknown {
+		if u, err := LookupUnit(input, ut); err == nil {
+			t.Errorf("LookupUnit(%q) = %s", input, u.Name())
+		}
+	}
+	if v, err := Convert(2.5, KilometersLengthUnit, MillimetersLengthUnit); err != nil || math.Abs(v-2.5e6) > 1e-6 {
+		t.Errorf("2.5 km = %v mm, %v", v, err)
+	}
+
+	r := NewRegistry()
+	err := r.Load(strings.NewReader(`
+definitions:
+  - type: Pressure
+    units:
+      - name: Bars
+        symbol: bar
+        definedAs: 100000 Pascals
+        prefixes: [m]
+        matches:
+          - bar
+          - bars
+  - type: Force
+    units:
+      - name: Dynes
+        symbol: dyn
+        definedAs: Newtons / 100000
+        prefixes: [m, M]
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u := r.GetUnit("millibars", PressureUnitType); u.Symbol() != "mbar" {
+		t.Errorf("GetUnit(millibars) = %s", u.Symbol())
+	}
+	for _, input := range []string{"MDYN", "mDYN"} {
+		if u, err := r.LookupUnit(input, ForceUnitType); err == nil {
+			t.Errorf("LookupUnit(%q) = %s, want no match as Dynes has both m and M", input, u.Name())
+		}
+	}
+	mdyn, err1 := r.LookupUnit("mdyn", ForceUnitType)
+	Mdyn, err2 := r.LookupUnit("Mdyn", ForceUnitType)
+	if err1 != nil || err2 != nil || mdyn.Name() != "Millidynes" || Mdyn.Name() != "Megadynes" || !Mdyn.Matches("M dyn") || Mdyn.Matches("mdyn") {
+		t.Errorf("mdyn = %v, %v and Mdyn = %v, %v", mdyn, err1, Mdyn, err2)
+	}
+	if f, _ := ExactFactor(Mdyn); f.RatString() != "10" {
+		t.Errorf("Mdyn factor = %s", f.RatString())
+	}
+}
@@ -0,0 +1,152 @@
+package units
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// UnitColumn stores a Unit in a text column as its AlakaTitle, eg.
+// "Pressure_Pascals". A nil Unit is NULL. It implements sql.Scanner and
+// driver.Valuer.
+type UnitColumn struct {
+	Unit Unit
+}
+
+// Scan implements sql.Scanner. Unknown titles are an error rather than
+// falling back to Number.
+func (c *UnitColumn) Scan(src interface{}) error {
+	if src == nil {
+		c.Unit = nil
+		return nil
+	}
+	s, err := scanText(src, "unit")
+	if err != nil {
+		return err
+	}
+	_, u, err := LookupTypeUnit(s)
+	if err != nil {
+		return err
+	}
+	c.Unit = u
+	return nil
+}
+
+// Value implements driver.Valuer
+func (c UnitColumn) Value() (driver.Value, error) {
+	if c.Unit == nil {
+		return nil, nil
+	}
+	return AlakaTitle(c.Unit.TypeOf(), c.Unit), nil
+}
+
+// QuantityColumn stores a Quantity as the text composite "(value,AlakaTitle)",
+// eg. "(101325,Pressure_Pascals)". It implements sql.Scanner and
+// driver.Valuer.
+//
+// Set DefaultUnit to declare the unit of a plain numeric column: bare numbers
+// are then read in that unit, and composites must use a unit of the same
+// UnitType. Scanning leaves DefaultUnit alone, so a QuantityColumn can be
+// reused for every row. Valid is false when the column is NULL.
+type QuantityColumn struct {
+	Quantity    Quantity
+	Valid       bool
+	DefaultUnit Unit
+}
+
+// NewQuantityColumn returns a valid QuantityColumn holding q
+func NewQuantityColumn(q Quantity) QuantityColumn {
+	return QuantityColumn{Quantity: q, Valid: true}
+}
+
+// Scan implements sql.Scanner
+func (c *QuantityColumn) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		c.Quantity, c.Valid = Quantity{}, false
+		return nil
+	case float64:
+		return c.scanNumber(v)
+	case int64:
+		return c.scanNumber(float64(v))
+	}
+
+	s, err := scanText(src, "quantity")
+	if err != nil {
+		return err
+	}
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "(") {
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return fmt.Errorf("units: cannot scan %q into a quantity", s)
+		}
+		return c.scanNumber(v)
+	}
+
+	value, unit, ok := splitComposite(s)
+	if !ok {
+		return fmt.Errorf("units: cannot scan %q into a quantity, expected (value,unit)", s)
+	}
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return fmt.Errorf("units: scanning %q: %w", s, err)
+	}
+	_, u, err := LookupTypeUnit(unit)
+	if err != nil {
+		return fmt.Errorf("units: scanning %q: %w", s, err)
+	}
+	if c.DefaultUnit != nil && !SameType(c.DefaultUnit, u) {
+		return &TypeMismatchError{From: u.TypeOf(), To: c.DefaultUnit.TypeOf()}
+	}
+
+	c.Quantity, c.Valid = Quantity{Value: v, Unit: u}, true
+	return nil
+}
+
+// scanNumber reads v in the default unit
+func (c *QuantityColumn) scanNumber(v float64) error {
+	if c.DefaultUnit == nil {
+		return fmt.Errorf("units: cannot scan bare number %v without a default unit", v)
+	}
+	c.Quantity, c.Valid = Quantity{Value: v, Unit: c.DefaultUnit}, true
+	return nil
+}
+
+// Value implements driver.Valuer
+func (c QuantityColumn) Value() (driver.Value, error) {
+	if !c.Valid || c.Quantity.Unit == nil {
+		return nil, nil
+	}
+	q := c.Quantity
+	return "(" + strconv.FormatFloat(q.Value, 'g', -1, 64) + "," + AlakaTitle(q.TypeOf(), q.Unit) + ")", nil
+}
+
+// splitComposite splits "(value,unit)" into its fields. Fields may be quoted,
+// as Postgres does for row values.
+func splitComposite(s string) (string, string, bool) {
+	if !strings.HasPrefix(s, "(") || !strings.HasSuffix(s, ")") {
+		return "", "", false
+	}
+	fields := strings.Split(s[1:len(s)-1], ",")
+	if len(fields) != 2 {
+		return "", "", false
+	}
+	for i, f := range fields {
+		fields[i] = strings.Trim(strings.TrimSpace(f), `"`)
+	}
+	return fields[0], fields[1], fields[0] != "" && fields[1] != ""
+}
+
+// scanText returns src as a string if the driver handed over text
+func scanText(src interface{}, what string) (string, error) {
+	switch v := src.(type) {
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	default:
+		return "", fmt.Errorf("units: cannot scan %T into a %s", src, what)
+	}
+}
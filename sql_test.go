@@ -0,0 +1,217 @@
+package units
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeDriver is an in-memory database/sql driver. Every DSN is its own table;
+// statements starting with INSERT append their arguments as a row and any
+// other statement returns every row.
+type fakeDriver struct {
+	mu     sync.Mutex
+	tables map[string][][]driver.Value
+}
+
+var fake = &fakeDriver{tables: map[string][][]driver.Value{}}
+
+func init() {
+	sql.Register("unitsfake", fake)
+}
+
+func (d *fakeDriver) Open(dsn string) (driver.Conn, error) {
+	return &fakeConn{driver: d, dsn: dsn}, nil
+}
+
+type fakeConn struct {
+	driver *fakeDriver
+	dsn    string
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{conn: c, insert: strings.HasPrefix(strings.ToUpper(query), "INSERT")}, nil
+}
+
+func (c *fakeConn) Close() error { return nil }
+
+func (c *fakeConn) Begin() (driver.Tx, error) { return nil, errors.New("fake: no transactions") }
+
+type fakeStmt struct {
+	conn   *fakeConn
+	insert bool
+}
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if !s.insert {
+		return nil, errors.New("fake: only INSERT can be executed")
+	}
+	d := s.conn.driver
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.tables[s.conn.dsn] = append(d.tables[s.conn.dsn], args)
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	d := s.conn.driver
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return &fakeRows{rows: append([][]driver.Value(nil), d.tables[s.conn.dsn]...)}, nil
+}
+
+type fakeRows struct {
+	rows [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string {
+	if len(r.rows) == 0 {
+		return nil
+	}
+	return make([]string, len(r.rows[0]))
+}
+
+func (r *fakeRows) Close() error { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
+
+func openFake(t *testing.T) *sql.DB {
+	fake.mu.Lock()
+	delete(fake.tables, t.Name())
+	fake.mu.Unlock()
+
+	db, err := sql.Open("unitsfake", t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestSQLRoundTrip(t *testing.T) {
+	db := openFake(t)
+	want := NewQuantity(150, PoundsPerSquareInchPressureUnit)
+	if _, err := db.Exec("INSERT", UnitColumn{Unit: want.Unit}, NewQuantityColumn(want), 101325.0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec("INSERT", UnitColumn{}, QuantityColumn{}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := db.Query("SELECT")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	type row struct {
+		unit     UnitColumn
+		quantity QuantityColumn
+		reading  QuantityColumn
+	}
+	var got []row
+	for rows.Next() {
+		row := row{reading: QuantityColumn{DefaultUnit: PascalsPressureUnit}}
+		if err := rows.Scan(&row.unit, &row.quantity, &row.reading); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, row)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d rows, want 2", len(got))
+	}
+
+	if got[0].unit.Unit != want.Unit {
+		t.Errorf("unit = %v, want %v", got[0].unit.Unit, want.Unit)
+	}
+	if !got[0].quantity.Valid || got[0].quantity.Quantity != want {
+		t.Errorf("quantity = %v, want %v", got[0].quantity.Quantity, want)
+	}
+	if r := got[0].reading; !r.Valid || r.Quantity != NewQuantity(101325, PascalsPressureUnit) {
+		t.Errorf("reading = %v, want 101325 Pa", r.Quantity)
+	}
+
+	if got[1].unit.Unit != nil || got[1].quantity.Valid || got[1].reading.Valid {
+		t.Errorf("NULL row scanned as %+v", got[1])
+	}
+}
+
+func TestQuantityColumnScan(t *testing.T) {
+	tests := []struct {
+		src     interface{}
+		declare Unit
+		want    Quantity
+		wantErr bool
+	}{
+		{src: "(101325,Pressure_Pascals)", want: NewQuantity(101325, PascalsPressureUnit)},
+		{src: []byte(`( 1.5e3 , "Pressure_PoundsPerSquareInch" )`), want: NewQuantity(1500, PoundsPerSquareInchPressureUnit)},
+		{src: "(2,Pressure_Pascals)", declare: PoundsPerSquareInchPressureUnit, want: NewQuantity(2, PascalsPressureUnit)},
+		{src: "12.5", declare: PoundsPerSquareInchPressureUnit, want: NewQuantity(12.5, PoundsPerSquareInchPressureUnit)},
+		{src: int64(7), declare: PascalsPressureUnit, want: NewQuantity(7, PascalsPressureUnit)},
+		{src: 12.5, wantErr: true},
+		{src: "(1,Pressure_Nope)", wantErr: true},
+		{src: "(1,Length_Meters)", declare: PascalsPressureUnit, wantErr: true},
+		{src: "(1)", wantErr: true},
+		{src: true, wantErr: true},
+	}
+	for _, tc := range tests {
+		c := QuantityColumn{DefaultUnit: tc.declare}
+		err := c.Scan(tc.src)
+		if tc.wantErr {
+			if err == nil {
+				t.Errorf("Scan(%v) = %v, want an error", tc.src, c.Quantity)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Scan(%v): %v", tc.src, err)
+		} else if !c.Valid || c.Quantity != tc.want {
+			t.Errorf("Scan(%v) = %v, want %v", tc.src, c.Quantity, tc.want)
+		}
+	}
+}
+
+func TestQuantityColumnReuse(t *testing.T) {
+	c := QuantityColumn{DefaultUnit: PoundsPerSquareInchPressureUnit}
+	for _, tc := range []struct {
+		src  interface{}
+		want Quantity
+	}{
+		{"(2,Pressure_Pascals)", NewQuantity(2, PascalsPressureUnit)},
+		{12.5, NewQuantity(12.5, PoundsPerSquareInchPressureUnit)},
+		{nil, Quantity{}},
+		{int64(3), NewQuantity(3, PoundsPerSquareInchPressureUnit)},
+	} {
+		if err := c.Scan(tc.src); err != nil || c.Quantity != tc.want {
+			t.Errorf("Scan(%v) = %v, %v, want %v", tc.src, c.Quantity, err, tc.want)
+		}
+	}
+	if c.DefaultUnit != PoundsPerSquareInchPressureUnit {
+		t.Errorf("scanning changed the default unit to %v", c.DefaultUnit)
+	}
+}
+
+func TestUnitColumnScanUnknown(t *testing.T) {
+	var c UnitColumn
+	var unknown *ErrUnknownUnit
+	if err := c.Scan("Pressure_Nope"); !errors.As(err, &unknown) {
+		t.Errorf("Scan(Pressure_Nope) = %v, want *ErrUnknownUnit", err)
+	}
+}
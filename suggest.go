@@ -0,0 +1,141 @@
+package units
+
+import (
+	"sort"
+	"strings"
+)
+
+// Suggestion is a unit which input may have been meant as, see SuggestUnits
+type Suggestion struct {
+	Type UnitType
+	Unit Unit
+	// Match is the match string, name or symbol which input was closest to
+	Match string
+	// Confidence is from 0 to 1, where 1 is an exact match
+	Confidence float64
+}
+
+// MinSuggestionConfidence is the lowest confidence that SuggestUnits returns
+var MinSuggestionConfidence = 0.5
+
+// SuggestUnits returns up to n units of typeOf which input may have meant,
+// best first. Every unit's MatchList, Name and Symbol are scored against the
+// sanitized input: prefixes score highly, so it works for autocomplete, and
+// anything else by edit distance, so "psia" still finds psi.
+func SuggestUnits(input string, typeOf UnitType, n int) []Suggestion {
+	return DefaultRegistry.suggest(input, []UnitType{typeOf}, n)
+}
+
+// SuggestAnyUnits is SuggestUnits across every registered unit type
+func SuggestAnyUnits(input string, n int) []Suggestion {
+	return DefaultRegistry.suggest(input, RegisteredTypes(), n)
+}
+
+// suggest scores the units of types in r. The registry mustn't be locked as
+// its units are listed one type at a time.
+func (r *Registry) suggest(input string, types []UnitType, n int) []Suggestion {
+	check := SanitizeString(input)
+	if check == "" || n <= 0 {
+		return nil
+	}
+
+	var out []Suggestion
+	for _, ut := range types {
+		for _, u := range r.Units(ut) {
+			best := Suggestion{Type: ut, Unit: u}
+			for _, candidate := range suggestionCandidates(u) {
+				if c := similarity(check, SanitizeString(candidate)); c > best.Confidence {
+					best.Match, best.Confidence = candidate, c
+				}
+			}
+			if best.Confidence >= MinSuggestionConfidence {
+				out = append(out, best)
+			}
+		}
+	}
+
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Confidence > out[j].Confidence })
+	if len(out) > n {
+		out = out[:n]
+	}
+	return out
+}
+
+// suggestionCandidates are the strings a unit can be suggested for
+func suggestionCandidates(u Unit) []string {
+	candidates := []string{u.Symbol(), u.Name()}
+	for _, m := range u.MatchList() {
+		if m != "*" {
+			candidates = append(candidates, m)
+		}
+	}
+	return candidates
+}
+
+// similarity scores how close input is to candidate from 0 to 1. A prefix
+// of candidate scores at least 0.6, growing with how much of it is typed.
+func similarity(input, candidate string) float64 {
+	if candidate == "" {
+		return 0
+	}
+	if input == candidate {
+		return 1
+	}
+
+	a, b := []rune(input), []rune(candidate)
+	longest := len(a)
+	if len(b) > longest {
+		longest = len(b)
+	}
+	score := 1 - float64(editDistance(a, b))/float64(longest)
+	if strings.HasPrefix(candidate, input) {
+		if prefix := 0.6 + 0.39*float64(len(a))/float64(len(b)); prefix > score {
+			score = prefix
+		}
+	}
+	return score
+}
+
+// editDistance is the Levenshtein distance between a and b
+func editDistance(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min3(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
+
+// closestUnit returns the symbol, or name, of the unit of typeOf in r which
+// input most likely meant, or "" when nothing is close
+func (r *Registry) closestUnit(input string, typeOf UnitType) string {
+	suggestions := r.suggest(input, []UnitType{typeOf}, 1)
+	if len(suggestions) == 0 {
+		return ""
+	}
+	if u := suggestions[0].Unit; u.Symbol() != "" {
+		return u.Symbol()
+	}
+	return suggestions[0].Unit.Name()
+}
@@ -0,0 +1,47 @@
+package units
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSuggestUnits(t *testing.T) {
+	tests := map[string]Unit{
+		"psia":   PoundsPerSquareInchPressureUnit,
+		"kilopa": KilopascalsPressureUnit,
+		"PASCAL": PascalsPressureUnit,
+	}
+	for input, want := range tests {
+		got := SuggestUnits(input, PressureUnitType, 3)
+		if len(got) == 0 || got[0].Unit != want {
+			t.Errorf("SuggestUnits(%q) = %v, want %s first", input, got, want.Name())
+			continue
+		}
+		for i := 1; i < len(got); i++ {
+			if got[i].Confidence > got[i-1].Confidence {
+				t.Errorf("SuggestUnits(%q) isn't sorted by confidence", input)
+			}
+		}
+	}
+
+	if got := SuggestUnits("zzzzzz", PressureUnitType, 3); len(got) != 0 {
+		t.Errorf("SuggestUnits(zzzzzz) = %v", got)
+	}
+	if got := SuggestAnyUnits("degf", 1); len(got) != 1 || got[0].Type != TemperatureUnitType {
+		t.Errorf("SuggestAnyUnits(degf) = %v", got)
+	}
+
+	_, err := LookupUnit("kpaa", PressureUnitType)
+	if err == nil || !strings.Contains(err.Error(), `did you mean "kPa"?`) {
+		t.Errorf("LookupUnit(kpaa) = %v", err)
+	}
+	var unknown *ErrUnknownUnit
+	if !errors.As(err, &unknown) || unknown.Suggestion != "kPa" {
+		t.Errorf("LookupUnit(kpaa) = %#v", err)
+	}
+	_, err = LookupUnit("zzzzzz", PressureUnitType)
+	if !errors.As(err, &unknown) || unknown.Suggestion != "" || strings.Contains(err.Error(), "did you mean") {
+		t.Errorf("LookupUnit(zzzzzz) = %v", err)
+	}
+}
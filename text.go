@@ -0,0 +1,152 @@
+package units
+
+import "bytes"
+
+// AnyUnit holds a Unit of any UnitType so it can be decoded from text, JSON
+// or YAML, where the concrete generated type isn't known up front. It's
+// written as the AlakaTitle of its Unit, eg. "Pressure_Kilopascals", and
+// works as a map key. An empty AnyUnit is written as "".
+type AnyUnit struct {
+	Unit
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (a AnyUnit) MarshalText() ([]byte, error) {
+	if a.Unit == nil {
+		return []byte{}, nil
+	}
+	return []byte(AlakaTitle(a.Unit.TypeOf(), a.Unit)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using DecodeUnit
+func (a *AnyUnit) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		a.Unit = nil
+		return nil
+	}
+	u, err := DecodeUnit(text)
+	if err != nil {
+		return err
+	}
+	a.Unit = u
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler
+func (a AnyUnit) MarshalYAML() (interface{}, error) {
+	text, err := a.MarshalText()
+	return string(text), err
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, see UnmarshalText
+func (a *AnyUnit) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var text string
+	if err := unmarshal(&text); err != nil {
+		return err
+	}
+	return a.UnmarshalText([]byte(text))
+}
+
+// AnyUnitType is AnyUnit for unit types, written as their Title, eg.
+// "Pressure"
+type AnyUnitType struct {
+	UnitType
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (a AnyUnitType) MarshalText() ([]byte, error) {
+	if a.UnitType == nil {
+		return []byte{}, nil
+	}
+	return []byte(a.UnitType.Title()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using DecodeUnitType
+func (a *AnyUnitType) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		a.UnitType = nil
+		return nil
+	}
+	ut, err := DecodeUnitType(text)
+	if err != nil {
+		return err
+	}
+	a.UnitType = ut
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler
+func (a AnyUnitType) MarshalYAML() (interface{}, error) {
+	text, err := a.MarshalText()
+	return string(text), err
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, see UnmarshalText
+func (a *AnyUnitType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var text string
+	if err := unmarshal(&text); err != nil {
+		return err
+	}
+	return a.UnmarshalText([]byte(text))
+}
+
+// MarshalText implements encoding.TextMarshaler, writing the same object as
+// MarshalJSON, eg. {"value":150,"unit":"Pressure_PoundsPerSquareInch"}. A
+// Quantity without a unit is written as "".
+func (q Quantity) MarshalText() ([]byte, error) {
+	if q.Unit == nil {
+		return []byte{}, nil
+	}
+	return q.MarshalJSON()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the object
+// written by MarshalText or free text such as "150 psi", which is parsed with
+// ParseAnyQuantity, and "" is a Quantity without a unit.
+func (q *Quantity) UnmarshalText(text []byte) error {
+	if len(bytes.TrimSpace(text)) == 0 {
+		*q = Quantity{}
+		return nil
+	}
+	var parsed Quantity
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(text), []byte("{")) {
+		parsed, err = unmarshalObject(text, nil)
+	} else {
+		parsed, err = ParseAnyQuantity(string(text))
+	}
+	if err != nil {
+		return err
+	}
+	*q = parsed
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler, writing the same value and unit as
+// MarshalJSON as a mapping. A Quantity without a unit is written as null.
+func (q Quantity) MarshalYAML() (interface{}, error) {
+	if q.Unit == nil {
+		return nil, nil
+	}
+	return quantityJSON{Value: q.Value, Unit: AlakaTitle(q.TypeOf(), q.Unit)}, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler. It accepts the mapping written by
+// MarshalYAML or a string such as "150 psi", as UnmarshalJSON does, and bare
+// numbers are an error.
+func (q *Quantity) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var parsed Quantity
+	var text string
+	var raw quantityFields
+	err := unmarshal(&text)
+	if err == nil {
+		parsed, err = ParseAnyQuantity(text)
+	} else if err = unmarshal(&raw); err == nil {
+		parsed, err = raw.quantity("in YAML", nil)
+	}
+	if err != nil {
+		return err
+	}
+	*q = parsed
+	return nil
+}
@@ -0,0 +1,81 @@
+package units
+
+import (
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+func TestYAMLRoundTrip(t *testing.T) {
+	type document struct {
+		Reading Quantity    `yaml:"reading"`
+		Empty   Quantity    `yaml:"empty"`
+		Unit    AnyUnit     `yaml:"unit"`
+		Type    AnyUnitType `yaml:"type"`
+	}
+	in := document{
+		Reading: NewQuantity(150, PoundsPerSquareInchPressureUnit),
+		Unit:    AnyUnit{KilopascalsPressureUnit},
+		Type:    AnyUnitType{TemperatureUnitType},
+	}
+	want := `reading:
+  value: 150
+  unit: Pressure_PoundsPerSquareInch
+empty: null
+unit: Pressure_Kilopascals
+type: Temperature
+`
+	data, err := yaml.Marshal(in)
+	if err != nil || string(data) != want {
+		t.Fatalf("Marshal = %s, %v, want %s", data, err, want)
+	}
+
+	var out document
+	if err := yaml.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out != in {
+		t.Errorf("Unmarshal(%s) = %+v, want %+v", data, out, in)
+	}
+
+	tests := map[string]Quantity{
+		"reading: 12.5 kPa": NewQuantity(12.5, KilopascalsPressureUnit),
+		"reading: {value: 3, unit: Temperature_Kelvins}": NewQuantity(3, KelvinsTemperatureUnit),
+	}
+	for input, want := range tests {
+		var doc document
+		if err := yaml.Unmarshal([]byte(input), &doc); err != nil || doc.Reading != want {
+			t.Errorf("Unmarshal(%s) = %v, %v, want %v", input, doc.Reading, err, want)
+		}
+	}
+	for _, input := range []string{"reading: 150", "reading: {value: 150}", "reading: {unit: Pressure_Pascals}", "reading: 12.5 psig", "unit: Pressure_Nope", "type: Nope"} {
+		var doc document
+		if err := yaml.Unmarshal([]byte(input), &doc); err == nil {
+			t.Errorf("Unmarshal(%s) = %+v, want an error", input, doc)
+		}
+	}
+}
+
+func TestQuantityText(t *testing.T) {
+	q := NewQuantity(150, PoundsPerSquareInchPressureUnit)
+	text, err := q.MarshalText()
+	if err != nil || string(text) != `{"value":150,"unit":"Pressure_PoundsPerSquareInch"}` {
+		t.Errorf("MarshalText(150 psi) = %s, %v", text, err)
+	}
+
+	tests := map[string]Quantity{
+		string(text): q,
+		"150 psi":    q,
+		"":           {},
+	}
+	for input, want := range tests {
+		got := NewQuantity(1, PascalsPressureUnit)
+		if err := got.UnmarshalText([]byte(input)); err != nil || got != want {
+			t.Errorf("UnmarshalText(%q) = %v, %v, want %v", input, got, err, want)
+		}
+	}
+	var got Quantity
+	if err := got.UnmarshalText([]byte("150")); err == nil {
+		t.Errorf("UnmarshalText(150) = %v, want an error", got)
+	}
+}
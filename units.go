@@ -12,17 +12,25 @@
 // including the ability to use those type definitions as guards in
 // functions that depend on a particular Unit or UnitType. Eg.:
 //
-// func AddPressure (p1, p2 PascalsPressure) PascalsPressure {
-//     returns p1 + p2
-// }
+//	func AddPressure (p1, p2 PascalsPressure) PascalsPressure {
+//	    returns p1 + p2
+//	}
+//
+// Scalars of the generated types marshal as plain numbers, so they keep
+// their value in JSON and YAML. To write a unit or unit type itself, eg.
+// as a map key or in a config file, wrap it in AnyUnit or AnyUnitType,
+// which marshal to text, JSON and YAML as its AlakaTitle or Title, or
+// encode a Quantity to keep both the value and its unit.
 package units
 
 import (
+	"fmt"
+	"math/big"
 	"regexp"
 	"strings"
 )
 
-// File autogenerated on 2022-03-30 11:14:45.39394226 -0500 CDT m=+0.000861970.
+// File autogenerated from units.yaml (sha256 5bc3954acadf7f43).
 // Do not edit directly
 
 // Unit represents a scalar type of unit which can be converted to and from a base
@@ -57,6 +65,8 @@ type UnitType interface {
 	// Most of the time this is an SI unit, but not always (temperature is C,
 	// not K, for example)
 	Base() Unit
+	// Dimension returns the exponents of the SI base dimensions of this unit type
+	Dimension() Dimension
 	// Units returns all the supported units of this unit type
 	Units() []Unit
 	// UnitList returns all the supported units of this unit type as strings
@@ -81,10 +91,45 @@ func AlakaTitle(ut UnitType, u Unit) string {
 	return ut.Title() + "_" + u.Title()
 }
 
+// ErrUnknownType is returned by LookupType when the input doesn't match any unit type
+type ErrUnknownType struct {
+	// Input is the sanitized string which failed to match
+	Input string
+}
+
+// Error implements the error interface
+func (e *ErrUnknownType) Error() string {
+	return fmt.Sprintf("units: unknown unit type %q", e.Input)
+}
+
+// ErrUnknownUnit is returned by LookupUnit and LookupTypeUnit when the input doesn't match any unit
+type ErrUnknownUnit struct {
+	// Input is the sanitized string which failed to match, or the AlakaTitle
+	// as given to LookupTypeUnit, which matches it exactly
+	Input string
+	// Type is the unit type that was searched, nil when searching by AlakaTitle
+	Type UnitType
+	// Suggestion is the symbol, or name, of the unit of Type closest to Input
+	// when the error was made, or empty when nothing is close
+	Suggestion string
+}
+
+// Error implements the error interface
+func (e *ErrUnknownUnit) Error() string {
+	if e.Type == nil {
+		return fmt.Sprintf("units: unknown unit %q", e.Input)
+	}
+	if e.Suggestion != "" {
+		return fmt.Sprintf("units: unknown %s unit %q; did you mean %q?", e.Type.Title(), e.Input, e.Suggestion)
+	}
+	return fmt.Sprintf("units: unknown %s unit %q", e.Type.Title(), e.Input)
+}
+
 // AllTypes is a list of all available types below
 var AllTypes = [...]string{
 	"Pressure",
 	"Temperature",
+	"TemperatureDifference",
 	"Flow",
 	"Volume",
 	"Mass",
@@ -99,6 +144,7 @@ var AllTypes = [...]string{
 	"Force",
 	"Length",
 	"StrokeRate",
+	"Time",
 	"Number",
 	"Overspeed",
 	"Underspeed",
@@ -106,24 +152,54 @@ var AllTypes = [...]string{
 	"WMLFlowRate",
 }
 
+// UnitTypes is a list of all available unit types below,
+// in the same order as AllTypes
+var UnitTypes = [...]UnitType{
+	PressureUnitType,
+	TemperatureUnitType,
+	TemperatureDifferenceUnitType,
+	FlowUnitType,
+	VolumeUnitType,
+	MassUnitType,
+	MassFlowUnitType,
+	ElectricPotentialUnitType,
+	ElectricPotentialLoadedUnitType,
+	ElectricPotentialUnloadedUnitType,
+	PercentageUnitType,
+	HumidityUnitType,
+	AlarmUnitType,
+	WorkUnitType,
+	ForceUnitType,
+	LengthUnitType,
+	StrokeRateUnitType,
+	TimeUnitType,
+	NumberUnitType,
+	OverspeedUnitType,
+	UnderspeedUnitType,
+	TotaliserUnitType,
+	WMLFlowRateUnitType,
+}
+
 // AllUnits is a map of unit type -> units
 var AllUnits = map[string][]string{
 	"Pressure":                  {"Pascals", "Kilopascals", "Megapascals", "PoundsPerSquareInch", "InchesOfWater"},
 	"Temperature":               {"DegreesCelsius", "DegreesFahrenheit", "Kelvins"},
+	"TemperatureDifference":     {"DegreesCelsius", "DegreesFahrenheit", "Kelvins"},
 	"Flow":                      {"CubicMetersPerSecond", "CubicFeetPerSecond", "ThousandCubicFeetPerDay", "GallonsUSFluidPerSecond", "GallonsUSFluidPerMinute", "BarrelsPerSecond", "BarrelsPerMinute"},
 	"Volume":                    {"CubicMeters", "CubicFeet", "ThousandsOfCubicFeet", "CubicDecimeter", "Liter", "GallonUSFluid", "BarrelsOfOil"},
 	"Mass":                      {"Kilograms", "Pounds"},
 	"MassFlow":                  {"KilogramsPerSecond", "PoundsPerSecond", "PoundsPerMinute"},
-	"ElectricPotential":         {"Volts"},
-	"ElectricPotentialLoaded":   {"Volts"},
-	"ElectricPotentialUnloaded": {"Volts"},
+	"ElectricPotential":         {"Volts", "Millivolts", "Kilovolts"},
+	"ElectricPotentialLoaded":   {"Volts", "Millivolts", "Kilovolts"},
+	"ElectricPotentialUnloaded": {"Volts", "Millivolts", "Kilovolts"},
 	"Percentage":                {"Percent"},
 	"Humidity":                  {"Percent"},
 	"Alarm":                     {"Percent"},
-	"Work":                      {"Joules", "InchPoundsForce", "CubicFeetOfNaturalGas", "BarrelsOfOilEquivalent"},
-	"Force":                     {"Newtons", "PoundsForce", "KilogramsForce"},
-	"Length":                    {"Meters", "Feet", "Inches"},
+	"Work":                      {"Joules", "Kilojoules", "Megajoules", "InchPoundsForce", "CubicFeetOfNaturalGas", "BarrelsOfOilEquivalent"},
+	"Force":                     {"Newtons", "Kilonewtons", "PoundsForce", "KilogramsForce"},
+	"Length":                    {"Meters", "Millimeters", "Kilometers", "Feet", "Inches"},
 	"StrokeRate":                {"StrokesPerSecond"},
+	"Time":                      {"Seconds", "Minutes", "Hours", "Days"},
 	"Number":                    {"Number"},
 	"Overspeed":                 {"Number"},
 	"Underspeed":                {"Number"},
@@ -142,6 +218,9 @@ var AllUnitTypes = [...]string{
 	"Temperature_DegreesCelsius",
 	"Temperature_DegreesFahrenheit",
 	"Temperature_Kelvins",
+	"TemperatureDifference_DegreesCelsius",
+	"TemperatureDifference_DegreesFahrenheit",
+	"TemperatureDifference_Kelvins",
 	"Flow_CubicMetersPerSecond",
 	"Flow_CubicFeetPerSecond",
 	"Flow_ThousandCubicFeetPerDay",
@@ -162,22 +241,37 @@ var AllUnitTypes = [...]string{
 	"MassFlow_PoundsPerSecond",
 	"MassFlow_PoundsPerMinute",
 	"ElectricPotential_Volts",
+	"ElectricPotential_Millivolts",
+	"ElectricPotential_Kilovolts",
 	"ElectricPotentialLoaded_Volts",
+	"ElectricPotentialLoaded_Millivolts",
+	"ElectricPotentialLoaded_Kilovolts",
 	"ElectricPotentialUnloaded_Volts",
+	"ElectricPotentialUnloaded_Millivolts",
+	"ElectricPotentialUnloaded_Kilovolts",
 	"Percentage_Percent",
 	"Humidity_Percent",
 	"Alarm_Percent",
 	"Work_Joules",
+	"Work_Kilojoules",
+	"Work_Megajoules",
 	"Work_InchPoundsForce",
 	"Work_CubicFeetOfNaturalGas",
 	"Work_BarrelsOfOilEquivalent",
 	"Force_Newtons",
+	"Force_Kilonewtons",
 	"Force_PoundsForce",
 	"Force_KilogramsForce",
 	"Length_Meters",
+	"Length_Millimeters",
+	"Length_Kilometers",
 	"Length_Feet",
 	"Length_Inches",
 	"StrokeRate_StrokesPerSecond",
+	"Time_Seconds",
+	"Time_Minutes",
+	"Time_Hours",
+	"Time_Days",
 	"Number_Number",
 	"Overspeed_Number",
 	"Underspeed_Number",
@@ -185,783 +279,1104 @@ var AllUnitTypes = [...]string{
 	"WMLFlowRate_Number",
 }
 
-// GetType returns the unit type which matches input or NumberUnitType
-func GetType(input string) UnitType {
-	switch SanitizeString(input) {
+// LookupType returns the unit type which matches input or an *ErrUnknownType. Unit
+// types registered in DefaultRegistry are searched after the compiled-in ones
+func LookupType(input string) (UnitType, error) {
+	check := SanitizeString(input)
+	switch check {
 	case "pressure":
-		return PressureUnitType
+		return PressureUnitType, nil
 	case "temperature":
-		return TemperatureUnitType
+		return TemperatureUnitType, nil
 	case "temp":
-		return TemperatureUnitType
+		return TemperatureUnitType, nil
+	case "temperaturedifference":
+		return TemperatureDifferenceUnitType, nil
+	case "tempdifference":
+		return TemperatureDifferenceUnitType, nil
+	case "temperaturedelta":
+		return TemperatureDifferenceUnitType, nil
+	case "tempdelta":
+		return TemperatureDifferenceUnitType, nil
+	case "deltat":
+		return TemperatureDifferenceUnitType, nil
+	case "δt":
+		return TemperatureDifferenceUnitType, nil
 	case "flow":
-		return FlowUnitType
+		return FlowUnitType, nil
 	case "flowrate":
-		return FlowUnitType
+		return FlowUnitType, nil
 	case "flow_rate":
-		return FlowUnitType
+		return FlowUnitType, nil
 	case "gasflow":
-		return FlowUnitType
+		return FlowUnitType, nil
 	case "gasflowrate":
-		return FlowUnitType
+		return FlowUnitType, nil
 	case "gas_flow":
-		return FlowUnitType
+		return FlowUnitType, nil
 	case "gas_flow_rate":
-		return FlowUnitType
+		return FlowUnitType, nil
 	case "volume":
-		return VolumeUnitType
+		return VolumeUnitType, nil
 	case "mass":
-		return MassUnitType
+		return MassUnitType, nil
 	case "massflow":
-		return MassFlowUnitType
+		return MassFlowUnitType, nil
 	case "massflowrate":
-		return MassFlowUnitType
+		return MassFlowUnitType, nil
 	case "flow(mass)":
-		return MassFlowUnitType
+		return MassFlowUnitType, nil
 	case "flowrate(mass)":
-		return MassFlowUnitType
+		return MassFlowUnitType, nil
 	case "electricpotential":
-		return ElectricPotentialUnitType
+		return ElectricPotentialUnitType, nil
 	case "voltage":
-		return ElectricPotentialUnitType
+		return ElectricPotentialUnitType, nil
 	case "electricpotentialloaded":
-		return ElectricPotentialLoadedUnitType
+		return ElectricPotentialLoadedUnitType, nil
 	case "voltageloaded":
-		return ElectricPotentialLoadedUnitType
+		return ElectricPotentialLoadedUnitType, nil
 	case "electricpotentialunloaded":
-		return ElectricPotentialUnloadedUnitType
+		return ElectricPotentialUnloadedUnitType, nil
 	case "voltageunloaded":
-		return ElectricPotentialUnloadedUnitType
+		return ElectricPotentialUnloadedUnitType, nil
 	case "percentage":
-		return PercentageUnitType
+		return PercentageUnitType, nil
 	case "humidity":
-		return HumidityUnitType
+		return HumidityUnitType, nil
 	case "alarm":
-		return AlarmUnitType
+		return AlarmUnitType, nil
 	case "work":
-		return WorkUnitType
+		return WorkUnitType, nil
 	case "force":
-		return ForceUnitType
+		return ForceUnitType, nil
 	case "l":
-		return LengthUnitType
+		return LengthUnitType, nil
 	case "length":
-		return LengthUnitType
+		return LengthUnitType, nil
 	case "strokerate":
-		return StrokeRateUnitType
+		return StrokeRateUnitType, nil
 	case "stroke-rate":
-		return StrokeRateUnitType
+		return StrokeRateUnitType, nil
+	case "time":
+		return TimeUnitType, nil
+	case "duration":
+		return TimeUnitType, nil
 	case "*":
-		return NumberUnitType
+		return NumberUnitType, nil
 	case "overspeed":
-		return OverspeedUnitType
+		return OverspeedUnitType, nil
 	case "underspeed":
-		return UnderspeedUnitType
+		return UnderspeedUnitType, nil
 	case "totaliser":
-		return TotaliserUnitType
+		return TotaliserUnitType, nil
 	case "wmlflowrate":
-		return WMLFlowRateUnitType
+		return WMLFlowRateUnitType, nil
 	default:
-		return NumberUnitType
+		return DefaultRegistry.LookupType(input)
 	}
 }
 
-// GetUnit returns the unit which matches input or NumberNumberUnit
-func GetUnit(input string, typeOf UnitType) Unit {
-	search := typeOf.Title() + "->" + SanitizeString(input)
-	switch search {
+// LookupUnit returns the unit of typeOf which matches input or an *ErrUnknownUnit.
+// Units registered in DefaultRegistry are searched after the compiled-in ones
+func LookupUnit(input string, typeOf UnitType) (Unit, error) {
+	if u, ok := findUnit(input, typeOf); ok {
+		return u, nil
+	}
+	return nil, DefaultRegistry.unknownUnit(input, typeOf)
+}
+
+// findUnit returns the unit of typeOf which matches input, or false
+func findUnit(input string, typeOf UnitType) (Unit, bool) {
+	switch typeOf.Title() + "->" + WhitespaceRegex.ReplaceAllString(input, "") {
+	case "Pressure->kPa":
+		return KilopascalsPressureUnit, true
+	case "Pressure->MPa":
+		return MegapascalsPressureUnit, true
+	case "ElectricPotential->mV":
+		return MillivoltsElectricPotentialUnit, true
+	case "ElectricPotential->kV":
+		return KilovoltsElectricPotentialUnit, true
+	case "ElectricPotentialLoaded->mV":
+		return MillivoltsElectricPotentialLoadedUnit, true
+	case "ElectricPotentialLoaded->kV":
+		return KilovoltsElectricPotentialLoadedUnit, true
+	case "ElectricPotentialUnloaded->mV":
+		return MillivoltsElectricPotentialUnloadedUnit, true
+	case "ElectricPotentialUnloaded->kV":
+		return KilovoltsElectricPotentialUnloadedUnit, true
+	case "Work->kJ":
+		return KilojoulesWorkUnit, true
+	case "Work->MJ":
+		return MegajoulesWorkUnit, true
+	case "Force->kN":
+		return KilonewtonsForceUnit, true
+	case "Length->mm":
+		return MillimetersLengthUnit, true
+	case "Length->km":
+		return KilometersLengthUnit, true
+	}
+	check := SanitizeString(input)
+	switch typeOf.Title() + "->" + check {
 	case "Pressure->pa":
-		return PascalsPressureUnit
+		return PascalsPressureUnit, true
 	case "Pressure->pascal":
-		return PascalsPressureUnit
+		return PascalsPressureUnit, true
 	case "Pressure->pascals":
-		return PascalsPressureUnit
+		return PascalsPressureUnit, true
 	case "Pressure->kpa":
-		return KilopascalsPressureUnit
+		return KilopascalsPressureUnit, true
 	case "Pressure->kilopascal":
-		return KilopascalsPressureUnit
+		return KilopascalsPressureUnit, true
 	case "Pressure->kilopascals":
-		return KilopascalsPressureUnit
-	case "Pressure->mpa":
-		return MegapascalsPressureUnit
+		return KilopascalsPressureUnit, true
 	case "Pressure->megapascal":
-		return MegapascalsPressureUnit
+		return MegapascalsPressureUnit, true
 	case "Pressure->megapascals":
-		return MegapascalsPressureUnit
+		return MegapascalsPressureUnit, true
 	case "Pressure->psi":
-		return PoundsPerSquareInchPressureUnit
+		return PoundsPerSquareInchPressureUnit, true
 	case "Pressure->poundspersquareinch":
-		return PoundsPerSquareInchPressureUnit
+		return PoundsPerSquareInchPressureUnit, true
 	case "Pressure->poundpersquareinch":
-		return PoundsPerSquareInchPressureUnit
+		return PoundsPerSquareInchPressureUnit, true
 	case "Pressure->inh₂o":
-		return InchesOfWaterPressureUnit
+		return InchesOfWaterPressureUnit, true
 	case "Pressure->inh₂0":
-		return InchesOfWaterPressureUnit
+		return InchesOfWaterPressureUnit, true
 	case "Pressure->inh2o":
-		return InchesOfWaterPressureUnit
+		return InchesOfWaterPressureUnit, true
 	case "Pressure->inh20":
-		return InchesOfWaterPressureUnit
+		return InchesOfWaterPressureUnit, true
 	case "Pressure->incheswater":
-		return InchesOfWaterPressureUnit
+		return InchesOfWaterPressureUnit, true
 	case "Pressure->inchesofwater":
-		return InchesOfWaterPressureUnit
+		return InchesOfWaterPressureUnit, true
 	case "Pressure->inchwater":
-		return InchesOfWaterPressureUnit
+		return InchesOfWaterPressureUnit, true
 	case "Pressure->inchofwater":
-		return InchesOfWaterPressureUnit
+		return InchesOfWaterPressureUnit, true
 	case "Temperature->c":
-		return DegreesCelsiusTemperatureUnit
+		return DegreesCelsiusTemperatureUnit, true
 	case "Temperature->°c":
-		return DegreesCelsiusTemperatureUnit
+		return DegreesCelsiusTemperatureUnit, true
 	case "Temperature->celsius":
-		return DegreesCelsiusTemperatureUnit
+		return DegreesCelsiusTemperatureUnit, true
 	case "Temperature->degreesc":
-		return DegreesCelsiusTemperatureUnit
+		return DegreesCelsiusTemperatureUnit, true
 	case "Temperature->degreec":
-		return DegreesCelsiusTemperatureUnit
+		return DegreesCelsiusTemperatureUnit, true
 	case "Temperature->degreescelsius":
-		return DegreesCelsiusTemperatureUnit
+		return DegreesCelsiusTemperatureUnit, true
 	case "Temperature->degreecelsius":
-		return DegreesCelsiusTemperatureUnit
+		return DegreesCelsiusTemperatureUnit, true
 	case "Temperature->f":
-		return DegreesFahrenheitTemperatureUnit
+		return DegreesFahrenheitTemperatureUnit, true
 	case "Temperature->°f":
-		return DegreesFahrenheitTemperatureUnit
+		return DegreesFahrenheitTemperatureUnit, true
 	case "Temperature->fahrenheit":
-		return DegreesFahrenheitTemperatureUnit
+		return DegreesFahrenheitTemperatureUnit, true
 	case "Temperature->degreesf":
-		return DegreesFahrenheitTemperatureUnit
+		return DegreesFahrenheitTemperatureUnit, true
 	case "Temperature->degreef":
-		return DegreesFahrenheitTemperatureUnit
+		return DegreesFahrenheitTemperatureUnit, true
 	case "Temperature->degreesfahrenheit":
-		return DegreesFahrenheitTemperatureUnit
+		return DegreesFahrenheitTemperatureUnit, true
 	case "Temperature->degreefahrenheit":
-		return DegreesFahrenheitTemperatureUnit
+		return DegreesFahrenheitTemperatureUnit, true
 	case "Temperature->k":
-		return KelvinsTemperatureUnit
+		return KelvinsTemperatureUnit, true
 	case "Temperature->°k":
-		return KelvinsTemperatureUnit
+		return KelvinsTemperatureUnit, true
 	case "Temperature->kelvin":
-		return KelvinsTemperatureUnit
+		return KelvinsTemperatureUnit, true
 	case "Temperature->kelvins":
-		return KelvinsTemperatureUnit
+		return KelvinsTemperatureUnit, true
 	case "Temperature->degreesk":
-		return KelvinsTemperatureUnit
+		return KelvinsTemperatureUnit, true
 	case "Temperature->degreek":
-		return KelvinsTemperatureUnit
+		return KelvinsTemperatureUnit, true
 	case "Temperature->degreeskelvin":
-		return KelvinsTemperatureUnit
+		return KelvinsTemperatureUnit, true
 	case "Temperature->degreekelvin":
-		return KelvinsTemperatureUnit
+		return KelvinsTemperatureUnit, true
+	case "TemperatureDifference->δ°c":
+		return DegreesCelsiusTemperatureDifferenceUnit, true
+	case "TemperatureDifference->δc":
+		return DegreesCelsiusTemperatureDifferenceUnit, true
+	case "TemperatureDifference->deltac":
+		return DegreesCelsiusTemperatureDifferenceUnit, true
+	case "TemperatureDifference->delta°c":
+		return DegreesCelsiusTemperatureDifferenceUnit, true
+	case "TemperatureDifference->deltacelsius":
+		return DegreesCelsiusTemperatureDifferenceUnit, true
+	case "TemperatureDifference->celsiusdifference":
+		return DegreesCelsiusTemperatureDifferenceUnit, true
+	case "TemperatureDifference->degreescelsiusdifference":
+		return DegreesCelsiusTemperatureDifferenceUnit, true
+	case "TemperatureDifference->δ°f":
+		return DegreesFahrenheitTemperatureDifferenceUnit, true
+	case "TemperatureDifference->δf":
+		return DegreesFahrenheitTemperatureDifferenceUnit, true
+	case "TemperatureDifference->deltaf":
+		return DegreesFahrenheitTemperatureDifferenceUnit, true
+	case "TemperatureDifference->delta°f":
+		return DegreesFahrenheitTemperatureDifferenceUnit, true
+	case "TemperatureDifference->deltafahrenheit":
+		return DegreesFahrenheitTemperatureDifferenceUnit, true
+	case "TemperatureDifference->fahrenheitdifference":
+		return DegreesFahrenheitTemperatureDifferenceUnit, true
+	case "TemperatureDifference->degreesfahrenheitdifference":
+		return DegreesFahrenheitTemperatureDifferenceUnit, true
+	case "TemperatureDifference->δk":
+		return KelvinsTemperatureDifferenceUnit, true
+	case "TemperatureDifference->deltak":
+		return KelvinsTemperatureDifferenceUnit, true
+	case "TemperatureDifference->deltakelvin":
+		return KelvinsTemperatureDifferenceUnit, true
+	case "TemperatureDifference->kelvindifference":
+		return KelvinsTemperatureDifferenceUnit, true
+	case "TemperatureDifference->kelvinsdifference":
+		return KelvinsTemperatureDifferenceUnit, true
 	case "Flow->m³/s":
-		return CubicMetersPerSecondFlowUnit
+		return CubicMetersPerSecondFlowUnit, true
 	case "Flow->m³s":
-		return CubicMetersPerSecondFlowUnit
+		return CubicMetersPerSecondFlowUnit, true
 	case "Flow->m3/s":
-		return CubicMetersPerSecondFlowUnit
+		return CubicMetersPerSecondFlowUnit, true
 	case "Flow->m3s":
-		return CubicMetersPerSecondFlowUnit
+		return CubicMetersPerSecondFlowUnit, true
 	case "Flow->m^3/s":
-		return CubicMetersPerSecondFlowUnit
+		return CubicMetersPerSecondFlowUnit, true
 	case "Flow->m^3s":
-		return CubicMetersPerSecondFlowUnit
+		return CubicMetersPerSecondFlowUnit, true
 	case "Flow->cubicmeterspersecond":
-		return CubicMetersPerSecondFlowUnit
+		return CubicMetersPerSecondFlowUnit, true
 	case "Flow->cubicmeterpersecond":
-		return CubicMetersPerSecondFlowUnit
+		return CubicMetersPerSecondFlowUnit, true
 	case "Flow->cubicmeters/second":
-		return CubicMetersPerSecondFlowUnit
+		return CubicMetersPerSecondFlowUnit, true
 	case "Flow->cubicmeter/second":
-		return CubicMetersPerSecondFlowUnit
+		return CubicMetersPerSecondFlowUnit, true
 	case "Flow->ft³/s":
-		return CubicFeetPerSecondFlowUnit
+		return CubicFeetPerSecondFlowUnit, true
 	case "Flow->ft³s":
-		return CubicFeetPerSecondFlowUnit
+		return CubicFeetPerSecondFlowUnit, true
 	case "Flow->ft3/s":
-		return CubicFeetPerSecondFlowUnit
+		return CubicFeetPerSecondFlowUnit, true
 	case "Flow->ft3s":
-		return CubicFeetPerSecondFlowUnit
+		return CubicFeetPerSecondFlowUnit, true
 	case "Flow->ft^3/s":
-		return CubicFeetPerSecondFlowUnit
+		return CubicFeetPerSecondFlowUnit, true
 	case "Flow->ft^3s":
-		return CubicFeetPerSecondFlowUnit
+		return CubicFeetPerSecondFlowUnit, true
 	case "Flow->f³/s":
-		return CubicFeetPerSecondFlowUnit
+		return CubicFeetPerSecondFlowUnit, true
 	case "Flow->f³s":
-		return CubicFeetPerSecondFlowUnit
+		return CubicFeetPerSecondFlowUnit, true
 	case "Flow->f3/s":
-		return CubicFeetPerSecondFlowUnit
+		return CubicFeetPerSecondFlowUnit, true
 	case "Flow->f3s":
-		return CubicFeetPerSecondFlowUnit
+		return CubicFeetPerSecondFlowUnit, true
 	case "Flow->f^3/s":
-		return CubicFeetPerSecondFlowUnit
+		return CubicFeetPerSecondFlowUnit, true
 	case "Flow->f^3s":
-		return CubicFeetPerSecondFlowUnit
+		return CubicFeetPerSecondFlowUnit, true
 	case "Flow->cubicfeetpersecond":
-		return CubicFeetPerSecondFlowUnit
+		return CubicFeetPerSecondFlowUnit, true
 	case "Flow->cubicfootpersecond":
-		return CubicFeetPerSecondFlowUnit
+		return CubicFeetPerSecondFlowUnit, true
 	case "Flow->cubicfeet/second":
-		return CubicFeetPerSecondFlowUnit
+		return CubicFeetPerSecondFlowUnit, true
 	case "Flow->cubicfoot/second":
-		return CubicFeetPerSecondFlowUnit
+		return CubicFeetPerSecondFlowUnit, true
 	case "Flow->mcfd":
-		return ThousandCubicFeetPerDayFlowUnit
+		return ThousandCubicFeetPerDayFlowUnit, true
 	case "Flow->mcf/d":
-		return ThousandCubicFeetPerDayFlowUnit
+		return ThousandCubicFeetPerDayFlowUnit, true
 	case "Flow->mcftd":
-		return ThousandCubicFeetPerDayFlowUnit
+		return ThousandCubicFeetPerDayFlowUnit, true
 	case "Flow->mcft/d":
-		return ThousandCubicFeetPerDayFlowUnit
+		return ThousandCubicFeetPerDayFlowUnit, true
 	case "Flow->mft³/d":
-		return ThousandCubicFeetPerDayFlowUnit
+		return ThousandCubicFeetPerDayFlowUnit, true
 	case "Flow->mft³d":
-		return ThousandCubicFeetPerDayFlowUnit
+		return ThousandCubicFeetPerDayFlowUnit, true
 	case "Flow->mft3/d":
-		return ThousandCubicFeetPerDayFlowUnit
+		return ThousandCubicFeetPerDayFlowUnit, true
 	case "Flow->mft3d":
-		return ThousandCubicFeetPerDayFlowUnit
+		return ThousandCubicFeetPerDayFlowUnit, true
 	case "Flow->mft^3/d":
-		return ThousandCubicFeetPerDayFlowUnit
+		return ThousandCubicFeetPerDayFlowUnit, true
 	case "Flow->mft^3d":
-		return ThousandCubicFeetPerDayFlowUnit
+		return ThousandCubicFeetPerDayFlowUnit, true
 	case "Flow->mf³/d":
-		return ThousandCubicFeetPerDayFlowUnit
+		return ThousandCubicFeetPerDayFlowUnit, true
 	case "Flow->mf³d":
-		return ThousandCubicFeetPerDayFlowUnit
+		return ThousandCubicFeetPerDayFlowUnit, true
 	case "Flow->mf3/d":
-		return ThousandCubicFeetPerDayFlowUnit
+		return ThousandCubicFeetPerDayFlowUnit, true
 	case "Flow->mf3d":
-		return ThousandCubicFeetPerDayFlowUnit
+		return ThousandCubicFeetPerDayFlowUnit, true
 	case "Flow->mf^3/d":
-		return ThousandCubicFeetPerDayFlowUnit
+		return ThousandCubicFeetPerDayFlowUnit, true
 	case "Flow->mf^3d":
-		return ThousandCubicFeetPerDayFlowUnit
+		return ThousandCubicFeetPerDayFlowUnit, true
 	case "Flow->thousandcubicfeetperday":
-		return ThousandCubicFeetPerDayFlowUnit
+		return ThousandCubicFeetPerDayFlowUnit, true
 	case "Flow->thousandcubicfeet/day":
-		return ThousandCubicFeetPerDayFlowUnit
+		return ThousandCubicFeetPerDayFlowUnit, true
 	case "Flow->gal/s":
-		return GallonsUSFluidPerSecondFlowUnit
+		return GallonsUSFluidPerSecondFlowUnit, true
 	case "Flow->gals/s":
-		return GallonsUSFluidPerSecondFlowUnit
+		return GallonsUSFluidPerSecondFlowUnit, true
 	case "Flow->gals":
-		return GallonsUSFluidPerSecondFlowUnit
+		return GallonsUSFluidPerSecondFlowUnit, true
 	case "Flow->galss":
-		return GallonsUSFluidPerSecondFlowUnit
+		return GallonsUSFluidPerSecondFlowUnit, true
 	case "Flow->gps":
-		return GallonsUSFluidPerSecondFlowUnit
+		return GallonsUSFluidPerSecondFlowUnit, true
 	case "Flow->gallonspersecond":
-		return GallonsUSFluidPerSecondFlowUnit
+		return GallonsUSFluidPerSecondFlowUnit, true
 	case "Flow->gallonpersecond":
-		return GallonsUSFluidPerSecondFlowUnit
+		return GallonsUSFluidPerSecondFlowUnit, true
 	case "Flow->gallons/second":
-		return GallonsUSFluidPerSecondFlowUnit
+		return GallonsUSFluidPerSecondFlowUnit, true
 	case "Flow->gallon/second":
-		return GallonsUSFluidPerSecondFlowUnit
+		return GallonsUSFluidPerSecondFlowUnit, true
+	case "Flow->gal/min":
+		return GallonsUSFluidPerMinuteFlowUnit, true
 	case "Flow->gal/m":
-		return GallonsUSFluidPerMinuteFlowUnit
+		return GallonsUSFluidPerMinuteFlowUnit, true
 	case "Flow->gals/m":
-		return GallonsUSFluidPerMinuteFlowUnit
+		return GallonsUSFluidPerMinuteFlowUnit, true
 	case "Flow->galm":
-		return GallonsUSFluidPerMinuteFlowUnit
+		return GallonsUSFluidPerMinuteFlowUnit, true
 	case "Flow->galsm":
-		return GallonsUSFluidPerMinuteFlowUnit
+		return GallonsUSFluidPerMinuteFlowUnit, true
 	case "Flow->gpm":
-		return GallonsUSFluidPerMinuteFlowUnit
+		return GallonsUSFluidPerMinuteFlowUnit, true
 	case "Flow->gallonsperminute":
-		return GallonsUSFluidPerMinuteFlowUnit
+		return GallonsUSFluidPerMinuteFlowUnit, true
 	case "Flow->gallonperminute":
-		return GallonsUSFluidPerMinuteFlowUnit
+		return GallonsUSFluidPerMinuteFlowUnit, true
 	case "Flow->gallonspermin":
-		return GallonsUSFluidPerMinuteFlowUnit
+		return GallonsUSFluidPerMinuteFlowUnit, true
 	case "Flow->gallonpermin":
-		return GallonsUSFluidPerMinuteFlowUnit
+		return GallonsUSFluidPerMinuteFlowUnit, true
 	case "Flow->gallons/minute":
-		return GallonsUSFluidPerMinuteFlowUnit
+		return GallonsUSFluidPerMinuteFlowUnit, true
 	case "Flow->gallons/min":
-		return GallonsUSFluidPerMinuteFlowUnit
+		return GallonsUSFluidPerMinuteFlowUnit, true
 	case "Flow->gallon/minute":
-		return GallonsUSFluidPerMinuteFlowUnit
+		return GallonsUSFluidPerMinuteFlowUnit, true
 	case "Flow->gallon/min":
-		return GallonsUSFluidPerMinuteFlowUnit
+		return GallonsUSFluidPerMinuteFlowUnit, true
 	case "Flow->bbl/s":
-		return BarrelsPerSecondFlowUnit
+		return BarrelsPerSecondFlowUnit, true
 	case "Flow->bbl/second":
-		return BarrelsPerSecondFlowUnit
+		return BarrelsPerSecondFlowUnit, true
 	case "Flow->bbls":
-		return BarrelsPerSecondFlowUnit
+		return BarrelsPerSecondFlowUnit, true
 	case "Flow->barrelpersecond":
-		return BarrelsPerSecondFlowUnit
+		return BarrelsPerSecondFlowUnit, true
 	case "Flow->barrelspersecond":
-		return BarrelsPerSecondFlowUnit
+		return BarrelsPerSecondFlowUnit, true
 	case "Flow->barrels/second":
-		return BarrelsPerSecondFlowUnit
+		return BarrelsPerSecondFlowUnit, true
 	case "Flow->barrel/second":
-		return BarrelsPerSecondFlowUnit
+		return BarrelsPerSecondFlowUnit, true
 	case "Flow->bbl/min":
-		return BarrelsPerMinuteFlowUnit
+		return BarrelsPerMinuteFlowUnit, true
 	case "Flow->bbl/minute":
-		return BarrelsPerMinuteFlowUnit
+		return BarrelsPerMinuteFlowUnit, true
 	case "Flow->bbl/m":
-		return BarrelsPerMinuteFlowUnit
+		return BarrelsPerMinuteFlowUnit, true
 	case "Flow->bblm":
-		return BarrelsPerMinuteFlowUnit
+		return BarrelsPerMinuteFlowUnit, true
 	case "Flow->barrelspermin":
-		return BarrelsPerMinuteFlowUnit
+		return BarrelsPerMinuteFlowUnit, true
 	case "Flow->barrelsperminute":
-		return BarrelsPerMinuteFlowUnit
+		return BarrelsPerMinuteFlowUnit, true
 	case "Flow->barrelpermin":
-		return BarrelsPerMinuteFlowUnit
+		return BarrelsPerMinuteFlowUnit, true
 	case "Flow->barrelperminute":
-		return BarrelsPerMinuteFlowUnit
+		return BarrelsPerMinuteFlowUnit, true
 	case "Flow->barrels/min":
-		return BarrelsPerMinuteFlowUnit
+		return BarrelsPerMinuteFlowUnit, true
 	case "Flow->barrels/minute":
-		return BarrelsPerMinuteFlowUnit
+		return BarrelsPerMinuteFlowUnit, true
 	case "Flow->barrel/min":
-		return BarrelsPerMinuteFlowUnit
+		return BarrelsPerMinuteFlowUnit, true
 	case "Flow->barrel/minute":
-		return BarrelsPerMinuteFlowUnit
+		return BarrelsPerMinuteFlowUnit, true
 	case "Volume->m³":
-		return CubicMetersVolumeUnit
+		return CubicMetersVolumeUnit, true
 	case "Volume->m3":
-		return CubicMetersVolumeUnit
+		return CubicMetersVolumeUnit, true
 	case "Volume->cubicmeter":
-		return CubicMetersVolumeUnit
+		return CubicMetersVolumeUnit, true
 	case "Volume->cubicmeters":
-		return CubicMetersVolumeUnit
+		return CubicMetersVolumeUnit, true
 	case "Volume->cuft":
-		return CubicFeetVolumeUnit
+		return CubicFeetVolumeUnit, true
 	case "Volume->ft³":
-		return CubicFeetVolumeUnit
+		return CubicFeetVolumeUnit, true
 	case "Volume->f³":
-		return CubicFeetVolumeUnit
+		return CubicFeetVolumeUnit, true
 	case "Volume->cubicfoot":
-		return CubicFeetVolumeUnit
+		return CubicFeetVolumeUnit, true
 	case "Volume->cubicfeet":
-		return CubicFeetVolumeUnit
+		return CubicFeetVolumeUnit, true
 	case "Volume->mcf":
-		return ThousandsOfCubicFeetVolumeUnit
+		return ThousandsOfCubicFeetVolumeUnit, true
 	case "Volume->mft³":
-		return ThousandsOfCubicFeetVolumeUnit
+		return ThousandsOfCubicFeetVolumeUnit, true
 	case "Volume->mf³":
-		return ThousandsOfCubicFeetVolumeUnit
+		return ThousandsOfCubicFeetVolumeUnit, true
 	case "Volume->thousandcubicfeet":
-		return ThousandsOfCubicFeetVolumeUnit
+		return ThousandsOfCubicFeetVolumeUnit, true
 	case "Volume->thousandsofcubicfeet":
-		return ThousandsOfCubicFeetVolumeUnit
+		return ThousandsOfCubicFeetVolumeUnit, true
 	case "Volume->thousandscubicfeet":
-		return ThousandsOfCubicFeetVolumeUnit
+		return ThousandsOfCubicFeetVolumeUnit, true
 	case "Volume->dm³":
-		return CubicDecimeterVolumeUnit
+		return CubicDecimeterVolumeUnit, true
 	case "Volume->dm3":
-		return CubicDecimeterVolumeUnit
+		return CubicDecimeterVolumeUnit, true
 	case "Volume->cubicdecimeter":
-		return CubicDecimeterVolumeUnit
+		return CubicDecimeterVolumeUnit, true
 	case "Volume->cubicdecimeters":
-		return CubicDecimeterVolumeUnit
+		return CubicDecimeterVolumeUnit, true
 	case "Volume->l":
-		return LiterVolumeUnit
+		return LiterVolumeUnit, true
 	case "Volume->liter":
-		return LiterVolumeUnit
+		return LiterVolumeUnit, true
 	case "Volume->liters":
-		return LiterVolumeUnit
+		return LiterVolumeUnit, true
 	case "Volume->litre":
-		return LiterVolumeUnit
+		return LiterVolumeUnit, true
 	case "Volume->litres":
-		return LiterVolumeUnit
+		return LiterVolumeUnit, true
 	case "Volume->gal":
-		return GallonUSFluidVolumeUnit
+		return GallonUSFluidVolumeUnit, true
 	case "Volume->gallon":
-		return GallonUSFluidVolumeUnit
+		return GallonUSFluidVolumeUnit, true
 	case "Volume->gals":
-		return GallonUSFluidVolumeUnit
+		return GallonUSFluidVolumeUnit, true
 	case "Volume->gallons":
-		return GallonUSFluidVolumeUnit
+		return GallonUSFluidVolumeUnit, true
 	case "Volume->gal(us)":
-		return GallonUSFluidVolumeUnit
+		return GallonUSFluidVolumeUnit, true
 	case "Volume->gallon(us)":
-		return GallonUSFluidVolumeUnit
+		return GallonUSFluidVolumeUnit, true
 	case "Volume->gals(us)":
-		return GallonUSFluidVolumeUnit
+		return GallonUSFluidVolumeUnit, true
 	case "Volume->gallons(us)":
-		return GallonUSFluidVolumeUnit
+		return GallonUSFluidVolumeUnit, true
 	case "Volume->gal(u.s.)":
-		return GallonUSFluidVolumeUnit
+		return GallonUSFluidVolumeUnit, true
 	case "Volume->gallon(u.s.)":
-		return GallonUSFluidVolumeUnit
+		return GallonUSFluidVolumeUnit, true
 	case "Volume->gals(u.s.)":
-		return GallonUSFluidVolumeUnit
+		return GallonUSFluidVolumeUnit, true
 	case "Volume->gallons(u.s.)":
-		return GallonUSFluidVolumeUnit
+		return GallonUSFluidVolumeUnit, true
 	case "Volume->gal(usfluid)":
-		return GallonUSFluidVolumeUnit
+		return GallonUSFluidVolumeUnit, true
 	case "Volume->gallon(usfluid)":
-		return GallonUSFluidVolumeUnit
+		return GallonUSFluidVolumeUnit, true
 	case "Volume->gals(usfluid)":
-		return GallonUSFluidVolumeUnit
+		return GallonUSFluidVolumeUnit, true
 	case "Volume->gallons(usfluid)":
-		return GallonUSFluidVolumeUnit
+		return GallonUSFluidVolumeUnit, true
 	case "Volume->gal(u.s.fluid)":
-		return GallonUSFluidVolumeUnit
+		return GallonUSFluidVolumeUnit, true
 	case "Volume->gallon(u.s.fluid)":
-		return GallonUSFluidVolumeUnit
+		return GallonUSFluidVolumeUnit, true
 	case "Volume->gals(u.s.fluid)":
-		return GallonUSFluidVolumeUnit
+		return GallonUSFluidVolumeUnit, true
 	case "Volume->gallons(u.s.fluid)":
-		return GallonUSFluidVolumeUnit
+		return GallonUSFluidVolumeUnit, true
 	case "Volume->bbl":
-		return BarrelsOfOilVolumeUnit
+		return BarrelsOfOilVolumeUnit, true
 	case "Volume->bbls":
-		return BarrelsOfOilVolumeUnit
+		return BarrelsOfOilVolumeUnit, true
 	case "Volume->barrelsofoil":
-		return BarrelsOfOilVolumeUnit
+		return BarrelsOfOilVolumeUnit, true
 	case "Volume->barrelofoil":
-		return BarrelsOfOilVolumeUnit
+		return BarrelsOfOilVolumeUnit, true
 	case "Mass->kg":
-		return KilogramsMassUnit
+		return KilogramsMassUnit, true
 	case "Mass->kilogram":
-		return KilogramsMassUnit
+		return KilogramsMassUnit, true
 	case "Mass->kilo":
-		return KilogramsMassUnit
+		return KilogramsMassUnit, true
 	case "Mass->kgs":
-		return KilogramsMassUnit
+		return KilogramsMassUnit, true
 	case "Mass->kilograms":
-		return KilogramsMassUnit
+		return KilogramsMassUnit, true
 	case "Mass->kilos":
-		return KilogramsMassUnit
+		return KilogramsMassUnit, true
 	case "Mass->lb":
-		return PoundsMassUnit
+		return PoundsMassUnit, true
 	case "Mass->lbs":
-		return PoundsMassUnit
+		return PoundsMassUnit, true
 	case "Mass->pound":
-		return PoundsMassUnit
+		return PoundsMassUnit, true
 	case "Mass->pounds":
-		return PoundsMassUnit
+		return PoundsMassUnit, true
 	case "MassFlow->kg/s":
-		return KilogramsPerSecondMassFlowUnit
+		return KilogramsPerSecondMassFlowUnit, true
 	case "MassFlow->kgs":
-		return KilogramsPerSecondMassFlowUnit
+		return KilogramsPerSecondMassFlowUnit, true
 	case "MassFlow->kilogrampersecond":
-		return KilogramsPerSecondMassFlowUnit
+		return KilogramsPerSecondMassFlowUnit, true
 	case "MassFlow->kilopersecond":
-		return KilogramsPerSecondMassFlowUnit
+		return KilogramsPerSecondMassFlowUnit, true
 	case "MassFlow->kgpersecond":
-		return KilogramsPerSecondMassFlowUnit
+		return KilogramsPerSecondMassFlowUnit, true
 	case "MassFlow->kilogramspersecond":
-		return KilogramsPerSecondMassFlowUnit
+		return KilogramsPerSecondMassFlowUnit, true
 	case "MassFlow->kilospersecond":
-		return KilogramsPerSecondMassFlowUnit
+		return KilogramsPerSecondMassFlowUnit, true
 	case "MassFlow->kgspersecond":
-		return KilogramsPerSecondMassFlowUnit
+		return KilogramsPerSecondMassFlowUnit, true
 	case "MassFlow->kilogram/second":
-		return KilogramsPerSecondMassFlowUnit
+		return KilogramsPerSecondMassFlowUnit, true
 	case "MassFlow->kilo/second":
-		return KilogramsPerSecondMassFlowUnit
+		return KilogramsPerSecondMassFlowUnit, true
 	case "MassFlow->kg/second":
-		return KilogramsPerSecondMassFlowUnit
+		return KilogramsPerSecondMassFlowUnit, true
 	case "MassFlow->kilograms/second":
-		return KilogramsPerSecondMassFlowUnit
+		return KilogramsPerSecondMassFlowUnit, true
 	case "MassFlow->kilos/second":
-		return KilogramsPerSecondMassFlowUnit
+		return KilogramsPerSecondMassFlowUnit, true
 	case "MassFlow->kgs/second":
-		return KilogramsPerSecondMassFlowUnit
+		return KilogramsPerSecondMassFlowUnit, true
 	case "MassFlow->lb/s":
-		return PoundsPerSecondMassFlowUnit
+		return PoundsPerSecondMassFlowUnit, true
 	case "MassFlow->lbs/s":
-		return PoundsPerSecondMassFlowUnit
+		return PoundsPerSecondMassFlowUnit, true
 	case "MassFlow->lbs":
-		return PoundsPerSecondMassFlowUnit
+		return PoundsPerSecondMassFlowUnit, true
 	case "MassFlow->lbss":
-		return PoundsPerSecondMassFlowUnit
+		return PoundsPerSecondMassFlowUnit, true
 	case "MassFlow->poundpersecond":
-		return PoundsPerSecondMassFlowUnit
+		return PoundsPerSecondMassFlowUnit, true
 	case "MassFlow->poundspersecond":
-		return PoundsPerSecondMassFlowUnit
+		return PoundsPerSecondMassFlowUnit, true
 	case "MassFlow->pound/second":
-		return PoundsPerSecondMassFlowUnit
+		return PoundsPerSecondMassFlowUnit, true
 	case "MassFlow->pounds/second":
-		return PoundsPerSecondMassFlowUnit
+		return PoundsPerSecondMassFlowUnit, true
 	case "MassFlow->lb/min":
-		return PoundsPerMinuteMassFlowUnit
+		return PoundsPerMinuteMassFlowUnit, true
 	case "MassFlow->lbs/min":
-		return PoundsPerMinuteMassFlowUnit
+		return PoundsPerMinuteMassFlowUnit, true
 	case "MassFlow->lbmin":
-		return PoundsPerMinuteMassFlowUnit
+		return PoundsPerMinuteMassFlowUnit, true
 	case "MassFlow->lbsmin":
-		return PoundsPerMinuteMassFlowUnit
+		return PoundsPerMinuteMassFlowUnit, true
 	case "MassFlow->lb/m":
-		return PoundsPerMinuteMassFlowUnit
+		return PoundsPerMinuteMassFlowUnit, true
 	case "MassFlow->lbs/m":
-		return PoundsPerMinuteMassFlowUnit
+		return PoundsPerMinuteMassFlowUnit, true
 	case "MassFlow->lbm":
-		return PoundsPerMinuteMassFlowUnit
+		return PoundsPerMinuteMassFlowUnit, true
 	case "MassFlow->lbsm":
-		return PoundsPerMinuteMassFlowUnit
+		return PoundsPerMinuteMassFlowUnit, true
 	case "MassFlow->poundperminute":
-		return PoundsPerMinuteMassFlowUnit
+		return PoundsPerMinuteMassFlowUnit, true
 	case "MassFlow->poundsperminute":
-		return PoundsPerMinuteMassFlowUnit
+		return PoundsPerMinuteMassFlowUnit, true
 	case "MassFlow->pound/minute":
-		return PoundsPerMinuteMassFlowUnit
+		return PoundsPerMinuteMassFlowUnit, true
 	case "MassFlow->pounds/minute":
-		return PoundsPerMinuteMassFlowUnit
+		return PoundsPerMinuteMassFlowUnit, true
 	case "MassFlow->poundpermin":
-		return PoundsPerMinuteMassFlowUnit
+		return PoundsPerMinuteMassFlowUnit, true
 	case "MassFlow->poundspermin":
-		return PoundsPerMinuteMassFlowUnit
+		return PoundsPerMinuteMassFlowUnit, true
 	case "MassFlow->pound/min":
-		return PoundsPerMinuteMassFlowUnit
+		return PoundsPerMinuteMassFlowUnit, true
 	case "MassFlow->pounds/min":
-		return PoundsPerMinuteMassFlowUnit
+		return PoundsPerMinuteMassFlowUnit, true
 	case "ElectricPotential->volt":
-		return VoltsElectricPotentialUnit
+		return VoltsElectricPotentialUnit, true
 	case "ElectricPotential->volts":
-		return VoltsElectricPotentialUnit
+		return VoltsElectricPotentialUnit, true
 	case "ElectricPotential->v":
-		return VoltsElectricPotentialUnit
+		return VoltsElectricPotentialUnit, true
+	case "ElectricPotential->millivolt":
+		return MillivoltsElectricPotentialUnit, true
+	case "ElectricPotential->millivolts":
+		return MillivoltsElectricPotentialUnit, true
+	case "ElectricPotential->kv":
+		return KilovoltsElectricPotentialUnit, true
+	case "ElectricPotential->kilovolt":
+		return KilovoltsElectricPotentialUnit, true
+	case "ElectricPotential->kilovolts":
+		return KilovoltsElectricPotentialUnit, true
 	case "ElectricPotentialLoaded->volt":
-		return VoltsElectricPotentialLoadedUnit
+		return VoltsElectricPotentialLoadedUnit, true
 	case "ElectricPotentialLoaded->volts":
-		return VoltsElectricPotentialLoadedUnit
+		return VoltsElectricPotentialLoadedUnit, true
 	case "ElectricPotentialLoaded->v":
-		return VoltsElectricPotentialLoadedUnit
+		return VoltsElectricPotentialLoadedUnit, true
+	case "ElectricPotentialLoaded->millivolt":
+		return MillivoltsElectricPotentialLoadedUnit, true
+	case "ElectricPotentialLoaded->millivolts":
+		return MillivoltsElectricPotentialLoadedUnit, true
+	case "ElectricPotentialLoaded->kv":
+		return KilovoltsElectricPotentialLoadedUnit, true
+	case "ElectricPotentialLoaded->kilovolt":
+		return KilovoltsElectricPotentialLoadedUnit, true
+	case "ElectricPotentialLoaded->kilovolts":
+		return KilovoltsElectricPotentialLoadedUnit, true
 	case "ElectricPotentialUnloaded->volt":
-		return VoltsElectricPotentialUnloadedUnit
+		return VoltsElectricPotentialUnloadedUnit, true
 	case "ElectricPotentialUnloaded->volts":
-		return VoltsElectricPotentialUnloadedUnit
+		return VoltsElectricPotentialUnloadedUnit, true
 	case "ElectricPotentialUnloaded->v":
-		return VoltsElectricPotentialUnloadedUnit
+		return VoltsElectricPotentialUnloadedUnit, true
+	case "ElectricPotentialUnloaded->millivolt":
+		return MillivoltsElectricPotentialUnloadedUnit, true
+	case "ElectricPotentialUnloaded->millivolts":
+		return MillivoltsElectricPotentialUnloadedUnit, true
+	case "ElectricPotentialUnloaded->kv":
+		return KilovoltsElectricPotentialUnloadedUnit, true
+	case "ElectricPotentialUnloaded->kilovolt":
+		return KilovoltsElectricPotentialUnloadedUnit, true
+	case "ElectricPotentialUnloaded->kilovolts":
+		return KilovoltsElectricPotentialUnloadedUnit, true
 	case "Percentage->%":
-		return PercentPercentageUnit
+		return PercentPercentageUnit, true
 	case "Percentage->percent":
-		return PercentPercentageUnit
+		return PercentPercentageUnit, true
 	case "Percentage->percentage":
-		return PercentPercentageUnit
+		return PercentPercentageUnit, true
 	case "Humidity->%":
-		return PercentHumidityUnit
+		return PercentHumidityUnit, true
 	case "Humidity->percent":
-		return PercentHumidityUnit
+		return PercentHumidityUnit, true
 	case "Humidity->percentage":
-		return PercentHumidityUnit
+		return PercentHumidityUnit, true
 	case "Alarm->%":
-		return PercentAlarmUnit
+		return PercentAlarmUnit, true
 	case "Alarm->percent":
-		return PercentAlarmUnit
+		return PercentAlarmUnit, true
 	case "Alarm->percentage":
-		return PercentAlarmUnit
+		return PercentAlarmUnit, true
 	case "Work->j":
-		return JoulesWorkUnit
+		return JoulesWorkUnit, true
 	case "Work->joule":
-		return JoulesWorkUnit
+		return JoulesWorkUnit, true
 	case "Work->joules":
-		return JoulesWorkUnit
+		return JoulesWorkUnit, true
+	case "Work->kj":
+		return KilojoulesWorkUnit, true
+	case "Work->kilojoule":
+		return KilojoulesWorkUnit, true
+	case "Work->kilojoules":
+		return KilojoulesWorkUnit, true
+	case "Work->megajoule":
+		return MegajoulesWorkUnit, true
+	case "Work->megajoules":
+		return MegajoulesWorkUnit, true
 	case "Work->inlbf":
-		return InchPoundsForceWorkUnit
+		return InchPoundsForceWorkUnit, true
 	case "Work->inch-poundsforce":
-		return InchPoundsForceWorkUnit
+		return InchPoundsForceWorkUnit, true
 	case "Work->inch-poundforce":
-		return InchPoundsForceWorkUnit
+		return InchPoundsForceWorkUnit, true
 	case "Work->in-lbf":
-		return InchPoundsForceWorkUnit
+		return InchPoundsForceWorkUnit, true
 	case "Work->btuᵢₜ":
-		return CubicFeetOfNaturalGasWorkUnit
+		return CubicFeetOfNaturalGasWorkUnit, true
 	case "Work->btuit":
-		return CubicFeetOfNaturalGasWorkUnit
+		return CubicFeetOfNaturalGasWorkUnit, true
 	case "Work->btu":
-		return CubicFeetOfNaturalGasWorkUnit
+		return CubicFeetOfNaturalGasWorkUnit, true
 	case "Work->cubicfeetofnaturalgas":
-		return CubicFeetOfNaturalGasWorkUnit
+		return CubicFeetOfNaturalGasWorkUnit, true
 	case "Work->bboe":
-		return BarrelsOfOilEquivalentWorkUnit
+		return BarrelsOfOilEquivalentWorkUnit, true
 	case "Work->barrelsofoilequivalent":
-		return BarrelsOfOilEquivalentWorkUnit
+		return BarrelsOfOilEquivalentWorkUnit, true
 	case "Force->n":
-		return NewtonsForceUnit
+		return NewtonsForceUnit, true
 	case "Force->newton":
-		return NewtonsForceUnit
+		return NewtonsForceUnit, true
 	case "Force->newtons":
-		return NewtonsForceUnit
+		return NewtonsForceUnit, true
+	case "Force->kn":
+		return KilonewtonsForceUnit, true
+	case "Force->kilonewton":
+		return KilonewtonsForceUnit, true
+	case "Force->kilonewtons":
+		return KilonewtonsForceUnit, true
 	case "Force->lbf":
-		return PoundsForceForceUnit
+		return PoundsForceForceUnit, true
 	case "Force->pounds-force":
-		return PoundsForceForceUnit
+		return PoundsForceForceUnit, true
 	case "Force->poundsforce":
-		return PoundsForceForceUnit
+		return PoundsForceForceUnit, true
 	case "Force->pound-force":
-		return PoundsForceForceUnit
+		return PoundsForceForceUnit, true
 	case "Force->poundforce":
-		return PoundsForceForceUnit
+		return PoundsForceForceUnit, true
 	case "Force->kgf":
-		return KilogramsForceForceUnit
+		return KilogramsForceForceUnit, true
 	case "Force->kilograms-force":
-		return KilogramsForceForceUnit
+		return KilogramsForceForceUnit, true
 	case "Force->kilogram-force":
-		return KilogramsForceForceUnit
+		return KilogramsForceForceUnit, true
 	case "Length->m":
-		return MetersLengthUnit
+		return MetersLengthUnit, true
 	case "Length->meter":
-		return MetersLengthUnit
+		return MetersLengthUnit, true
 	case "Length->meters":
-		return MetersLengthUnit
+		return MetersLengthUnit, true
+	case "Length->millimeter":
+		return MillimetersLengthUnit, true
+	case "Length->millimeters":
+		return MillimetersLengthUnit, true
+	case "Length->km":
+		return KilometersLengthUnit, true
+	case "Length->kilometer":
+		return KilometersLengthUnit, true
+	case "Length->kilometers":
+		return KilometersLengthUnit, true
 	case "Length->ft":
-		return FeetLengthUnit
+		return FeetLengthUnit, true
 	case "Length->foot":
-		return FeetLengthUnit
+		return FeetLengthUnit, true
 	case "Length->feet":
-		return FeetLengthUnit
+		return FeetLengthUnit, true
 	case "Length->in":
-		return InchesLengthUnit
+		return InchesLengthUnit, true
 	case "Length->inch":
-		return InchesLengthUnit
+		return InchesLengthUnit, true
 	case "Length->inches":
-		return InchesLengthUnit
+		return InchesLengthUnit, true
 	case "StrokeRate->strokes/s":
-		return StrokesPerSecondStrokeRateUnit
+		return StrokesPerSecondStrokeRateUnit, true
 	case "StrokeRate->strokespersecond":
-		return StrokesPerSecondStrokeRateUnit
+		return StrokesPerSecondStrokeRateUnit, true
 	case "StrokeRate->s/s":
-		return StrokesPerSecondStrokeRateUnit
+		return StrokesPerSecondStrokeRateUnit, true
+	case "Time->s":
+		return SecondsTimeUnit, true
+	case "Time->sec":
+		return SecondsTimeUnit, true
+	case "Time->secs":
+		return SecondsTimeUnit, true
+	case "Time->second":
+		return SecondsTimeUnit, true
+	case "Time->seconds":
+		return SecondsTimeUnit, true
+	case "Time->min":
+		return MinutesTimeUnit, true
+	case "Time->mins":
+		return MinutesTimeUnit, true
+	case "Time->minute":
+		return MinutesTimeUnit, true
+	case "Time->minutes":
+		return MinutesTimeUnit, true
+	case "Time->h":
+		return HoursTimeUnit, true
+	case "Time->hr":
+		return HoursTimeUnit, true
+	case "Time->hrs":
+		return HoursTimeUnit, true
+	case "Time->hour":
+		return HoursTimeUnit, true
+	case "Time->hours":
+		return HoursTimeUnit, true
+	case "Time->d":
+		return DaysTimeUnit, true
+	case "Time->day":
+		return DaysTimeUnit, true
+	case "Time->days":
+		return DaysTimeUnit, true
 	case "Number->number":
-		return NumberNumberUnit
+		return NumberNumberUnit, true
 	case "Number->*":
-		return NumberNumberUnit
+		return NumberNumberUnit, true
 	case "Overspeed->number":
-		return NumberOverspeedUnit
+		return NumberOverspeedUnit, true
 	case "Overspeed->*":
-		return NumberOverspeedUnit
+		return NumberOverspeedUnit, true
 	case "Underspeed->number":
-		return NumberUnderspeedUnit
+		return NumberUnderspeedUnit, true
 	case "Underspeed->*":
-		return NumberUnderspeedUnit
+		return NumberUnderspeedUnit, true
 	case "Totaliser->number":
-		return NumberTotaliserUnit
+		return NumberTotaliserUnit, true
 	case "Totaliser->*":
-		return NumberTotaliserUnit
+		return NumberTotaliserUnit, true
 	case "WMLFlowRate->number":
-		return NumberWMLFlowRateUnit
+		return NumberWMLFlowRateUnit, true
 	case "WMLFlowRate->*":
-		return NumberWMLFlowRateUnit
+		return NumberWMLFlowRateUnit, true
 	default:
-		return NumberNumberUnit
+		return DefaultRegistry.lookupUnit(input, typeOf)
 	}
 }
 
-// GetTypeUnit returns the unit type and unit which matches input or (NumberUnitType, NumberNumberUnit).
-// Opposite of AlakaTitle
-func GetTypeUnit(input string) (UnitType, Unit) {
+// LookupTypeUnit returns the unit type and unit which matches input or an *ErrUnknownUnit,
+// including those registered in DefaultRegistry. Opposite of AlakaTitle
+func LookupTypeUnit(input string) (UnitType, Unit, error) {
 	switch input {
 	case "Pressure_Pascals":
-		return PressureUnitType, PascalsPressureUnit
+		return PressureUnitType, PascalsPressureUnit, nil
 	case "Pressure_Kilopascals":
-		return PressureUnitType, KilopascalsPressureUnit
+		return PressureUnitType, KilopascalsPressureUnit, nil
 	case "Pressure_Megapascals":
-		return PressureUnitType, MegapascalsPressureUnit
+		return PressureUnitType, MegapascalsPressureUnit, nil
 	case "Pressure_PoundsPerSquareInch":
-		return PressureUnitType, PoundsPerSquareInchPressureUnit
+		return PressureUnitType, PoundsPerSquareInchPressureUnit, nil
 	case "Pressure_InchesOfWater":
-		return PressureUnitType, InchesOfWaterPressureUnit
+		return PressureUnitType, InchesOfWaterPressureUnit, nil
 	case "Temperature_DegreesCelsius":
-		return TemperatureUnitType, DegreesCelsiusTemperatureUnit
+		return TemperatureUnitType, DegreesCelsiusTemperatureUnit, nil
 	case "Temperature_DegreesFahrenheit":
-		return TemperatureUnitType, DegreesFahrenheitTemperatureUnit
+		return TemperatureUnitType, DegreesFahrenheitTemperatureUnit, nil
 	case "Temperature_Kelvins":
-		return TemperatureUnitType, KelvinsTemperatureUnit
+		return TemperatureUnitType, KelvinsTemperatureUnit, nil
+	case "TemperatureDifference_DegreesCelsius":
+		return TemperatureDifferenceUnitType, DegreesCelsiusTemperatureDifferenceUnit, nil
+	case "TemperatureDifference_DegreesFahrenheit":
+		return TemperatureDifferenceUnitType, DegreesFahrenheitTemperatureDifferenceUnit, nil
+	case "TemperatureDifference_Kelvins":
+		return TemperatureDifferenceUnitType, KelvinsTemperatureDifferenceUnit, nil
 	case "Flow_CubicMetersPerSecond":
-		return FlowUnitType, CubicMetersPerSecondFlowUnit
+		return FlowUnitType, CubicMetersPerSecondFlowUnit, nil
 	case "Flow_CubicFeetPerSecond":
-		return FlowUnitType, CubicFeetPerSecondFlowUnit
+		return FlowUnitType, CubicFeetPerSecondFlowUnit, nil
 	case "Flow_ThousandCubicFeetPerDay":
-		return FlowUnitType, ThousandCubicFeetPerDayFlowUnit
+		return FlowUnitType, ThousandCubicFeetPerDayFlowUnit, nil
 	case "Flow_GallonsUSFluidPerSecond":
-		return FlowUnitType, GallonsUSFluidPerSecondFlowUnit
+		return FlowUnitType, GallonsUSFluidPerSecondFlowUnit, nil
 	case "Flow_GallonsUSFluidPerMinute":
-		return FlowUnitType, GallonsUSFluidPerMinuteFlowUnit
+		return FlowUnitType, GallonsUSFluidPerMinuteFlowUnit, nil
 	case "Flow_BarrelsPerSecond":
-		return FlowUnitType, BarrelsPerSecondFlowUnit
+		return FlowUnitType, BarrelsPerSecondFlowUnit, nil
 	case "Flow_BarrelsPerMinute":
-		return FlowUnitType, BarrelsPerMinuteFlowUnit
+		return FlowUnitType, BarrelsPerMinuteFlowUnit, nil
 	case "Volume_CubicMeters":
-		return VolumeUnitType, CubicMetersVolumeUnit
+		return VolumeUnitType, CubicMetersVolumeUnit, nil
 	case "Volume_CubicFeet":
-		return VolumeUnitType, CubicFeetVolumeUnit
+		return VolumeUnitType, CubicFeetVolumeUnit, nil
 	case "Volume_ThousandsOfCubicFeet":
-		return VolumeUnitType, ThousandsOfCubicFeetVolumeUnit
+		return VolumeUnitType, ThousandsOfCubicFeetVolumeUnit, nil
 	case "Volume_CubicDecimeter":
-		return VolumeUnitType, CubicDecimeterVolumeUnit
+		return VolumeUnitType, CubicDecimeterVolumeUnit, nil
 	case "Volume_Liter":
-		return VolumeUnitType, LiterVolumeUnit
+		return VolumeUnitType, LiterVolumeUnit, nil
 	case "Volume_GallonUSFluid":
-		return VolumeUnitType, GallonUSFluidVolumeUnit
+		return VolumeUnitType, GallonUSFluidVolumeUnit, nil
 	case "Volume_BarrelsOfOil":
-		return VolumeUnitType, BarrelsOfOilVolumeUnit
+		return VolumeUnitType, BarrelsOfOilVolumeUnit, nil
 	case "Mass_Kilograms":
-		return MassUnitType, KilogramsMassUnit
+		return MassUnitType, KilogramsMassUnit, nil
 	case "Mass_Pounds":
-		return MassUnitType, PoundsMassUnit
+		return MassUnitType, PoundsMassUnit, nil
 	case "MassFlow_KilogramsPerSecond":
-		return MassFlowUnitType, KilogramsPerSecondMassFlowUnit
+		return MassFlowUnitType, KilogramsPerSecondMassFlowUnit, nil
 	case "MassFlow_PoundsPerSecond":
-		return MassFlowUnitType, PoundsPerSecondMassFlowUnit
+		return MassFlowUnitType, PoundsPerSecondMassFlowUnit, nil
 	case "MassFlow_PoundsPerMinute":
-		return MassFlowUnitType, PoundsPerMinuteMassFlowUnit
+		return MassFlowUnitType, PoundsPerMinuteMassFlowUnit, nil
 	case "ElectricPotential_Volts":
-		return ElectricPotentialUnitType, VoltsElectricPotentialUnit
+		return ElectricPotentialUnitType, VoltsElectricPotentialUnit, nil
+	case "ElectricPotential_Millivolts":
+		return ElectricPotentialUnitType, MillivoltsElectricPotentialUnit, nil
+	case "ElectricPotential_Kilovolts":
+		return ElectricPotentialUnitType, KilovoltsElectricPotentialUnit, nil
 	case "ElectricPotentialLoaded_Volts":
-		return ElectricPotentialLoadedUnitType, VoltsElectricPotentialLoadedUnit
+		return ElectricPotentialLoadedUnitType, VoltsElectricPotentialLoadedUnit, nil
+	case "ElectricPotentialLoaded_Millivolts":
+		return ElectricPotentialLoadedUnitType, MillivoltsElectricPotentialLoadedUnit, nil
+	case "ElectricPotentialLoaded_Kilovolts":
+		return ElectricPotentialLoadedUnitType, KilovoltsElectricPotentialLoadedUnit, nil
 	case "ElectricPotentialUnloaded_Volts":
-		return ElectricPotentialUnloadedUnitType, VoltsElectricPotentialUnloadedUnit
+		return ElectricPotentialUnloadedUnitType, VoltsElectricPotentialUnloadedUnit, nil
+	case "ElectricPotentialUnloaded_Millivolts":
+		return ElectricPotentialUnloadedUnitType, MillivoltsElectricPotentialUnloadedUnit, nil
+	case "ElectricPotentialUnloaded_Kilovolts":
+		return ElectricPotentialUnloadedUnitType, KilovoltsElectricPotentialUnloadedUnit, nil
 	case "Percentage_Percent":
-		return PercentageUnitType, PercentPercentageUnit
+		return PercentageUnitType, PercentPercentageUnit, nil
 	case "Humidity_Percent":
-		return HumidityUnitType, PercentHumidityUnit
+		return HumidityUnitType, PercentHumidityUnit, nil
 	case "Alarm_Percent":
-		return AlarmUnitType, PercentAlarmUnit
+		return AlarmUnitType, PercentAlarmUnit, nil
 	case "Work_Joules":
-		return WorkUnitType, JoulesWorkUnit
+		return WorkUnitType, JoulesWorkUnit, nil
+	case "Work_Kilojoules":
+		return WorkUnitType, KilojoulesWorkUnit, nil
+	case "Work_Megajoules":
+		return WorkUnitType, MegajoulesWorkUnit, nil
 	case "Work_InchPoundsForce":
-		return WorkUnitType, InchPoundsForceWorkUnit
+		return WorkUnitType, InchPoundsForceWorkUnit, nil
 	case "Work_CubicFeetOfNaturalGas":
-		return WorkUnitType, CubicFeetOfNaturalGasWorkUnit
+		return WorkUnitType, CubicFeetOfNaturalGasWorkUnit, nil
 	case "Work_BarrelsOfOilEquivalent":
-		return WorkUnitType, BarrelsOfOilEquivalentWorkUnit
+		return WorkUnitType, BarrelsOfOilEquivalentWorkUnit, nil
 	case "Force_Newtons":
-		return ForceUnitType, NewtonsForceUnit
+		return ForceUnitType, NewtonsForceUnit, nil
+	case "Force_Kilonewtons":
+		return ForceUnitType, KilonewtonsForceUnit, nil
 	case "Force_PoundsForce":
-		return ForceUnitType, PoundsForceForceUnit
+		return ForceUnitType, PoundsForceForceUnit, nil
 	case "Force_KilogramsForce":
-		return ForceUnitType, KilogramsForceForceUnit
+		return ForceUnitType, KilogramsForceForceUnit, nil
 	case "Length_Meters":
-		return LengthUnitType, MetersLengthUnit
+		return LengthUnitType, MetersLengthUnit, nil
+	case "Length_Millimeters":
+		return LengthUnitType, MillimetersLengthUnit, nil
+	case "Length_Kilometers":
+		return LengthUnitType, KilometersLengthUnit, nil
 	case "Length_Feet":
-		return LengthUnitType, FeetLengthUnit
+		return LengthUnitType, FeetLengthUnit, nil
 	case "Length_Inches":
-		return LengthUnitType, InchesLengthUnit
+		return LengthUnitType, InchesLengthUnit, nil
 	case "StrokeRate_StrokesPerSecond":
-		return StrokeRateUnitType, StrokesPerSecondStrokeRateUnit
+		return StrokeRateUnitType, StrokesPerSecondStrokeRateUnit, nil
+	case "Time_Seconds":
+		return TimeUnitType, SecondsTimeUnit, nil
+	case "Time_Minutes":
+		return TimeUnitType, MinutesTimeUnit, nil
+	case "Time_Hours":
+		return TimeUnitType, HoursTimeUnit, nil
+	case "Time_Days":
+		return TimeUnitType, DaysTimeUnit, nil
 	case "Number_Number":
-		return NumberUnitType, NumberNumberUnit
+		return NumberUnitType, NumberNumberUnit, nil
 	case "Overspeed_Number":
-		return OverspeedUnitType, NumberOverspeedUnit
+		return OverspeedUnitType, NumberOverspeedUnit, nil
 	case "Underspeed_Number":
-		return UnderspeedUnitType, NumberUnderspeedUnit
+		return UnderspeedUnitType, NumberUnderspeedUnit, nil
 	case "Totaliser_Number":
-		return TotaliserUnitType, NumberTotaliserUnit
+		return TotaliserUnitType, NumberTotaliserUnit, nil
 	case "WMLFlowRate_Number":
-		return WMLFlowRateUnitType, NumberWMLFlowRateUnit
+		return WMLFlowRateUnitType, NumberWMLFlowRateUnit, nil
 	default:
+		return DefaultRegistry.LookupTypeUnit(input)
+	}
+}
+
+// GetType returns the unit type which matches input or NumberUnitType
+func GetType(input string) UnitType {
+	ut, err := LookupType(input)
+	if err != nil {
+		return NumberUnitType
+	}
+	return ut
+}
+
+// GetUnit returns the unit which matches input or NumberNumberUnit
+func GetUnit(input string, typeOf UnitType) Unit {
+	if u, ok := findUnit(input, typeOf); ok {
+		return u
+	}
+	return NumberNumberUnit
+}
+
+// GetTypeUnit returns the unit type and unit which matches input or (NumberUnitType, NumberNumberUnit).
+// Opposite of AlakaTitle
+func GetTypeUnit(input string) (UnitType, Unit) {
+	ut, u, err := LookupTypeUnit(input)
+	if err != nil {
 		return NumberUnitType, NumberNumberUnit
 	}
+	return ut, u
+}
+
+// DifferenceType returns the unit type which measures differences between two values of ut,
+// or nil when ut is linear. Only unit types with an affine base, such as
+// Temperature, have a separate difference type.
+func DifferenceType(ut UnitType) UnitType {
+	switch ut.Title() {
+	case "Temperature":
+		return TemperatureDifferenceUnitType
+	default:
+		return nil
+	}
+}
+
+// DecodeUnitType returns the unit type whose Title is exactly text, as written by
+// AnyUnitType, or an *ErrUnknownType
+func DecodeUnitType(text []byte) (UnitType, error) {
+	switch string(text) {
+	case "Pressure":
+		return PressureUnitType, nil
+	case "Temperature":
+		return TemperatureUnitType, nil
+	case "TemperatureDifference":
+		return TemperatureDifferenceUnitType, nil
+	case "Flow":
+		return FlowUnitType, nil
+	case "Volume":
+		return VolumeUnitType, nil
+	case "Mass":
+		return MassUnitType, nil
+	case "MassFlow":
+		return MassFlowUnitType, nil
+	case "ElectricPotential":
+		return ElectricPotentialUnitType, nil
+	case "ElectricPotentialLoaded":
+		return ElectricPotentialLoadedUnitType, nil
+	case "ElectricPotentialUnloaded":
+		return ElectricPotentialUnloadedUnitType, nil
+	case "Percentage":
+		return PercentageUnitType, nil
+	case "Humidity":
+		return HumidityUnitType, nil
+	case "Alarm":
+		return AlarmUnitType, nil
+	case "Work":
+		return WorkUnitType, nil
+	case "Force":
+		return ForceUnitType, nil
+	case "Length":
+		return LengthUnitType, nil
+	case "StrokeRate":
+		return StrokeRateUnitType, nil
+	case "Time":
+		return TimeUnitType, nil
+	case "Number":
+		return NumberUnitType, nil
+	case "Overspeed":
+		return OverspeedUnitType, nil
+	case "Underspeed":
+		return UnderspeedUnitType, nil
+	case "Totaliser":
+		return TotaliserUnitType, nil
+	case "WMLFlowRate":
+		return WMLFlowRateUnitType, nil
+	default:
+		return DefaultRegistry.decodeUnitType(text)
+	}
+}
+
+// DecodeUnit returns the unit whose AlakaTitle is exactly text, as written by
+// AnyUnit, or an *ErrUnknownUnit
+func DecodeUnit(text []byte) (Unit, error) {
+	_, u, err := LookupTypeUnit(string(text))
+	return u, err
 }
 
 // Pressure (UnitType)
 // Contains 5 units:
-//  - PascalsPressure             Pa => Pa                 = Pa
-//  - KilopascalsPressure         Pa => Pa * 0.001         = kPa
-//  - MegapascalsPressure         Pa => Pa * 0.000,001     = MPa
-//  - PoundsPerSquareInchPressure Pa => Pa * 0.000,145,038 = psi
-//  - InchesOfWaterPressure       Pa => Pa * 0.004,014,74  = inH₂O
+//   - PascalsPressure             Pa => Pa                       = Pa
+//   - KilopascalsPressure         v => v * 0.001                 = kPa
+//   - MegapascalsPressure         v => v * 1e-06                 = MPa
+//   - PoundsPerSquareInchPressure v => v * 0.0001450377377302092 = psi
+//   - InchesOfWaterPressure       v => v * 0.00401474213311279   = inH₂O
+//
 // Base: PascalsPressure
 type Pressure float64
 
@@ -980,6 +1395,11 @@ func (x Pressure) Base() Unit {
 	return PascalsPressureUnit
 }
 
+// Dimension always returns Dimension{Mass: 1, Length: -1, Time: -2}
+func (x Pressure) Dimension() Dimension {
+	return Dimension{Mass: 1, Length: -1, Time: -2}
+}
+
 // PressureUnits is effectively a constant
 var PressureUnits = [...]Unit{PascalsPressureUnit, KilopascalsPressureUnit, MegapascalsPressureUnit, PoundsPerSquareInchPressureUnit, InchesOfWaterPressureUnit}
 
@@ -1023,7 +1443,7 @@ var PressureUnitType Pressure = 0.0
 // UnitType     : Pressure
 // UnitType.Base: PascalsPressure
 // Unit.FromBase: Pa => Pa = Pa
-// Unit.ToBase  : Pa => Pa = Pa
+// Unit.ToBase  : v => v   = Pa
 type PascalsPressure Pressure
 
 // Title always returns "Pascals"
@@ -1047,8 +1467,8 @@ func (x PascalsPressure) FromBase(Pa float64) float64 {
 }
 
 // ToBase converts Pa to Pa
-func (x PascalsPressure) ToBase(Pa float64) float64 {
-	return Pa
+func (x PascalsPressure) ToBase(v float64) float64 {
+	return v
 }
 
 // PascalsPressureMatchList is effectively a constant
@@ -1059,6 +1479,11 @@ func (x PascalsPressure) MatchList() []string {
 	return PascalsPressureMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x PascalsPressure) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -1082,13 +1507,19 @@ func (x PascalsPressure) Base() Unit {
 	return PascalsPressureUnit
 }
 
+// ExactFactor always returns 1 exactly
+func (x PascalsPressure) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1")
+	return r, true
+}
+
 var PascalsPressureUnit PascalsPressure = 0.0
 
 // KilopascalsPressure (Unit)
 // UnitType     : Pressure
 // UnitType.Base: PascalsPressure
-// Unit.FromBase: Pa => Pa * 0.001   = kPa
-// Unit.ToBase  : kPa => kPa * 1,000 = Pa
+// Unit.FromBase: v => v * 0.001 = kPa
+// Unit.ToBase  : v => v * 1000  = Pa
 type KilopascalsPressure Pressure
 
 // Title always returns "Kilopascals"
@@ -1107,13 +1538,13 @@ func (x KilopascalsPressure) Symbol() string {
 }
 
 // FromBase converts Pa to kPa
-func (x KilopascalsPressure) FromBase(Pa float64) float64 {
-	return Pa * 0.001
+func (x KilopascalsPressure) FromBase(v float64) float64 {
+	return v * 0.001
 }
 
 // ToBase converts kPa to Pa
-func (x KilopascalsPressure) ToBase(kPa float64) float64 {
-	return kPa * 1000
+func (x KilopascalsPressure) ToBase(v float64) float64 {
+	return v * 1000.0
 }
 
 // KilopascalsPressureMatchList is effectively a constant
@@ -1124,10 +1555,24 @@ func (x KilopascalsPressure) MatchList() []string {
 	return KilopascalsPressureMatchList[:]
 }
 
+// KilopascalsPressureExactMatchList is effectively a constant
+var KilopascalsPressureExactMatchList = [...]string{"kPa"}
+
+// ExactMatchList always returns KilopascalsPressureExactMatchList[:]
+func (x KilopascalsPressure) ExactMatchList() []string {
+	return KilopascalsPressureExactMatchList[:]
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
 func (x KilopascalsPressure) Matches(check string) bool {
+	exact := WhitespaceRegex.ReplaceAllString(check, "")
+	for _, m := range x.ExactMatchList() {
+		if m == exact {
+			return true
+		}
+	}
 	check = SanitizeString(check)
 	for _, m := range x.MatchList() {
 		if m == check || m == "*" {
@@ -1147,13 +1592,19 @@ func (x KilopascalsPressure) Base() Unit {
 	return PascalsPressureUnit
 }
 
+// ExactFactor always returns 1000 exactly
+func (x KilopascalsPressure) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1000")
+	return r, true
+}
+
 var KilopascalsPressureUnit KilopascalsPressure = 0.0
 
 // MegapascalsPressure (Unit)
 // UnitType     : Pressure
 // UnitType.Base: PascalsPressure
-// Unit.FromBase: Pa => Pa * 0.000,001   = MPa
-// Unit.ToBase  : MPa => MPa * 1,000,000 = Pa
+// Unit.FromBase: v => v * 1e-06 = MPa
+// Unit.ToBase  : v => v * 1e+06 = Pa
 type MegapascalsPressure Pressure
 
 // Title always returns "Megapascals"
@@ -1172,27 +1623,41 @@ func (x MegapascalsPressure) Symbol() string {
 }
 
 // FromBase converts Pa to MPa
-func (x MegapascalsPressure) FromBase(Pa float64) float64 {
-	return Pa * 0.000001
+func (x MegapascalsPressure) FromBase(v float64) float64 {
+	return v * 1e-06
 }
 
 // ToBase converts MPa to Pa
-func (x MegapascalsPressure) ToBase(MPa float64) float64 {
-	return MPa * 1000000
+func (x MegapascalsPressure) ToBase(v float64) float64 {
+	return v * 1e+06
 }
 
 // MegapascalsPressureMatchList is effectively a constant
-var MegapascalsPressureMatchList = [...]string{"mpa", "megapascal", "megapascals"}
+var MegapascalsPressureMatchList = [...]string{"megapascal", "megapascals"}
 
 // MatchList always returns MegapascalsPressureMatchList[:]
 func (x MegapascalsPressure) MatchList() []string {
 	return MegapascalsPressureMatchList[:]
 }
 
+// MegapascalsPressureExactMatchList is effectively a constant
+var MegapascalsPressureExactMatchList = [...]string{"MPa"}
+
+// ExactMatchList always returns MegapascalsPressureExactMatchList[:]
+func (x MegapascalsPressure) ExactMatchList() []string {
+	return MegapascalsPressureExactMatchList[:]
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
 func (x MegapascalsPressure) Matches(check string) bool {
+	exact := WhitespaceRegex.ReplaceAllString(check, "")
+	for _, m := range x.ExactMatchList() {
+		if m == exact {
+			return true
+		}
+	}
 	check = SanitizeString(check)
 	for _, m := range x.MatchList() {
 		if m == check || m == "*" {
@@ -1212,13 +1677,19 @@ func (x MegapascalsPressure) Base() Unit {
 	return PascalsPressureUnit
 }
 
+// ExactFactor always returns 1000000 exactly
+func (x MegapascalsPressure) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1000000")
+	return r, true
+}
+
 var MegapascalsPressureUnit MegapascalsPressure = 0.0
 
 // PoundsPerSquareInchPressure (Unit)
 // UnitType     : Pressure
 // UnitType.Base: PascalsPressure
-// Unit.FromBase: Pa => Pa * 0.000,145,038 = psi
-// Unit.ToBase  : psi => psi * 6,894.76    = Pa
+// Unit.FromBase: v => v * 0.0001450377377302092 = psi
+// Unit.ToBase  : v => v * 6894.757293168362     = Pa
 type PoundsPerSquareInchPressure Pressure
 
 // Title always returns "PoundsPerSquareInch"
@@ -1237,13 +1708,13 @@ func (x PoundsPerSquareInchPressure) Symbol() string {
 }
 
 // FromBase converts Pa to psi
-func (x PoundsPerSquareInchPressure) FromBase(Pa float64) float64 {
-	return Pa * 0.000145038
+func (x PoundsPerSquareInchPressure) FromBase(v float64) float64 {
+	return v * 0.0001450377377302092
 }
 
 // ToBase converts psi to Pa
-func (x PoundsPerSquareInchPressure) ToBase(psi float64) float64 {
-	return psi * 6894.76
+func (x PoundsPerSquareInchPressure) ToBase(v float64) float64 {
+	return v * 6894.757293168362
 }
 
 // PoundsPerSquareInchPressureMatchList is effectively a constant
@@ -1254,6 +1725,11 @@ func (x PoundsPerSquareInchPressure) MatchList() []string {
 	return PoundsPerSquareInchPressureMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x PoundsPerSquareInchPressure) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -1277,13 +1753,19 @@ func (x PoundsPerSquareInchPressure) Base() Unit {
 	return PascalsPressureUnit
 }
 
+// ExactFactor always returns 8896443230521/1290320000 exactly
+func (x PoundsPerSquareInchPressure) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("8896443230521/1290320000")
+	return r, true
+}
+
 var PoundsPerSquareInchPressureUnit PoundsPerSquareInchPressure = 0.0
 
 // InchesOfWaterPressure (Unit)
 // UnitType     : Pressure
 // UnitType.Base: PascalsPressure
-// Unit.FromBase: Pa => Pa * 0.004,014,74  = inH₂O
-// Unit.ToBase  : inH2O => inH2O * 249.082 = Pa
+// Unit.FromBase: v => v * 0.00401474213311279 = inH₂O
+// Unit.ToBase  : v => v * 249.082             = Pa
 type InchesOfWaterPressure Pressure
 
 // Title always returns "InchesOfWater"
@@ -1302,13 +1784,13 @@ func (x InchesOfWaterPressure) Symbol() string {
 }
 
 // FromBase converts Pa to inH₂O
-func (x InchesOfWaterPressure) FromBase(Pa float64) float64 {
-	return Pa * 0.00401474
+func (x InchesOfWaterPressure) FromBase(v float64) float64 {
+	return v * 0.00401474213311279
 }
 
 // ToBase converts inH₂O to Pa
-func (x InchesOfWaterPressure) ToBase(inH2O float64) float64 {
-	return inH2O * 249.082
+func (x InchesOfWaterPressure) ToBase(v float64) float64 {
+	return v * 249.082
 }
 
 // InchesOfWaterPressureMatchList is effectively a constant
@@ -1319,6 +1801,11 @@ func (x InchesOfWaterPressure) MatchList() []string {
 	return InchesOfWaterPressureMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x InchesOfWaterPressure) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -1342,13 +1829,20 @@ func (x InchesOfWaterPressure) Base() Unit {
 	return PascalsPressureUnit
 }
 
+// ExactFactor always returns 124541/500 exactly
+func (x InchesOfWaterPressure) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("124541/500")
+	return r, true
+}
+
 var InchesOfWaterPressureUnit InchesOfWaterPressure = 0.0
 
 // Temperature (UnitType)
 // Contains 3 units:
-//  - DegreesCelsiusTemperature    C => C                  = °C
-//  - DegreesFahrenheitTemperature C => (C * (9 / 5)) + 32 = °F
-//  - KelvinsTemperature           C => C + 273.15         = K
+//   - DegreesCelsiusTemperature    C => C              = °C
+//   - DegreesFahrenheitTemperature C => (C * 1.8) + 32 = °F
+//   - KelvinsTemperature           C => C + 273.15     = K
+//
 // Base: DegreesCelsiusTemperature
 type Temperature float64
 
@@ -1367,6 +1861,11 @@ func (x Temperature) Base() Unit {
 	return DegreesCelsiusTemperatureUnit
 }
 
+// Dimension always returns Dimension{Temperature: 1}
+func (x Temperature) Dimension() Dimension {
+	return Dimension{Temperature: 1}
+}
+
 // TemperatureUnits is effectively a constant
 var TemperatureUnits = [...]Unit{DegreesCelsiusTemperatureUnit, DegreesFahrenheitTemperatureUnit, KelvinsTemperatureUnit}
 
@@ -1410,7 +1909,7 @@ var TemperatureUnitType Temperature = 0.0
 // UnitType     : Temperature
 // UnitType.Base: DegreesCelsiusTemperature
 // Unit.FromBase: C => C = °C
-// Unit.ToBase  : C => C = °C
+// Unit.ToBase  : v => v = °C
 type DegreesCelsiusTemperature Temperature
 
 // Title always returns "DegreesCelsius"
@@ -1434,8 +1933,8 @@ func (x DegreesCelsiusTemperature) FromBase(C float64) float64 {
 }
 
 // ToBase converts °C to °C
-func (x DegreesCelsiusTemperature) ToBase(C float64) float64 {
-	return C
+func (x DegreesCelsiusTemperature) ToBase(v float64) float64 {
+	return v
 }
 
 // DegreesCelsiusTemperatureMatchList is effectively a constant
@@ -1446,6 +1945,11 @@ func (x DegreesCelsiusTemperature) MatchList() []string {
 	return DegreesCelsiusTemperatureMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x DegreesCelsiusTemperature) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -1469,13 +1973,19 @@ func (x DegreesCelsiusTemperature) Base() Unit {
 	return DegreesCelsiusTemperatureUnit
 }
 
+// ExactFactor always returns 1 exactly
+func (x DegreesCelsiusTemperature) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1")
+	return r, true
+}
+
 var DegreesCelsiusTemperatureUnit DegreesCelsiusTemperature = 0.0
 
 // DegreesFahrenheitTemperature (Unit)
 // UnitType     : Temperature
 // UnitType.Base: DegreesCelsiusTemperature
-// Unit.FromBase: C => (C * (9 / 5)) + 32 = °F
-// Unit.ToBase  : F => (F - 32) * (5 / 9) = °C
+// Unit.FromBase: C => (C * 1.8) + 32 = °F
+// Unit.ToBase  : v => (v - 32) / 1.8 = °C
 type DegreesFahrenheitTemperature Temperature
 
 // Title always returns "DegreesFahrenheit"
@@ -1495,12 +2005,12 @@ func (x DegreesFahrenheitTemperature) Symbol() string {
 
 // FromBase converts °C to °F
 func (x DegreesFahrenheitTemperature) FromBase(C float64) float64 {
-	return (C * (9 / 5)) + 32
+	return (C * 1.8) + 32.0
 }
 
 // ToBase converts °F to °C
-func (x DegreesFahrenheitTemperature) ToBase(F float64) float64 {
-	return (F - 32) * (5 / 9)
+func (x DegreesFahrenheitTemperature) ToBase(v float64) float64 {
+	return (v - 32.0) / 1.8
 }
 
 // DegreesFahrenheitTemperatureMatchList is effectively a constant
@@ -1511,6 +2021,11 @@ func (x DegreesFahrenheitTemperature) MatchList() []string {
 	return DegreesFahrenheitTemperatureMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x DegreesFahrenheitTemperature) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -1534,13 +2049,18 @@ func (x DegreesFahrenheitTemperature) Base() Unit {
 	return DegreesCelsiusTemperatureUnit
 }
 
+// ExactFactor always returns false, the unit is affine
+func (x DegreesFahrenheitTemperature) ExactFactor() (*big.Rat, bool) {
+	return nil, false
+}
+
 var DegreesFahrenheitTemperatureUnit DegreesFahrenheitTemperature = 0.0
 
 // KelvinsTemperature (Unit)
 // UnitType     : Temperature
 // UnitType.Base: DegreesCelsiusTemperature
 // Unit.FromBase: C => C + 273.15 = K
-// Unit.ToBase  : K => K - 273.15 = °C
+// Unit.ToBase  : v => v - 273.15 = °C
 type KelvinsTemperature Temperature
 
 // Title always returns "Kelvins"
@@ -1564,8 +2084,8 @@ func (x KelvinsTemperature) FromBase(C float64) float64 {
 }
 
 // ToBase converts K to °C
-func (x KelvinsTemperature) ToBase(K float64) float64 {
-	return K - 273.15
+func (x KelvinsTemperature) ToBase(v float64) float64 {
+	return v - 273.15
 }
 
 // KelvinsTemperatureMatchList is effectively a constant
@@ -1576,6 +2096,11 @@ func (x KelvinsTemperature) MatchList() []string {
 	return KelvinsTemperatureMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x KelvinsTemperature) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -1599,63 +2124,70 @@ func (x KelvinsTemperature) Base() Unit {
 	return DegreesCelsiusTemperatureUnit
 }
 
+// ExactFactor always returns false, the unit is affine
+func (x KelvinsTemperature) ExactFactor() (*big.Rat, bool) {
+	return nil, false
+}
+
 var KelvinsTemperatureUnit KelvinsTemperature = 0.0
 
-// Flow (UnitType)
-// Contains 7 units:
-//  - CubicMetersPerSecondFlow    m3s => m3s            = m³/s
-//  - CubicFeetPerSecondFlow      m3s => m3s * 35.314,7 = ft³/s
-//  - ThousandCubicFeetPerDayFlow m3s => m3s * 3,051.19 = MCFD
-//  - GallonsUSFluidPerSecondFlow m3s => m3s * 264.172  = gal/s
-//  - GallonsUSFluidPerMinuteFlow m3s => m3s * 15850.3  = gal/min
-//  - BarrelsPerSecondFlow        m3s => m3s * 6.289,81 = bbl/s
-//  - BarrelsPerMinuteFlow        m3s => m3s * 377.389  = bbl/min
-// Base: CubicMetersPerSecondFlow
-type Flow float64
+// TemperatureDifference (UnitType)
+// Contains 3 units:
+//   - DegreesCelsiusTemperatureDifference    C => C       = Δ°C
+//   - DegreesFahrenheitTemperatureDifference C => C * 1.8 = Δ°F
+//   - KelvinsTemperatureDifference           C => C       = ΔK
+//
+// Base: DegreesCelsiusTemperatureDifference
+type TemperatureDifference float64
 
-// Title always returns "Flow"
-func (x Flow) Title() string {
-	return "Flow"
+// Title always returns "TemperatureDifference"
+func (x TemperatureDifference) Title() string {
+	return "TemperatureDifference"
 }
 
-// Name always returns "Flow"
-func (x Flow) Name() string {
-	return "Flow"
+// Name always returns "Temperature Difference"
+func (x TemperatureDifference) Name() string {
+	return "Temperature Difference"
 }
 
-// Base always returns CubicMetersPerSecondFlowUnit
-func (x Flow) Base() Unit {
-	return CubicMetersPerSecondFlowUnit
+// Base always returns DegreesCelsiusTemperatureDifferenceUnit
+func (x TemperatureDifference) Base() Unit {
+	return DegreesCelsiusTemperatureDifferenceUnit
 }
 
-// FlowUnits is effectively a constant
-var FlowUnits = [...]Unit{CubicMetersPerSecondFlowUnit, CubicFeetPerSecondFlowUnit, ThousandCubicFeetPerDayFlowUnit, GallonsUSFluidPerSecondFlowUnit, GallonsUSFluidPerMinuteFlowUnit, BarrelsPerSecondFlowUnit, BarrelsPerMinuteFlowUnit}
+// Dimension always returns Dimension{Temperature: 1}
+func (x TemperatureDifference) Dimension() Dimension {
+	return Dimension{Temperature: 1}
+}
 
-// Units always returns FlowUnits[:]
-func (x Flow) Units() []Unit {
-	return FlowUnits[:]
+// TemperatureDifferenceUnits is effectively a constant
+var TemperatureDifferenceUnits = [...]Unit{DegreesCelsiusTemperatureDifferenceUnit, DegreesFahrenheitTemperatureDifferenceUnit, KelvinsTemperatureDifferenceUnit}
+
+// Units always returns TemperatureDifferenceUnits[:]
+func (x TemperatureDifference) Units() []Unit {
+	return TemperatureDifferenceUnits[:]
 }
 
-// FlowUnitList is effectively a constant
-var FlowUnitList = [...]string{"Cubic Meters per Second", "Cubic Feet per Second", "Thousand Cubic Feet per Day", "Gallons (U.S. Fluid) per Second", "Gallons (U.S. Fluid) per Minute", "Barrels per Second", "Barrels per Minute"}
+// TemperatureDifferenceUnitList is effectively a constant
+var TemperatureDifferenceUnitList = [...]string{"Degrees Celsius", "Degrees Fahrenheit", "Kelvins"}
 
-// UnitList always returns FlowUnitList[:]
-func (x Flow) UnitList() []string {
-	return FlowUnitList[:]
+// UnitList always returns TemperatureDifferenceUnitList[:]
+func (x TemperatureDifference) UnitList() []string {
+	return TemperatureDifferenceUnitList[:]
 }
 
-// FlowMatchList is effectively a constant
-var FlowMatchList = [...]string{"flow", "flowrate", "flow_rate", "gasflow", "gasflowrate", "gas_flow", "gas_flow_rate"}
+// TemperatureDifferenceMatchList is effectively a constant
+var TemperatureDifferenceMatchList = [...]string{"temperaturedifference", "tempdifference", "temperaturedelta", "tempdelta", "deltat", "δt"}
 
-// MatchList always returns FlowMatchList[:]
-func (x Flow) MatchList() []string {
-	return FlowMatchList[:]
+// MatchList always returns TemperatureDifferenceMatchList[:]
+func (x TemperatureDifference) MatchList() []string {
+	return TemperatureDifferenceMatchList[:]
 }
 
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
-func (x Flow) Matches(check string) bool {
+func (x TemperatureDifference) Matches(check string) bool {
 	check = SanitizeString(check)
 	for _, m := range x.MatchList() {
 		if m == check || m == "*" {
@@ -1665,52 +2197,57 @@ func (x Flow) Matches(check string) bool {
 	return false
 }
 
-var FlowUnitType Flow = 0.0
+var TemperatureDifferenceUnitType TemperatureDifference = 0.0
 
-// CubicMetersPerSecondFlow (Unit)
-// UnitType     : Flow
-// UnitType.Base: CubicMetersPerSecondFlow
-// Unit.FromBase: m3s => m3s = m³/s
-// Unit.ToBase  : m3s => m3s = m³/s
-type CubicMetersPerSecondFlow Flow
+// DegreesCelsiusTemperatureDifference (Unit)
+// UnitType     : TemperatureDifference
+// UnitType.Base: DegreesCelsiusTemperatureDifference
+// Unit.FromBase: C => C = Δ°C
+// Unit.ToBase  : v => v = Δ°C
+type DegreesCelsiusTemperatureDifference TemperatureDifference
 
-// Title always returns "CubicMetersPerSecond"
-func (x CubicMetersPerSecondFlow) Title() string {
-	return "CubicMetersPerSecond"
+// Title always returns "DegreesCelsius"
+func (x DegreesCelsiusTemperatureDifference) Title() string {
+	return "DegreesCelsius"
 }
 
-// Name always returns "Cubic Meters per Second"
-func (x CubicMetersPerSecondFlow) Name() string {
-	return "Cubic Meters per Second"
+// Name always returns "Degrees Celsius"
+func (x DegreesCelsiusTemperatureDifference) Name() string {
+	return "Degrees Celsius"
 }
 
-// Symbol always returns "m³/s"
-func (x CubicMetersPerSecondFlow) Symbol() string {
-	return "m³/s"
+// Symbol always returns "Δ°C"
+func (x DegreesCelsiusTemperatureDifference) Symbol() string {
+	return "Δ°C"
 }
 
-// FromBase converts m³/s to m³/s
-func (x CubicMetersPerSecondFlow) FromBase(m3s float64) float64 {
-	return m3s
+// FromBase converts Δ°C to Δ°C
+func (x DegreesCelsiusTemperatureDifference) FromBase(C float64) float64 {
+	return C
 }
 
-// ToBase converts m³/s to m³/s
-func (x CubicMetersPerSecondFlow) ToBase(m3s float64) float64 {
-	return m3s
+// ToBase converts Δ°C to Δ°C
+func (x DegreesCelsiusTemperatureDifference) ToBase(v float64) float64 {
+	return v
 }
 
-// CubicMetersPerSecondFlowMatchList is effectively a constant
-var CubicMetersPerSecondFlowMatchList = [...]string{"m³/s", "m³s", "m3/s", "m3s", "m^3/s", "m^3s", "cubicmeterspersecond", "cubicmeterpersecond", "cubicmeters/second", "cubicmeter/second"}
+// DegreesCelsiusTemperatureDifferenceMatchList is effectively a constant
+var DegreesCelsiusTemperatureDifferenceMatchList = [...]string{"δ°c", "δc", "deltac", "delta°c", "deltacelsius", "celsiusdifference", "degreescelsiusdifference"}
 
-// MatchList always returns CubicMetersPerSecondFlowMatchList[:]
-func (x CubicMetersPerSecondFlow) MatchList() []string {
-	return CubicMetersPerSecondFlowMatchList[:]
+// MatchList always returns DegreesCelsiusTemperatureDifferenceMatchList[:]
+func (x DegreesCelsiusTemperatureDifference) MatchList() []string {
+	return DegreesCelsiusTemperatureDifferenceMatchList[:]
+}
+
+// ExactMatchList always returns nil
+func (x DegreesCelsiusTemperatureDifference) ExactMatchList() []string {
+	return nil
 }
 
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
-func (x CubicMetersPerSecondFlow) Matches(check string) bool {
+func (x DegreesCelsiusTemperatureDifference) Matches(check string) bool {
 	check = SanitizeString(check)
 	for _, m := range x.MatchList() {
 		if m == check || m == "*" {
@@ -1720,62 +2257,73 @@ func (x CubicMetersPerSecondFlow) Matches(check string) bool {
 	return false
 }
 
-// TypeOf always returns FlowUnitType
-func (x CubicMetersPerSecondFlow) TypeOf() UnitType {
-	return FlowUnitType
+// TypeOf always returns TemperatureDifferenceUnitType
+func (x DegreesCelsiusTemperatureDifference) TypeOf() UnitType {
+	return TemperatureDifferenceUnitType
 }
 
-// Base always returns CubicMetersPerSecondFlowUnit
-func (x CubicMetersPerSecondFlow) Base() Unit {
-	return CubicMetersPerSecondFlowUnit
+// Base always returns DegreesCelsiusTemperatureDifferenceUnit
+func (x DegreesCelsiusTemperatureDifference) Base() Unit {
+	return DegreesCelsiusTemperatureDifferenceUnit
 }
 
-var CubicMetersPerSecondFlowUnit CubicMetersPerSecondFlow = 0.0
+// ExactFactor always returns 1 exactly
+func (x DegreesCelsiusTemperatureDifference) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1")
+	return r, true
+}
 
-// CubicFeetPerSecondFlow (Unit)
-// UnitType     : Flow
-// UnitType.Base: CubicMetersPerSecondFlow
-// Unit.FromBase: m3s => m3s * 35.314,7      = ft³/s
-// Unit.ToBase  : ft3s => ft3s * 0.028,316,8 = m³/s
-type CubicFeetPerSecondFlow Flow
+var DegreesCelsiusTemperatureDifferenceUnit DegreesCelsiusTemperatureDifference = 0.0
 
-// Title always returns "CubicFeetPerSecond"
-func (x CubicFeetPerSecondFlow) Title() string {
-	return "CubicFeetPerSecond"
+// DegreesFahrenheitTemperatureDifference (Unit)
+// UnitType     : TemperatureDifference
+// UnitType.Base: DegreesCelsiusTemperatureDifference
+// Unit.FromBase: C => C * 1.8                = Δ°F
+// Unit.ToBase  : v => v * 0.5555555555555556 = Δ°C
+type DegreesFahrenheitTemperatureDifference TemperatureDifference
+
+// Title always returns "DegreesFahrenheit"
+func (x DegreesFahrenheitTemperatureDifference) Title() string {
+	return "DegreesFahrenheit"
 }
 
-// Name always returns "Cubic Feet per Second"
-func (x CubicFeetPerSecondFlow) Name() string {
-	return "Cubic Feet per Second"
+// Name always returns "Degrees Fahrenheit"
+func (x DegreesFahrenheitTemperatureDifference) Name() string {
+	return "Degrees Fahrenheit"
 }
 
-// Symbol always returns "ft³/s"
-func (x CubicFeetPerSecondFlow) Symbol() string {
-	return "ft³/s"
+// Symbol always returns "Δ°F"
+func (x DegreesFahrenheitTemperatureDifference) Symbol() string {
+	return "Δ°F"
 }
 
-// FromBase converts m³/s to ft³/s
-func (x CubicFeetPerSecondFlow) FromBase(m3s float64) float64 {
-	return m3s * 35.3147
+// FromBase converts Δ°C to Δ°F
+func (x DegreesFahrenheitTemperatureDifference) FromBase(C float64) float64 {
+	return C * 1.8
 }
 
-// ToBase converts ft³/s to m³/s
-func (x CubicFeetPerSecondFlow) ToBase(ft3s float64) float64 {
-	return ft3s * 0.0283168
+// ToBase converts Δ°F to Δ°C
+func (x DegreesFahrenheitTemperatureDifference) ToBase(v float64) float64 {
+	return v * 0.5555555555555556
 }
 
-// CubicFeetPerSecondFlowMatchList is effectively a constant
-var CubicFeetPerSecondFlowMatchList = [...]string{"ft³/s", "ft³s", "ft3/s", "ft3s", "ft^3/s", "ft^3s", "f³/s", "f³s", "f3/s", "f3s", "f^3/s", "f^3s", "cubicfeetpersecond", "cubicfootpersecond", "cubicfeet/second", "cubicfoot/second"}
+// DegreesFahrenheitTemperatureDifferenceMatchList is effectively a constant
+var DegreesFahrenheitTemperatureDifferenceMatchList = [...]string{"δ°f", "δf", "deltaf", "delta°f", "deltafahrenheit", "fahrenheitdifference", "degreesfahrenheitdifference"}
 
-// MatchList always returns CubicFeetPerSecondFlowMatchList[:]
-func (x CubicFeetPerSecondFlow) MatchList() []string {
-	return CubicFeetPerSecondFlowMatchList[:]
+// MatchList always returns DegreesFahrenheitTemperatureDifferenceMatchList[:]
+func (x DegreesFahrenheitTemperatureDifference) MatchList() []string {
+	return DegreesFahrenheitTemperatureDifferenceMatchList[:]
+}
+
+// ExactMatchList always returns nil
+func (x DegreesFahrenheitTemperatureDifference) ExactMatchList() []string {
+	return nil
 }
 
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
-func (x CubicFeetPerSecondFlow) Matches(check string) bool {
+func (x DegreesFahrenheitTemperatureDifference) Matches(check string) bool {
 	check = SanitizeString(check)
 	for _, m := range x.MatchList() {
 		if m == check || m == "*" {
@@ -1785,62 +2333,73 @@ func (x CubicFeetPerSecondFlow) Matches(check string) bool {
 	return false
 }
 
-// TypeOf always returns FlowUnitType
-func (x CubicFeetPerSecondFlow) TypeOf() UnitType {
-	return FlowUnitType
+// TypeOf always returns TemperatureDifferenceUnitType
+func (x DegreesFahrenheitTemperatureDifference) TypeOf() UnitType {
+	return TemperatureDifferenceUnitType
 }
 
-// Base always returns CubicMetersPerSecondFlowUnit
-func (x CubicFeetPerSecondFlow) Base() Unit {
-	return CubicMetersPerSecondFlowUnit
+// Base always returns DegreesCelsiusTemperatureDifferenceUnit
+func (x DegreesFahrenheitTemperatureDifference) Base() Unit {
+	return DegreesCelsiusTemperatureDifferenceUnit
 }
 
-var CubicFeetPerSecondFlowUnit CubicFeetPerSecondFlow = 0.0
+// ExactFactor always returns 5/9 exactly
+func (x DegreesFahrenheitTemperatureDifference) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("5/9")
+	return r, true
+}
 
-// ThousandCubicFeetPerDayFlow (Unit)
-// UnitType     : Flow
-// UnitType.Base: CubicMetersPerSecondFlow
-// Unit.FromBase: m3s => m3s * 3,051.19        = MCFD
-// Unit.ToBase  : MCFD => MCFD * 0.000,327,741 = m³/s
-type ThousandCubicFeetPerDayFlow Flow
+var DegreesFahrenheitTemperatureDifferenceUnit DegreesFahrenheitTemperatureDifference = 0.0
 
-// Title always returns "ThousandCubicFeetPerDay"
-func (x ThousandCubicFeetPerDayFlow) Title() string {
-	return "ThousandCubicFeetPerDay"
+// KelvinsTemperatureDifference (Unit)
+// UnitType     : TemperatureDifference
+// UnitType.Base: DegreesCelsiusTemperatureDifference
+// Unit.FromBase: C => C = ΔK
+// Unit.ToBase  : v => v = Δ°C
+type KelvinsTemperatureDifference TemperatureDifference
+
+// Title always returns "Kelvins"
+func (x KelvinsTemperatureDifference) Title() string {
+	return "Kelvins"
 }
 
-// Name always returns "Thousand Cubic Feet per Day"
-func (x ThousandCubicFeetPerDayFlow) Name() string {
-	return "Thousand Cubic Feet per Day"
+// Name always returns "Kelvins"
+func (x KelvinsTemperatureDifference) Name() string {
+	return "Kelvins"
 }
 
-// Symbol always returns "MCFD"
-func (x ThousandCubicFeetPerDayFlow) Symbol() string {
-	return "MCFD"
+// Symbol always returns "ΔK"
+func (x KelvinsTemperatureDifference) Symbol() string {
+	return "ΔK"
 }
 
-// FromBase converts m³/s to MCFD
-func (x ThousandCubicFeetPerDayFlow) FromBase(m3s float64) float64 {
-	return m3s * 3051.19
+// FromBase converts Δ°C to ΔK
+func (x KelvinsTemperatureDifference) FromBase(C float64) float64 {
+	return C
 }
 
-// ToBase converts MCFD to m³/s
-func (x ThousandCubicFeetPerDayFlow) ToBase(MCFD float64) float64 {
-	return MCFD * 0.000327741
+// ToBase converts ΔK to Δ°C
+func (x KelvinsTemperatureDifference) ToBase(v float64) float64 {
+	return v
 }
 
-// ThousandCubicFeetPerDayFlowMatchList is effectively a constant
-var ThousandCubicFeetPerDayFlowMatchList = [...]string{"mcfd", "mcf/d", "mcftd", "mcft/d", "mft³/d", "mft³d", "mft3/d", "mft3d", "mft^3/d", "mft^3d", "mf³/d", "mf³d", "mf3/d", "mf3d", "mf^3/d", "mf^3d", "thousandcubicfeetperday", "thousandcubicfeet/day"}
+// KelvinsTemperatureDifferenceMatchList is effectively a constant
+var KelvinsTemperatureDifferenceMatchList = [...]string{"δk", "deltak", "deltakelvin", "kelvindifference", "kelvinsdifference"}
 
-// MatchList always returns ThousandCubicFeetPerDayFlowMatchList[:]
-func (x ThousandCubicFeetPerDayFlow) MatchList() []string {
-	return ThousandCubicFeetPerDayFlowMatchList[:]
+// MatchList always returns KelvinsTemperatureDifferenceMatchList[:]
+func (x KelvinsTemperatureDifference) MatchList() []string {
+	return KelvinsTemperatureDifferenceMatchList[:]
+}
+
+// ExactMatchList always returns nil
+func (x KelvinsTemperatureDifference) ExactMatchList() []string {
+	return nil
 }
 
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
-func (x ThousandCubicFeetPerDayFlow) Matches(check string) bool {
+func (x KelvinsTemperatureDifference) Matches(check string) bool {
 	check = SanitizeString(check)
 	for _, m := range x.MatchList() {
 		if m == check || m == "*" {
@@ -1850,28 +2409,334 @@ func (x ThousandCubicFeetPerDayFlow) Matches(check string) bool {
 	return false
 }
 
-// TypeOf always returns FlowUnitType
-func (x ThousandCubicFeetPerDayFlow) TypeOf() UnitType {
-	return FlowUnitType
+// TypeOf always returns TemperatureDifferenceUnitType
+func (x KelvinsTemperatureDifference) TypeOf() UnitType {
+	return TemperatureDifferenceUnitType
 }
 
-// Base always returns CubicMetersPerSecondFlowUnit
-func (x ThousandCubicFeetPerDayFlow) Base() Unit {
-	return CubicMetersPerSecondFlowUnit
+// Base always returns DegreesCelsiusTemperatureDifferenceUnit
+func (x KelvinsTemperatureDifference) Base() Unit {
+	return DegreesCelsiusTemperatureDifferenceUnit
 }
 
-var ThousandCubicFeetPerDayFlowUnit ThousandCubicFeetPerDayFlow = 0.0
-
-// GallonsUSFluidPerSecondFlow (Unit)
-// UnitType     : Flow
-// UnitType.Base: CubicMetersPerSecondFlow
-// Unit.FromBase: m3s => m3s * 264.172        = gal/s
-// Unit.ToBase  : gals => gals * 0.003,785,41 = m³/s
-type GallonsUSFluidPerSecondFlow Flow
-
-// Title always returns "GallonsUSFluidPerSecond"
-func (x GallonsUSFluidPerSecondFlow) Title() string {
-	return "GallonsUSFluidPerSecond"
+// ExactFactor always returns 1 exactly
+func (x KelvinsTemperatureDifference) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1")
+	return r, true
+}
+
+var KelvinsTemperatureDifferenceUnit KelvinsTemperatureDifference = 0.0
+
+// Flow (UnitType)
+// Contains 7 units:
+//   - CubicMetersPerSecondFlow    m3s => m3s                  = m³/s
+//   - CubicFeetPerSecondFlow      v => v * 35.31466672148859  = ft³/s
+//   - ThousandCubicFeetPerDayFlow v => v * 3051.187204736614  = MCFD
+//   - GallonsUSFluidPerSecondFlow v => v * 264.1720523581484  = gal/s
+//   - GallonsUSFluidPerMinuteFlow v => v * 15850.323141488905 = gal/min
+//   - BarrelsPerSecondFlow        v => v * 6.289810770432105  = bbl/s
+//   - BarrelsPerMinuteFlow        v => v * 377.3886462259263  = bbl/min
+//
+// Base: CubicMetersPerSecondFlow
+type Flow float64
+
+// Title always returns "Flow"
+func (x Flow) Title() string {
+	return "Flow"
+}
+
+// Name always returns "Flow"
+func (x Flow) Name() string {
+	return "Flow"
+}
+
+// Base always returns CubicMetersPerSecondFlowUnit
+func (x Flow) Base() Unit {
+	return CubicMetersPerSecondFlowUnit
+}
+
+// Dimension always returns Dimension{Length: 3, Time: -1}
+func (x Flow) Dimension() Dimension {
+	return Dimension{Length: 3, Time: -1}
+}
+
+// FlowUnits is effectively a constant
+var FlowUnits = [...]Unit{CubicMetersPerSecondFlowUnit, CubicFeetPerSecondFlowUnit, ThousandCubicFeetPerDayFlowUnit, GallonsUSFluidPerSecondFlowUnit, GallonsUSFluidPerMinuteFlowUnit, BarrelsPerSecondFlowUnit, BarrelsPerMinuteFlowUnit}
+
+// Units always returns FlowUnits[:]
+func (x Flow) Units() []Unit {
+	return FlowUnits[:]
+}
+
+// FlowUnitList is effectively a constant
+var FlowUnitList = [...]string{"Cubic Meters per Second", "Cubic Feet per Second", "Thousand Cubic Feet per Day", "Gallons (U.S. Fluid) per Second", "Gallons (U.S. Fluid) per Minute", "Barrels per Second", "Barrels per Minute"}
+
+// UnitList always returns FlowUnitList[:]
+func (x Flow) UnitList() []string {
+	return FlowUnitList[:]
+}
+
+// FlowMatchList is effectively a constant
+var FlowMatchList = [...]string{"flow", "flowrate", "flow_rate", "gasflow", "gasflowrate", "gas_flow", "gas_flow_rate"}
+
+// MatchList always returns FlowMatchList[:]
+func (x Flow) MatchList() []string {
+	return FlowMatchList[:]
+}
+
+// Matches returns true if check matches our possible names.
+// Helpful when a user is allowed to enter in unit types
+// freehand, for example.
+func (x Flow) Matches(check string) bool {
+	check = SanitizeString(check)
+	for _, m := range x.MatchList() {
+		if m == check || m == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+var FlowUnitType Flow = 0.0
+
+// CubicMetersPerSecondFlow (Unit)
+// UnitType     : Flow
+// UnitType.Base: CubicMetersPerSecondFlow
+// Unit.FromBase: m3s => m3s = m³/s
+// Unit.ToBase  : v => v     = m³/s
+type CubicMetersPerSecondFlow Flow
+
+// Title always returns "CubicMetersPerSecond"
+func (x CubicMetersPerSecondFlow) Title() string {
+	return "CubicMetersPerSecond"
+}
+
+// Name always returns "Cubic Meters per Second"
+func (x CubicMetersPerSecondFlow) Name() string {
+	return "Cubic Meters per Second"
+}
+
+// Symbol always returns "m³/s"
+func (x CubicMetersPerSecondFlow) Symbol() string {
+	return "m³/s"
+}
+
+// FromBase converts m³/s to m³/s
+func (x CubicMetersPerSecondFlow) FromBase(m3s float64) float64 {
+	return m3s
+}
+
+// ToBase converts m³/s to m³/s
+func (x CubicMetersPerSecondFlow) ToBase(v float64) float64 {
+	return v
+}
+
+// CubicMetersPerSecondFlowMatchList is effectively a constant
+var CubicMetersPerSecondFlowMatchList = [...]string{"m³/s", "m³s", "m3/s", "m3s", "m^3/s", "m^3s", "cubicmeterspersecond", "cubicmeterpersecond", "cubicmeters/second", "cubicmeter/second"}
+
+// MatchList always returns CubicMetersPerSecondFlowMatchList[:]
+func (x CubicMetersPerSecondFlow) MatchList() []string {
+	return CubicMetersPerSecondFlowMatchList[:]
+}
+
+// ExactMatchList always returns nil
+func (x CubicMetersPerSecondFlow) ExactMatchList() []string {
+	return nil
+}
+
+// Matches returns true if check matches our possible names.
+// Helpful when a user is allowed to enter in unit types
+// freehand, for example.
+func (x CubicMetersPerSecondFlow) Matches(check string) bool {
+	check = SanitizeString(check)
+	for _, m := range x.MatchList() {
+		if m == check || m == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// TypeOf always returns FlowUnitType
+func (x CubicMetersPerSecondFlow) TypeOf() UnitType {
+	return FlowUnitType
+}
+
+// Base always returns CubicMetersPerSecondFlowUnit
+func (x CubicMetersPerSecondFlow) Base() Unit {
+	return CubicMetersPerSecondFlowUnit
+}
+
+// ExactFactor always returns 1 exactly
+func (x CubicMetersPerSecondFlow) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1")
+	return r, true
+}
+
+var CubicMetersPerSecondFlowUnit CubicMetersPerSecondFlow = 0.0
+
+// CubicFeetPerSecondFlow (Unit)
+// UnitType     : Flow
+// UnitType.Base: CubicMetersPerSecondFlow
+// Unit.FromBase: v => v * 35.31466672148859 = ft³/s
+// Unit.ToBase  : v => v * 0.028316846592    = m³/s
+type CubicFeetPerSecondFlow Flow
+
+// Title always returns "CubicFeetPerSecond"
+func (x CubicFeetPerSecondFlow) Title() string {
+	return "CubicFeetPerSecond"
+}
+
+// Name always returns "Cubic Feet per Second"
+func (x CubicFeetPerSecondFlow) Name() string {
+	return "Cubic Feet per Second"
+}
+
+// Symbol always returns "ft³/s"
+func (x CubicFeetPerSecondFlow) Symbol() string {
+	return "ft³/s"
+}
+
+// FromBase converts m³/s to ft³/s
+func (x CubicFeetPerSecondFlow) FromBase(v float64) float64 {
+	return v * 35.31466672148859
+}
+
+// ToBase converts ft³/s to m³/s
+func (x CubicFeetPerSecondFlow) ToBase(v float64) float64 {
+	return v * 0.028316846592
+}
+
+// CubicFeetPerSecondFlowMatchList is effectively a constant
+var CubicFeetPerSecondFlowMatchList = [...]string{"ft³/s", "ft³s", "ft3/s", "ft3s", "ft^3/s", "ft^3s", "f³/s", "f³s", "f3/s", "f3s", "f^3/s", "f^3s", "cubicfeetpersecond", "cubicfootpersecond", "cubicfeet/second", "cubicfoot/second"}
+
+// MatchList always returns CubicFeetPerSecondFlowMatchList[:]
+func (x CubicFeetPerSecondFlow) MatchList() []string {
+	return CubicFeetPerSecondFlowMatchList[:]
+}
+
+// ExactMatchList always returns nil
+func (x CubicFeetPerSecondFlow) ExactMatchList() []string {
+	return nil
+}
+
+// Matches returns true if check matches our possible names.
+// Helpful when a user is allowed to enter in unit types
+// freehand, for example.
+func (x CubicFeetPerSecondFlow) Matches(check string) bool {
+	check = SanitizeString(check)
+	for _, m := range x.MatchList() {
+		if m == check || m == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// TypeOf always returns FlowUnitType
+func (x CubicFeetPerSecondFlow) TypeOf() UnitType {
+	return FlowUnitType
+}
+
+// Base always returns CubicMetersPerSecondFlowUnit
+func (x CubicFeetPerSecondFlow) Base() Unit {
+	return CubicMetersPerSecondFlowUnit
+}
+
+// ExactFactor always returns 55306341/1953125000 exactly
+func (x CubicFeetPerSecondFlow) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("55306341/1953125000")
+	return r, true
+}
+
+var CubicFeetPerSecondFlowUnit CubicFeetPerSecondFlow = 0.0
+
+// ThousandCubicFeetPerDayFlow (Unit)
+// UnitType     : Flow
+// UnitType.Base: CubicMetersPerSecondFlow
+// Unit.FromBase: v => v * 3051.187204736614 = MCFD
+// Unit.ToBase  : v => v * 0.00032774128     = m³/s
+type ThousandCubicFeetPerDayFlow Flow
+
+// Title always returns "ThousandCubicFeetPerDay"
+func (x ThousandCubicFeetPerDayFlow) Title() string {
+	return "ThousandCubicFeetPerDay"
+}
+
+// Name always returns "Thousand Cubic Feet per Day"
+func (x ThousandCubicFeetPerDayFlow) Name() string {
+	return "Thousand Cubic Feet per Day"
+}
+
+// Symbol always returns "MCFD"
+func (x ThousandCubicFeetPerDayFlow) Symbol() string {
+	return "MCFD"
+}
+
+// FromBase converts m³/s to MCFD
+func (x ThousandCubicFeetPerDayFlow) FromBase(v float64) float64 {
+	return v * 3051.187204736614
+}
+
+// ToBase converts MCFD to m³/s
+func (x ThousandCubicFeetPerDayFlow) ToBase(v float64) float64 {
+	return v * 0.00032774128
+}
+
+// ThousandCubicFeetPerDayFlowMatchList is effectively a constant
+var ThousandCubicFeetPerDayFlowMatchList = [...]string{"mcfd", "mcf/d", "mcftd", "mcft/d", "mft³/d", "mft³d", "mft3/d", "mft3d", "mft^3/d", "mft^3d", "mf³/d", "mf³d", "mf3/d", "mf3d", "mf^3/d", "mf^3d", "thousandcubicfeetperday", "thousandcubicfeet/day"}
+
+// MatchList always returns ThousandCubicFeetPerDayFlowMatchList[:]
+func (x ThousandCubicFeetPerDayFlow) MatchList() []string {
+	return ThousandCubicFeetPerDayFlowMatchList[:]
+}
+
+// ExactMatchList always returns nil
+func (x ThousandCubicFeetPerDayFlow) ExactMatchList() []string {
+	return nil
+}
+
+// Matches returns true if check matches our possible names.
+// Helpful when a user is allowed to enter in unit types
+// freehand, for example.
+func (x ThousandCubicFeetPerDayFlow) Matches(check string) bool {
+	check = SanitizeString(check)
+	for _, m := range x.MatchList() {
+		if m == check || m == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// TypeOf always returns FlowUnitType
+func (x ThousandCubicFeetPerDayFlow) TypeOf() UnitType {
+	return FlowUnitType
+}
+
+// Base always returns CubicMetersPerSecondFlowUnit
+func (x ThousandCubicFeetPerDayFlow) Base() Unit {
+	return CubicMetersPerSecondFlowUnit
+}
+
+// ExactFactor always returns 2048383/6250000000 exactly
+func (x ThousandCubicFeetPerDayFlow) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("2048383/6250000000")
+	return r, true
+}
+
+var ThousandCubicFeetPerDayFlowUnit ThousandCubicFeetPerDayFlow = 0.0
+
+// GallonsUSFluidPerSecondFlow (Unit)
+// UnitType     : Flow
+// UnitType.Base: CubicMetersPerSecondFlow
+// Unit.FromBase: v => v * 264.1720523581484 = gal/s
+// Unit.ToBase  : v => v * 0.003785411784    = m³/s
+type GallonsUSFluidPerSecondFlow Flow
+
+// Title always returns "GallonsUSFluidPerSecond"
+func (x GallonsUSFluidPerSecondFlow) Title() string {
+	return "GallonsUSFluidPerSecond"
 }
 
 // Name always returns "Gallons (U.S. Fluid) per Second"
@@ -1885,13 +2750,13 @@ func (x GallonsUSFluidPerSecondFlow) Symbol() string {
 }
 
 // FromBase converts m³/s to gal/s
-func (x GallonsUSFluidPerSecondFlow) FromBase(m3s float64) float64 {
-	return m3s * 264.172
+func (x GallonsUSFluidPerSecondFlow) FromBase(v float64) float64 {
+	return v * 264.1720523581484
 }
 
 // ToBase converts gal/s to m³/s
-func (x GallonsUSFluidPerSecondFlow) ToBase(gals float64) float64 {
-	return gals * 0.00378541
+func (x GallonsUSFluidPerSecondFlow) ToBase(v float64) float64 {
+	return v * 0.003785411784
 }
 
 // GallonsUSFluidPerSecondFlowMatchList is effectively a constant
@@ -1902,6 +2767,11 @@ func (x GallonsUSFluidPerSecondFlow) MatchList() []string {
 	return GallonsUSFluidPerSecondFlowMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x GallonsUSFluidPerSecondFlow) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -1925,13 +2795,19 @@ func (x GallonsUSFluidPerSecondFlow) Base() Unit {
 	return CubicMetersPerSecondFlowUnit
 }
 
+// ExactFactor always returns 473176473/125000000000 exactly
+func (x GallonsUSFluidPerSecondFlow) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("473176473/125000000000")
+	return r, true
+}
+
 var GallonsUSFluidPerSecondFlowUnit GallonsUSFluidPerSecondFlow = 0.0
 
 // GallonsUSFluidPerMinuteFlow (Unit)
 // UnitType     : Flow
 // UnitType.Base: CubicMetersPerSecondFlow
-// Unit.FromBase: m3s => m3s * 15850.3           = gal/min
-// Unit.ToBase  : galm => galm * 0.000,063,090,2 = m³/s
+// Unit.FromBase: v => v * 15850.323141488905 = gal/min
+// Unit.ToBase  : v => v * 6.30901964e-05     = m³/s
 type GallonsUSFluidPerMinuteFlow Flow
 
 // Title always returns "GallonsUSFluidPerMinute"
@@ -1950,23 +2826,28 @@ func (x GallonsUSFluidPerMinuteFlow) Symbol() string {
 }
 
 // FromBase converts m³/s to gal/min
-func (x GallonsUSFluidPerMinuteFlow) FromBase(m3s float64) float64 {
-	return m3s * 15850.3
+func (x GallonsUSFluidPerMinuteFlow) FromBase(v float64) float64 {
+	return v * 15850.323141488905
 }
 
 // ToBase converts gal/min to m³/s
-func (x GallonsUSFluidPerMinuteFlow) ToBase(galm float64) float64 {
-	return galm * 0.0000630902
+func (x GallonsUSFluidPerMinuteFlow) ToBase(v float64) float64 {
+	return v * 6.30901964e-05
 }
 
 // GallonsUSFluidPerMinuteFlowMatchList is effectively a constant
-var GallonsUSFluidPerMinuteFlowMatchList = [...]string{"gal/m", "gals/m", "galm", "galsm", "gpm", "gallonsperminute", "gallonperminute", "gallonspermin", "gallonpermin", "gallons/minute", "gallons/min", "gallon/minute", "gallon/min"}
+var GallonsUSFluidPerMinuteFlowMatchList = [...]string{"gal/min", "gal/m", "gals/m", "galm", "galsm", "gpm", "gallonsperminute", "gallonperminute", "gallonspermin", "gallonpermin", "gallons/minute", "gallons/min", "gallon/minute", "gallon/min"}
 
 // MatchList always returns GallonsUSFluidPerMinuteFlowMatchList[:]
 func (x GallonsUSFluidPerMinuteFlow) MatchList() []string {
 	return GallonsUSFluidPerMinuteFlowMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x GallonsUSFluidPerMinuteFlow) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -1990,13 +2871,19 @@ func (x GallonsUSFluidPerMinuteFlow) Base() Unit {
 	return CubicMetersPerSecondFlowUnit
 }
 
+// ExactFactor always returns 157725491/2500000000000 exactly
+func (x GallonsUSFluidPerMinuteFlow) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("157725491/2500000000000")
+	return r, true
+}
+
 var GallonsUSFluidPerMinuteFlowUnit GallonsUSFluidPerMinuteFlow = 0.0
 
 // BarrelsPerSecondFlow (Unit)
 // UnitType     : Flow
 // UnitType.Base: CubicMetersPerSecondFlow
-// Unit.FromBase: m3s => m3s * 6.289,81    = bbl/s
-// Unit.ToBase  : bbls => bbls * 0.158,987 = m³/s
+// Unit.FromBase: v => v * 6.289810770432105 = bbl/s
+// Unit.ToBase  : v => v * 0.158987294928    = m³/s
 type BarrelsPerSecondFlow Flow
 
 // Title always returns "BarrelsPerSecond"
@@ -2015,13 +2902,13 @@ func (x BarrelsPerSecondFlow) Symbol() string {
 }
 
 // FromBase converts m³/s to bbl/s
-func (x BarrelsPerSecondFlow) FromBase(m3s float64) float64 {
-	return m3s * 6.28981
+func (x BarrelsPerSecondFlow) FromBase(v float64) float64 {
+	return v * 6.289810770432105
 }
 
 // ToBase converts bbl/s to m³/s
-func (x BarrelsPerSecondFlow) ToBase(bbls float64) float64 {
-	return bbls * 0.158987
+func (x BarrelsPerSecondFlow) ToBase(v float64) float64 {
+	return v * 0.158987294928
 }
 
 // BarrelsPerSecondFlowMatchList is effectively a constant
@@ -2032,6 +2919,11 @@ func (x BarrelsPerSecondFlow) MatchList() []string {
 	return BarrelsPerSecondFlowMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x BarrelsPerSecondFlow) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -2055,13 +2947,19 @@ func (x BarrelsPerSecondFlow) Base() Unit {
 	return CubicMetersPerSecondFlowUnit
 }
 
+// ExactFactor always returns 9936705933/62500000000 exactly
+func (x BarrelsPerSecondFlow) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("9936705933/62500000000")
+	return r, true
+}
+
 var BarrelsPerSecondFlowUnit BarrelsPerSecondFlow = 0.0
 
 // BarrelsPerMinuteFlow (Unit)
 // UnitType     : Flow
 // UnitType.Base: CubicMetersPerSecondFlow
-// Unit.FromBase: m3s => m3s * 377.389        = bbl/min
-// Unit.ToBase  : bblm => bblm * 0.002,649,79 = m³/s
+// Unit.FromBase: v => v * 377.3886462259263 = bbl/min
+// Unit.ToBase  : v => v * 0.0026497882488   = m³/s
 type BarrelsPerMinuteFlow Flow
 
 // Title always returns "BarrelsPerMinute"
@@ -2080,13 +2978,13 @@ func (x BarrelsPerMinuteFlow) Symbol() string {
 }
 
 // FromBase converts m³/s to bbl/min
-func (x BarrelsPerMinuteFlow) FromBase(m3s float64) float64 {
-	return m3s * 377.389
+func (x BarrelsPerMinuteFlow) FromBase(v float64) float64 {
+	return v * 377.3886462259263
 }
 
 // ToBase converts bbl/min to m³/s
-func (x BarrelsPerMinuteFlow) ToBase(bblm float64) float64 {
-	return bblm * 0.00264979
+func (x BarrelsPerMinuteFlow) ToBase(v float64) float64 {
+	return v * 0.0026497882488
 }
 
 // BarrelsPerMinuteFlowMatchList is effectively a constant
@@ -2097,6 +2995,11 @@ func (x BarrelsPerMinuteFlow) MatchList() []string {
 	return BarrelsPerMinuteFlowMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x BarrelsPerMinuteFlow) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -2120,17 +3023,24 @@ func (x BarrelsPerMinuteFlow) Base() Unit {
 	return CubicMetersPerSecondFlowUnit
 }
 
+// ExactFactor always returns 3312235311/1250000000000 exactly
+func (x BarrelsPerMinuteFlow) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("3312235311/1250000000000")
+	return r, true
+}
+
 var BarrelsPerMinuteFlowUnit BarrelsPerMinuteFlow = 0.0
 
 // Volume (UnitType)
 // Contains 7 units:
-//  - CubicMetersVolume          m3 => m3               = m³
-//  - CubicFeetVolume            m3 => m3 * 35.314,7    = cu ft
-//  - ThousandsOfCubicFeetVolume m3 => m3 * 0.035,314,7 = MCF
-//  - CubicDecimeterVolume       m3 => m3 * 1,000       = dm³
-//  - LiterVolume                m3 => m3 * 1,000       = L
-//  - GallonUSFluidVolume        m3 => m3 * 264.172     = gal (US)
-//  - BarrelsOfOilVolume         m3 => m3 * 6.289,81    = bbl
+//   - CubicMetersVolume          m3 => m3                     = m³
+//   - CubicFeetVolume            v => v * 35.31466672148859   = cu ft
+//   - ThousandsOfCubicFeetVolume v => v * 0.03531466672148859 = MCF
+//   - CubicDecimeterVolume       v => v * 1000                = dm³
+//   - LiterVolume                v => v * 1000                = L
+//   - GallonUSFluidVolume        v => v * 264.1720523581484   = gal (US)
+//   - BarrelsOfOilVolume         v => v * 6.289810770432105   = bbl
+//
 // Base: CubicMetersVolume
 type Volume float64
 
@@ -2149,6 +3059,11 @@ func (x Volume) Base() Unit {
 	return CubicMetersVolumeUnit
 }
 
+// Dimension always returns Dimension{Length: 3}
+func (x Volume) Dimension() Dimension {
+	return Dimension{Length: 3}
+}
+
 // VolumeUnits is effectively a constant
 var VolumeUnits = [...]Unit{CubicMetersVolumeUnit, CubicFeetVolumeUnit, ThousandsOfCubicFeetVolumeUnit, CubicDecimeterVolumeUnit, LiterVolumeUnit, GallonUSFluidVolumeUnit, BarrelsOfOilVolumeUnit}
 
@@ -2192,7 +3107,7 @@ var VolumeUnitType Volume = 0.0
 // UnitType     : Volume
 // UnitType.Base: CubicMetersVolume
 // Unit.FromBase: m3 => m3 = m³
-// Unit.ToBase  : m3 => m3 = m³
+// Unit.ToBase  : v => v   = m³
 type CubicMetersVolume Volume
 
 // Title always returns "CubicMeters"
@@ -2216,8 +3131,8 @@ func (x CubicMetersVolume) FromBase(m3 float64) float64 {
 }
 
 // ToBase converts m³ to m³
-func (x CubicMetersVolume) ToBase(m3 float64) float64 {
-	return m3
+func (x CubicMetersVolume) ToBase(v float64) float64 {
+	return v
 }
 
 // CubicMetersVolumeMatchList is effectively a constant
@@ -2228,6 +3143,11 @@ func (x CubicMetersVolume) MatchList() []string {
 	return CubicMetersVolumeMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x CubicMetersVolume) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -2251,13 +3171,19 @@ func (x CubicMetersVolume) Base() Unit {
 	return CubicMetersVolumeUnit
 }
 
+// ExactFactor always returns 1 exactly
+func (x CubicMetersVolume) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1")
+	return r, true
+}
+
 var CubicMetersVolumeUnit CubicMetersVolume = 0.0
 
 // CubicFeetVolume (Unit)
 // UnitType     : Volume
 // UnitType.Base: CubicMetersVolume
-// Unit.FromBase: m3 => m3 * 35.314,7        = cu ft
-// Unit.ToBase  : cuft => cuft * 0.028,316,8 = m³
+// Unit.FromBase: v => v * 35.31466672148859 = cu ft
+// Unit.ToBase  : v => v * 0.028316846592    = m³
 type CubicFeetVolume Volume
 
 // Title always returns "CubicFeet"
@@ -2276,13 +3202,13 @@ func (x CubicFeetVolume) Symbol() string {
 }
 
 // FromBase converts m³ to cu ft
-func (x CubicFeetVolume) FromBase(m3 float64) float64 {
-	return m3 * 35.3147
+func (x CubicFeetVolume) FromBase(v float64) float64 {
+	return v * 35.31466672148859
 }
 
 // ToBase converts cu ft to m³
-func (x CubicFeetVolume) ToBase(cuft float64) float64 {
-	return cuft * 0.0283168
+func (x CubicFeetVolume) ToBase(v float64) float64 {
+	return v * 0.028316846592
 }
 
 // CubicFeetVolumeMatchList is effectively a constant
@@ -2293,6 +3219,11 @@ func (x CubicFeetVolume) MatchList() []string {
 	return CubicFeetVolumeMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x CubicFeetVolume) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -2316,13 +3247,19 @@ func (x CubicFeetVolume) Base() Unit {
 	return CubicMetersVolumeUnit
 }
 
+// ExactFactor always returns 55306341/1953125000 exactly
+func (x CubicFeetVolume) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("55306341/1953125000")
+	return r, true
+}
+
 var CubicFeetVolumeUnit CubicFeetVolume = 0.0
 
 // ThousandsOfCubicFeetVolume (Unit)
 // UnitType     : Volume
 // UnitType.Base: CubicMetersVolume
-// Unit.FromBase: m3 => m3 * 0.035,314,7 = MCF
-// Unit.ToBase  : MCF => MCF * 28.316,8  = m³
+// Unit.FromBase: v => v * 0.03531466672148859 = MCF
+// Unit.ToBase  : v => v * 28.316846592        = m³
 type ThousandsOfCubicFeetVolume Volume
 
 // Title always returns "ThousandsOfCubicFeet"
@@ -2341,13 +3278,13 @@ func (x ThousandsOfCubicFeetVolume) Symbol() string {
 }
 
 // FromBase converts m³ to MCF
-func (x ThousandsOfCubicFeetVolume) FromBase(m3 float64) float64 {
-	return m3 * 0.0353147
+func (x ThousandsOfCubicFeetVolume) FromBase(v float64) float64 {
+	return v * 0.03531466672148859
 }
 
 // ToBase converts MCF to m³
-func (x ThousandsOfCubicFeetVolume) ToBase(MCF float64) float64 {
-	return MCF * 28.3168
+func (x ThousandsOfCubicFeetVolume) ToBase(v float64) float64 {
+	return v * 28.316846592
 }
 
 // ThousandsOfCubicFeetVolumeMatchList is effectively a constant
@@ -2358,6 +3295,11 @@ func (x ThousandsOfCubicFeetVolume) MatchList() []string {
 	return ThousandsOfCubicFeetVolumeMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x ThousandsOfCubicFeetVolume) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -2381,13 +3323,19 @@ func (x ThousandsOfCubicFeetVolume) Base() Unit {
 	return CubicMetersVolumeUnit
 }
 
+// ExactFactor always returns 55306341/1953125 exactly
+func (x ThousandsOfCubicFeetVolume) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("55306341/1953125")
+	return r, true
+}
+
 var ThousandsOfCubicFeetVolumeUnit ThousandsOfCubicFeetVolume = 0.0
 
 // CubicDecimeterVolume (Unit)
 // UnitType     : Volume
 // UnitType.Base: CubicMetersVolume
-// Unit.FromBase: m3 => m3 * 1,000   = dm³
-// Unit.ToBase  : dm3 => dm3 * 0.001 = m³
+// Unit.FromBase: v => v * 1000  = dm³
+// Unit.ToBase  : v => v * 0.001 = m³
 type CubicDecimeterVolume Volume
 
 // Title always returns "CubicDecimeter"
@@ -2406,13 +3354,13 @@ func (x CubicDecimeterVolume) Symbol() string {
 }
 
 // FromBase converts m³ to dm³
-func (x CubicDecimeterVolume) FromBase(m3 float64) float64 {
-	return m3 * 1000
+func (x CubicDecimeterVolume) FromBase(v float64) float64 {
+	return v * 1000.0
 }
 
 // ToBase converts dm³ to m³
-func (x CubicDecimeterVolume) ToBase(dm3 float64) float64 {
-	return dm3 * 0.001
+func (x CubicDecimeterVolume) ToBase(v float64) float64 {
+	return v * 0.001
 }
 
 // CubicDecimeterVolumeMatchList is effectively a constant
@@ -2423,6 +3371,11 @@ func (x CubicDecimeterVolume) MatchList() []string {
 	return CubicDecimeterVolumeMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x CubicDecimeterVolume) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -2446,13 +3399,19 @@ func (x CubicDecimeterVolume) Base() Unit {
 	return CubicMetersVolumeUnit
 }
 
+// ExactFactor always returns 1/1000 exactly
+func (x CubicDecimeterVolume) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1/1000")
+	return r, true
+}
+
 var CubicDecimeterVolumeUnit CubicDecimeterVolume = 0.0
 
 // LiterVolume (Unit)
 // UnitType     : Volume
 // UnitType.Base: CubicMetersVolume
-// Unit.FromBase: m3 => m3 * 1,000 = L
-// Unit.ToBase  : L => L * 0.001   = m³
+// Unit.FromBase: v => v * 1000  = L
+// Unit.ToBase  : v => v * 0.001 = m³
 type LiterVolume Volume
 
 // Title always returns "Liter"
@@ -2471,13 +3430,13 @@ func (x LiterVolume) Symbol() string {
 }
 
 // FromBase converts m³ to L
-func (x LiterVolume) FromBase(m3 float64) float64 {
-	return m3 * 1000
+func (x LiterVolume) FromBase(v float64) float64 {
+	return v * 1000.0
 }
 
 // ToBase converts L to m³
-func (x LiterVolume) ToBase(L float64) float64 {
-	return L * 0.001
+func (x LiterVolume) ToBase(v float64) float64 {
+	return v * 0.001
 }
 
 // LiterVolumeMatchList is effectively a constant
@@ -2488,6 +3447,11 @@ func (x LiterVolume) MatchList() []string {
 	return LiterVolumeMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x LiterVolume) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -2511,13 +3475,19 @@ func (x LiterVolume) Base() Unit {
 	return CubicMetersVolumeUnit
 }
 
+// ExactFactor always returns 1/1000 exactly
+func (x LiterVolume) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1/1000")
+	return r, true
+}
+
 var LiterVolumeUnit LiterVolume = 0.0
 
 // GallonUSFluidVolume (Unit)
 // UnitType     : Volume
 // UnitType.Base: CubicMetersVolume
-// Unit.FromBase: m3 => m3 * 264.172        = gal (US)
-// Unit.ToBase  : gal => gal * 0.003,785,41 = m³
+// Unit.FromBase: v => v * 264.1720523581484 = gal (US)
+// Unit.ToBase  : v => v * 0.003785411784    = m³
 type GallonUSFluidVolume Volume
 
 // Title always returns "GallonUSFluid"
@@ -2536,13 +3506,13 @@ func (x GallonUSFluidVolume) Symbol() string {
 }
 
 // FromBase converts m³ to gal (US)
-func (x GallonUSFluidVolume) FromBase(m3 float64) float64 {
-	return m3 * 264.172
+func (x GallonUSFluidVolume) FromBase(v float64) float64 {
+	return v * 264.1720523581484
 }
 
 // ToBase converts gal (US) to m³
-func (x GallonUSFluidVolume) ToBase(gal float64) float64 {
-	return gal * 0.00378541
+func (x GallonUSFluidVolume) ToBase(v float64) float64 {
+	return v * 0.003785411784
 }
 
 // GallonUSFluidVolumeMatchList is effectively a constant
@@ -2553,6 +3523,11 @@ func (x GallonUSFluidVolume) MatchList() []string {
 	return GallonUSFluidVolumeMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x GallonUSFluidVolume) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -2576,13 +3551,19 @@ func (x GallonUSFluidVolume) Base() Unit {
 	return CubicMetersVolumeUnit
 }
 
+// ExactFactor always returns 473176473/125000000000 exactly
+func (x GallonUSFluidVolume) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("473176473/125000000000")
+	return r, true
+}
+
 var GallonUSFluidVolumeUnit GallonUSFluidVolume = 0.0
 
 // BarrelsOfOilVolume (Unit)
 // UnitType     : Volume
 // UnitType.Base: CubicMetersVolume
-// Unit.FromBase: m3 => m3 * 6.289,81    = bbl
-// Unit.ToBase  : bbl => bbl * 0.158,987 = m³
+// Unit.FromBase: v => v * 6.289810770432105 = bbl
+// Unit.ToBase  : v => v * 0.158987294928    = m³
 type BarrelsOfOilVolume Volume
 
 // Title always returns "BarrelsOfOil"
@@ -2601,13 +3582,13 @@ func (x BarrelsOfOilVolume) Symbol() string {
 }
 
 // FromBase converts m³ to bbl
-func (x BarrelsOfOilVolume) FromBase(m3 float64) float64 {
-	return m3 * 6.28981
+func (x BarrelsOfOilVolume) FromBase(v float64) float64 {
+	return v * 6.289810770432105
 }
 
 // ToBase converts bbl to m³
-func (x BarrelsOfOilVolume) ToBase(bbl float64) float64 {
-	return bbl * 0.158987
+func (x BarrelsOfOilVolume) ToBase(v float64) float64 {
+	return v * 0.158987294928
 }
 
 // BarrelsOfOilVolumeMatchList is effectively a constant
@@ -2618,6 +3599,11 @@ func (x BarrelsOfOilVolume) MatchList() []string {
 	return BarrelsOfOilVolumeMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x BarrelsOfOilVolume) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -2641,12 +3627,19 @@ func (x BarrelsOfOilVolume) Base() Unit {
 	return CubicMetersVolumeUnit
 }
 
+// ExactFactor always returns 9936705933/62500000000 exactly
+func (x BarrelsOfOilVolume) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("9936705933/62500000000")
+	return r, true
+}
+
 var BarrelsOfOilVolumeUnit BarrelsOfOilVolume = 0.0
 
 // Mass (UnitType)
 // Contains 2 units:
-//  - KilogramsMass kg => kg            = kg
-//  - PoundsMass    kg => kg * 2.204,62 = lb
+//   - KilogramsMass kg => kg                    = kg
+//   - PoundsMass    v => v * 2.2046226218487757 = lb
+//
 // Base: KilogramsMass
 type Mass float64
 
@@ -2665,6 +3658,11 @@ func (x Mass) Base() Unit {
 	return KilogramsMassUnit
 }
 
+// Dimension always returns Dimension{Mass: 1}
+func (x Mass) Dimension() Dimension {
+	return Dimension{Mass: 1}
+}
+
 // MassUnits is effectively a constant
 var MassUnits = [...]Unit{KilogramsMassUnit, PoundsMassUnit}
 
@@ -2708,7 +3706,7 @@ var MassUnitType Mass = 0.0
 // UnitType     : Mass
 // UnitType.Base: KilogramsMass
 // Unit.FromBase: kg => kg = kg
-// Unit.ToBase  : kg => kg = kg
+// Unit.ToBase  : v => v   = kg
 type KilogramsMass Mass
 
 // Title always returns "Kilograms"
@@ -2732,8 +3730,8 @@ func (x KilogramsMass) FromBase(kg float64) float64 {
 }
 
 // ToBase converts kg to kg
-func (x KilogramsMass) ToBase(kg float64) float64 {
-	return kg
+func (x KilogramsMass) ToBase(v float64) float64 {
+	return v
 }
 
 // KilogramsMassMatchList is effectively a constant
@@ -2744,6 +3742,11 @@ func (x KilogramsMass) MatchList() []string {
 	return KilogramsMassMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x KilogramsMass) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -2767,13 +3770,19 @@ func (x KilogramsMass) Base() Unit {
 	return KilogramsMassUnit
 }
 
+// ExactFactor always returns 1 exactly
+func (x KilogramsMass) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1")
+	return r, true
+}
+
 var KilogramsMassUnit KilogramsMass = 0.0
 
 // PoundsMass (Unit)
 // UnitType     : Mass
 // UnitType.Base: KilogramsMass
-// Unit.FromBase: kg => kg * 2.204,62  = lb
-// Unit.ToBase  : lb => lb * 0.453,592 = kg
+// Unit.FromBase: v => v * 2.2046226218487757 = lb
+// Unit.ToBase  : v => v * 0.45359237         = kg
 type PoundsMass Mass
 
 // Title always returns "Pounds"
@@ -2792,13 +3801,13 @@ func (x PoundsMass) Symbol() string {
 }
 
 // FromBase converts kg to lb
-func (x PoundsMass) FromBase(kg float64) float64 {
-	return kg * 2.20462
+func (x PoundsMass) FromBase(v float64) float64 {
+	return v * 2.2046226218487757
 }
 
 // ToBase converts lb to kg
-func (x PoundsMass) ToBase(lb float64) float64 {
-	return lb * 0.453592
+func (x PoundsMass) ToBase(v float64) float64 {
+	return v * 0.45359237
 }
 
 // PoundsMassMatchList is effectively a constant
@@ -2809,6 +3818,11 @@ func (x PoundsMass) MatchList() []string {
 	return PoundsMassMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x PoundsMass) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -2832,13 +3846,20 @@ func (x PoundsMass) Base() Unit {
 	return KilogramsMassUnit
 }
 
+// ExactFactor always returns 45359237/100000000 exactly
+func (x PoundsMass) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("45359237/100000000")
+	return r, true
+}
+
 var PoundsMassUnit PoundsMass = 0.0
 
 // MassFlow (UnitType)
 // Contains 3 units:
-//  - KilogramsPerSecondMassFlow kgs => kgs            = kg/s
-//  - PoundsPerSecondMassFlow    kgs => kgs * 2.204,62 = lb/s
-//  - PoundsPerMinuteMassFlow    kgs => kgs * 132.277  = lb/min
+//   - KilogramsPerSecondMassFlow kgs => kgs                  = kg/s
+//   - PoundsPerSecondMassFlow    v => v * 2.2046226218487757 = lb/s
+//   - PoundsPerMinuteMassFlow    v => v * 132.27735731092656 = lb/min
+//
 // Base: KilogramsPerSecondMassFlow
 type MassFlow float64
 
@@ -2857,6 +3878,11 @@ func (x MassFlow) Base() Unit {
 	return KilogramsPerSecondMassFlowUnit
 }
 
+// Dimension always returns Dimension{Mass: 1, Time: -1}
+func (x MassFlow) Dimension() Dimension {
+	return Dimension{Mass: 1, Time: -1}
+}
+
 // MassFlowUnits is effectively a constant
 var MassFlowUnits = [...]Unit{KilogramsPerSecondMassFlowUnit, PoundsPerSecondMassFlowUnit, PoundsPerMinuteMassFlowUnit}
 
@@ -2900,7 +3926,7 @@ var MassFlowUnitType MassFlow = 0.0
 // UnitType     : MassFlow
 // UnitType.Base: KilogramsPerSecondMassFlow
 // Unit.FromBase: kgs => kgs = kg/s
-// Unit.ToBase  : kgs => kgs = kg/s
+// Unit.ToBase  : v => v     = kg/s
 type KilogramsPerSecondMassFlow MassFlow
 
 // Title always returns "KilogramsPerSecond"
@@ -2924,8 +3950,8 @@ func (x KilogramsPerSecondMassFlow) FromBase(kgs float64) float64 {
 }
 
 // ToBase converts kg/s to kg/s
-func (x KilogramsPerSecondMassFlow) ToBase(kgs float64) float64 {
-	return kgs
+func (x KilogramsPerSecondMassFlow) ToBase(v float64) float64 {
+	return v
 }
 
 // KilogramsPerSecondMassFlowMatchList is effectively a constant
@@ -2936,6 +3962,11 @@ func (x KilogramsPerSecondMassFlow) MatchList() []string {
 	return KilogramsPerSecondMassFlowMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x KilogramsPerSecondMassFlow) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -2959,13 +3990,19 @@ func (x KilogramsPerSecondMassFlow) Base() Unit {
 	return KilogramsPerSecondMassFlowUnit
 }
 
+// ExactFactor always returns 1 exactly
+func (x KilogramsPerSecondMassFlow) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1")
+	return r, true
+}
+
 var KilogramsPerSecondMassFlowUnit KilogramsPerSecondMassFlow = 0.0
 
 // PoundsPerSecondMassFlow (Unit)
 // UnitType     : MassFlow
 // UnitType.Base: KilogramsPerSecondMassFlow
-// Unit.FromBase: kgs => kgs * 2.204,62  = lb/s
-// Unit.ToBase  : lbs => lbs * 0.453,592 = kg/s
+// Unit.FromBase: v => v * 2.2046226218487757 = lb/s
+// Unit.ToBase  : v => v * 0.45359237         = kg/s
 type PoundsPerSecondMassFlow MassFlow
 
 // Title always returns "PoundsPerSecond"
@@ -2984,13 +4021,13 @@ func (x PoundsPerSecondMassFlow) Symbol() string {
 }
 
 // FromBase converts kg/s to lb/s
-func (x PoundsPerSecondMassFlow) FromBase(kgs float64) float64 {
-	return kgs * 2.20462
+func (x PoundsPerSecondMassFlow) FromBase(v float64) float64 {
+	return v * 2.2046226218487757
 }
 
 // ToBase converts lb/s to kg/s
-func (x PoundsPerSecondMassFlow) ToBase(lbs float64) float64 {
-	return lbs * 0.453592
+func (x PoundsPerSecondMassFlow) ToBase(v float64) float64 {
+	return v * 0.45359237
 }
 
 // PoundsPerSecondMassFlowMatchList is effectively a constant
@@ -3001,6 +4038,11 @@ func (x PoundsPerSecondMassFlow) MatchList() []string {
 	return PoundsPerSecondMassFlowMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x PoundsPerSecondMassFlow) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -3024,13 +4066,19 @@ func (x PoundsPerSecondMassFlow) Base() Unit {
 	return KilogramsPerSecondMassFlowUnit
 }
 
+// ExactFactor always returns 45359237/100000000 exactly
+func (x PoundsPerSecondMassFlow) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("45359237/100000000")
+	return r, true
+}
+
 var PoundsPerSecondMassFlowUnit PoundsPerSecondMassFlow = 0.0
 
 // PoundsPerMinuteMassFlow (Unit)
 // UnitType     : MassFlow
 // UnitType.Base: KilogramsPerSecondMassFlow
-// Unit.FromBase: kgs => kgs * 132.277          = lb/min
-// Unit.ToBase  : lbmin => lbmin * 0.007,559,87 = kg/s
+// Unit.FromBase: v => v * 132.27735731092656   = lb/min
+// Unit.ToBase  : v => v * 0.007559872833333333 = kg/s
 type PoundsPerMinuteMassFlow MassFlow
 
 // Title always returns "PoundsPerMinute"
@@ -3049,13 +4097,13 @@ func (x PoundsPerMinuteMassFlow) Symbol() string {
 }
 
 // FromBase converts kg/s to lb/min
-func (x PoundsPerMinuteMassFlow) FromBase(kgs float64) float64 {
-	return kgs * 132.277
+func (x PoundsPerMinuteMassFlow) FromBase(v float64) float64 {
+	return v * 132.27735731092656
 }
 
 // ToBase converts lb/min to kg/s
-func (x PoundsPerMinuteMassFlow) ToBase(lbmin float64) float64 {
-	return lbmin * 0.00755987
+func (x PoundsPerMinuteMassFlow) ToBase(v float64) float64 {
+	return v * 0.007559872833333333
 }
 
 // PoundsPerMinuteMassFlowMatchList is effectively a constant
@@ -3066,6 +4114,11 @@ func (x PoundsPerMinuteMassFlow) MatchList() []string {
 	return PoundsPerMinuteMassFlowMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x PoundsPerMinuteMassFlow) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -3089,11 +4142,20 @@ func (x PoundsPerMinuteMassFlow) Base() Unit {
 	return KilogramsPerSecondMassFlowUnit
 }
 
+// ExactFactor always returns 45359237/6000000000 exactly
+func (x PoundsPerMinuteMassFlow) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("45359237/6000000000")
+	return r, true
+}
+
 var PoundsPerMinuteMassFlowUnit PoundsPerMinuteMassFlow = 0.0
 
 // ElectricPotential (UnitType)
-// Contains 1 units:
-//  - VoltsElectricPotential V => V = V
+// Contains 3 units:
+//   - VoltsElectricPotential      V => V         = V
+//   - MillivoltsElectricPotential v => v * 1000  = mV
+//   - KilovoltsElectricPotential  v => v * 0.001 = kV
+//
 // Base: VoltsElectricPotential
 type ElectricPotential float64
 
@@ -3112,8 +4174,13 @@ func (x ElectricPotential) Base() Unit {
 	return VoltsElectricPotentialUnit
 }
 
+// Dimension always returns Dimension{Mass: 1, Length: 2, Time: -3, Current: -1}
+func (x ElectricPotential) Dimension() Dimension {
+	return Dimension{Mass: 1, Length: 2, Time: -3, Current: -1}
+}
+
 // ElectricPotentialUnits is effectively a constant
-var ElectricPotentialUnits = [...]Unit{VoltsElectricPotentialUnit}
+var ElectricPotentialUnits = [...]Unit{VoltsElectricPotentialUnit, MillivoltsElectricPotentialUnit, KilovoltsElectricPotentialUnit}
 
 // Units always returns ElectricPotentialUnits[:]
 func (x ElectricPotential) Units() []Unit {
@@ -3121,7 +4188,7 @@ func (x ElectricPotential) Units() []Unit {
 }
 
 // ElectricPotentialUnitList is effectively a constant
-var ElectricPotentialUnitList = [...]string{"Volts"}
+var ElectricPotentialUnitList = [...]string{"Volts", "Millivolts", "Kilovolts"}
 
 // UnitList always returns ElectricPotentialUnitList[:]
 func (x ElectricPotential) UnitList() []string {
@@ -3155,7 +4222,7 @@ var ElectricPotentialUnitType ElectricPotential = 0.0
 // UnitType     : ElectricPotential
 // UnitType.Base: VoltsElectricPotential
 // Unit.FromBase: V => V = V
-// Unit.ToBase  : V => V = V
+// Unit.ToBase  : v => v = V
 type VoltsElectricPotential ElectricPotential
 
 // Title always returns "Volts"
@@ -3179,8 +4246,8 @@ func (x VoltsElectricPotential) FromBase(V float64) float64 {
 }
 
 // ToBase converts V to V
-func (x VoltsElectricPotential) ToBase(V float64) float64 {
-	return V
+func (x VoltsElectricPotential) ToBase(v float64) float64 {
+	return v
 }
 
 // VoltsElectricPotentialMatchList is effectively a constant
@@ -3191,6 +4258,11 @@ func (x VoltsElectricPotential) MatchList() []string {
 	return VoltsElectricPotentialMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x VoltsElectricPotential) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -3214,11 +4286,190 @@ func (x VoltsElectricPotential) Base() Unit {
 	return VoltsElectricPotentialUnit
 }
 
+// ExactFactor always returns 1 exactly
+func (x VoltsElectricPotential) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1")
+	return r, true
+}
+
 var VoltsElectricPotentialUnit VoltsElectricPotential = 0.0
 
+// MillivoltsElectricPotential (Unit)
+// UnitType     : ElectricPotential
+// UnitType.Base: VoltsElectricPotential
+// Unit.FromBase: v => v * 1000  = mV
+// Unit.ToBase  : v => v * 0.001 = V
+type MillivoltsElectricPotential ElectricPotential
+
+// Title always returns "Millivolts"
+func (x MillivoltsElectricPotential) Title() string {
+	return "Millivolts"
+}
+
+// Name always returns "Millivolts"
+func (x MillivoltsElectricPotential) Name() string {
+	return "Millivolts"
+}
+
+// Symbol always returns "mV"
+func (x MillivoltsElectricPotential) Symbol() string {
+	return "mV"
+}
+
+// FromBase converts V to mV
+func (x MillivoltsElectricPotential) FromBase(v float64) float64 {
+	return v * 1000.0
+}
+
+// ToBase converts mV to V
+func (x MillivoltsElectricPotential) ToBase(v float64) float64 {
+	return v * 0.001
+}
+
+// MillivoltsElectricPotentialMatchList is effectively a constant
+var MillivoltsElectricPotentialMatchList = [...]string{"millivolt", "millivolts"}
+
+// MatchList always returns MillivoltsElectricPotentialMatchList[:]
+func (x MillivoltsElectricPotential) MatchList() []string {
+	return MillivoltsElectricPotentialMatchList[:]
+}
+
+// MillivoltsElectricPotentialExactMatchList is effectively a constant
+var MillivoltsElectricPotentialExactMatchList = [...]string{"mV"}
+
+// ExactMatchList always returns MillivoltsElectricPotentialExactMatchList[:]
+func (x MillivoltsElectricPotential) ExactMatchList() []string {
+	return MillivoltsElectricPotentialExactMatchList[:]
+}
+
+// Matches returns true if check matches our possible names.
+// Helpful when a user is allowed to enter in unit types
+// freehand, for example.
+func (x MillivoltsElectricPotential) Matches(check string) bool {
+	exact := WhitespaceRegex.ReplaceAllString(check, "")
+	for _, m := range x.ExactMatchList() {
+		if m == exact {
+			return true
+		}
+	}
+	check = SanitizeString(check)
+	for _, m := range x.MatchList() {
+		if m == check || m == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// TypeOf always returns ElectricPotentialUnitType
+func (x MillivoltsElectricPotential) TypeOf() UnitType {
+	return ElectricPotentialUnitType
+}
+
+// Base always returns VoltsElectricPotentialUnit
+func (x MillivoltsElectricPotential) Base() Unit {
+	return VoltsElectricPotentialUnit
+}
+
+// ExactFactor always returns 1/1000 exactly
+func (x MillivoltsElectricPotential) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1/1000")
+	return r, true
+}
+
+var MillivoltsElectricPotentialUnit MillivoltsElectricPotential = 0.0
+
+// KilovoltsElectricPotential (Unit)
+// UnitType     : ElectricPotential
+// UnitType.Base: VoltsElectricPotential
+// Unit.FromBase: v => v * 0.001 = kV
+// Unit.ToBase  : v => v * 1000  = V
+type KilovoltsElectricPotential ElectricPotential
+
+// Title always returns "Kilovolts"
+func (x KilovoltsElectricPotential) Title() string {
+	return "Kilovolts"
+}
+
+// Name always returns "Kilovolts"
+func (x KilovoltsElectricPotential) Name() string {
+	return "Kilovolts"
+}
+
+// Symbol always returns "kV"
+func (x KilovoltsElectricPotential) Symbol() string {
+	return "kV"
+}
+
+// FromBase converts V to kV
+func (x KilovoltsElectricPotential) FromBase(v float64) float64 {
+	return v * 0.001
+}
+
+// ToBase converts kV to V
+func (x KilovoltsElectricPotential) ToBase(v float64) float64 {
+	return v * 1000.0
+}
+
+// KilovoltsElectricPotentialMatchList is effectively a constant
+var KilovoltsElectricPotentialMatchList = [...]string{"kv", "kilovolt", "kilovolts"}
+
+// MatchList always returns KilovoltsElectricPotentialMatchList[:]
+func (x KilovoltsElectricPotential) MatchList() []string {
+	return KilovoltsElectricPotentialMatchList[:]
+}
+
+// KilovoltsElectricPotentialExactMatchList is effectively a constant
+var KilovoltsElectricPotentialExactMatchList = [...]string{"kV"}
+
+// ExactMatchList always returns KilovoltsElectricPotentialExactMatchList[:]
+func (x KilovoltsElectricPotential) ExactMatchList() []string {
+	return KilovoltsElectricPotentialExactMatchList[:]
+}
+
+// Matches returns true if check matches our possible names.
+// Helpful when a user is allowed to enter in unit types
+// freehand, for example.
+func (x KilovoltsElectricPotential) Matches(check string) bool {
+	exact := WhitespaceRegex.ReplaceAllString(check, "")
+	for _, m := range x.ExactMatchList() {
+		if m == exact {
+			return true
+		}
+	}
+	check = SanitizeString(check)
+	for _, m := range x.MatchList() {
+		if m == check || m == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// TypeOf always returns ElectricPotentialUnitType
+func (x KilovoltsElectricPotential) TypeOf() UnitType {
+	return ElectricPotentialUnitType
+}
+
+// Base always returns VoltsElectricPotentialUnit
+func (x KilovoltsElectricPotential) Base() Unit {
+	return VoltsElectricPotentialUnit
+}
+
+// ExactFactor always returns 1000 exactly
+func (x KilovoltsElectricPotential) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1000")
+	return r, true
+}
+
+var KilovoltsElectricPotentialUnit KilovoltsElectricPotential = 0.0
+
 // ElectricPotentialLoaded (UnitType)
-// Contains 1 units:
-//  - VoltsElectricPotentialLoaded V => V = V
+// Contains 3 units:
+//   - VoltsElectricPotentialLoaded      V => V         = V
+//   - MillivoltsElectricPotentialLoaded v => v * 1000  = mV
+//   - KilovoltsElectricPotentialLoaded  v => v * 0.001 = kV
+//
 // Base: VoltsElectricPotentialLoaded
 type ElectricPotentialLoaded float64
 
@@ -3237,8 +4488,13 @@ func (x ElectricPotentialLoaded) Base() Unit {
 	return VoltsElectricPotentialLoadedUnit
 }
 
+// Dimension always returns Dimension{Mass: 1, Length: 2, Time: -3, Current: -1}
+func (x ElectricPotentialLoaded) Dimension() Dimension {
+	return Dimension{Mass: 1, Length: 2, Time: -3, Current: -1}
+}
+
 // ElectricPotentialLoadedUnits is effectively a constant
-var ElectricPotentialLoadedUnits = [...]Unit{VoltsElectricPotentialLoadedUnit}
+var ElectricPotentialLoadedUnits = [...]Unit{VoltsElectricPotentialLoadedUnit, MillivoltsElectricPotentialLoadedUnit, KilovoltsElectricPotentialLoadedUnit}
 
 // Units always returns ElectricPotentialLoadedUnits[:]
 func (x ElectricPotentialLoaded) Units() []Unit {
@@ -3246,7 +4502,7 @@ func (x ElectricPotentialLoaded) Units() []Unit {
 }
 
 // ElectricPotentialLoadedUnitList is effectively a constant
-var ElectricPotentialLoadedUnitList = [...]string{"Volts"}
+var ElectricPotentialLoadedUnitList = [...]string{"Volts", "Millivolts", "Kilovolts"}
 
 // UnitList always returns ElectricPotentialLoadedUnitList[:]
 func (x ElectricPotentialLoaded) UnitList() []string {
@@ -3280,7 +4536,7 @@ var ElectricPotentialLoadedUnitType ElectricPotentialLoaded = 0.0
 // UnitType     : ElectricPotentialLoaded
 // UnitType.Base: VoltsElectricPotentialLoaded
 // Unit.FromBase: V => V = V
-// Unit.ToBase  : V => V = V
+// Unit.ToBase  : v => v = V
 type VoltsElectricPotentialLoaded ElectricPotentialLoaded
 
 // Title always returns "Volts"
@@ -3304,8 +4560,8 @@ func (x VoltsElectricPotentialLoaded) FromBase(V float64) float64 {
 }
 
 // ToBase converts V to V
-func (x VoltsElectricPotentialLoaded) ToBase(V float64) float64 {
-	return V
+func (x VoltsElectricPotentialLoaded) ToBase(v float64) float64 {
+	return v
 }
 
 // VoltsElectricPotentialLoadedMatchList is effectively a constant
@@ -3316,10 +4572,185 @@ func (x VoltsElectricPotentialLoaded) MatchList() []string {
 	return VoltsElectricPotentialLoadedMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x VoltsElectricPotentialLoaded) ExactMatchList() []string {
+	return nil
+}
+
+// Matches returns true if check matches our possible names.
+// Helpful when a user is allowed to enter in unit types
+// freehand, for example.
+func (x VoltsElectricPotentialLoaded) Matches(check string) bool {
+	check = SanitizeString(check)
+	for _, m := range x.MatchList() {
+		if m == check || m == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// TypeOf always returns ElectricPotentialLoadedUnitType
+func (x VoltsElectricPotentialLoaded) TypeOf() UnitType {
+	return ElectricPotentialLoadedUnitType
+}
+
+// Base always returns VoltsElectricPotentialLoadedUnit
+func (x VoltsElectricPotentialLoaded) Base() Unit {
+	return VoltsElectricPotentialLoadedUnit
+}
+
+// ExactFactor always returns 1 exactly
+func (x VoltsElectricPotentialLoaded) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1")
+	return r, true
+}
+
+var VoltsElectricPotentialLoadedUnit VoltsElectricPotentialLoaded = 0.0
+
+// MillivoltsElectricPotentialLoaded (Unit)
+// UnitType     : ElectricPotentialLoaded
+// UnitType.Base: VoltsElectricPotentialLoaded
+// Unit.FromBase: v => v * 1000  = mV
+// Unit.ToBase  : v => v * 0.001 = V
+type MillivoltsElectricPotentialLoaded ElectricPotentialLoaded
+
+// Title always returns "Millivolts"
+func (x MillivoltsElectricPotentialLoaded) Title() string {
+	return "Millivolts"
+}
+
+// Name always returns "Millivolts"
+func (x MillivoltsElectricPotentialLoaded) Name() string {
+	return "Millivolts"
+}
+
+// Symbol always returns "mV"
+func (x MillivoltsElectricPotentialLoaded) Symbol() string {
+	return "mV"
+}
+
+// FromBase converts V to mV
+func (x MillivoltsElectricPotentialLoaded) FromBase(v float64) float64 {
+	return v * 1000.0
+}
+
+// ToBase converts mV to V
+func (x MillivoltsElectricPotentialLoaded) ToBase(v float64) float64 {
+	return v * 0.001
+}
+
+// MillivoltsElectricPotentialLoadedMatchList is effectively a constant
+var MillivoltsElectricPotentialLoadedMatchList = [...]string{"millivolt", "millivolts"}
+
+// MatchList always returns MillivoltsElectricPotentialLoadedMatchList[:]
+func (x MillivoltsElectricPotentialLoaded) MatchList() []string {
+	return MillivoltsElectricPotentialLoadedMatchList[:]
+}
+
+// MillivoltsElectricPotentialLoadedExactMatchList is effectively a constant
+var MillivoltsElectricPotentialLoadedExactMatchList = [...]string{"mV"}
+
+// ExactMatchList always returns MillivoltsElectricPotentialLoadedExactMatchList[:]
+func (x MillivoltsElectricPotentialLoaded) ExactMatchList() []string {
+	return MillivoltsElectricPotentialLoadedExactMatchList[:]
+}
+
+// Matches returns true if check matches our possible names.
+// Helpful when a user is allowed to enter in unit types
+// freehand, for example.
+func (x MillivoltsElectricPotentialLoaded) Matches(check string) bool {
+	exact := WhitespaceRegex.ReplaceAllString(check, "")
+	for _, m := range x.ExactMatchList() {
+		if m == exact {
+			return true
+		}
+	}
+	check = SanitizeString(check)
+	for _, m := range x.MatchList() {
+		if m == check || m == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// TypeOf always returns ElectricPotentialLoadedUnitType
+func (x MillivoltsElectricPotentialLoaded) TypeOf() UnitType {
+	return ElectricPotentialLoadedUnitType
+}
+
+// Base always returns VoltsElectricPotentialLoadedUnit
+func (x MillivoltsElectricPotentialLoaded) Base() Unit {
+	return VoltsElectricPotentialLoadedUnit
+}
+
+// ExactFactor always returns 1/1000 exactly
+func (x MillivoltsElectricPotentialLoaded) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1/1000")
+	return r, true
+}
+
+var MillivoltsElectricPotentialLoadedUnit MillivoltsElectricPotentialLoaded = 0.0
+
+// KilovoltsElectricPotentialLoaded (Unit)
+// UnitType     : ElectricPotentialLoaded
+// UnitType.Base: VoltsElectricPotentialLoaded
+// Unit.FromBase: v => v * 0.001 = kV
+// Unit.ToBase  : v => v * 1000  = V
+type KilovoltsElectricPotentialLoaded ElectricPotentialLoaded
+
+// Title always returns "Kilovolts"
+func (x KilovoltsElectricPotentialLoaded) Title() string {
+	return "Kilovolts"
+}
+
+// Name always returns "Kilovolts"
+func (x KilovoltsElectricPotentialLoaded) Name() string {
+	return "Kilovolts"
+}
+
+// Symbol always returns "kV"
+func (x KilovoltsElectricPotentialLoaded) Symbol() string {
+	return "kV"
+}
+
+// FromBase converts V to kV
+func (x KilovoltsElectricPotentialLoaded) FromBase(v float64) float64 {
+	return v * 0.001
+}
+
+// ToBase converts kV to V
+func (x KilovoltsElectricPotentialLoaded) ToBase(v float64) float64 {
+	return v * 1000.0
+}
+
+// KilovoltsElectricPotentialLoadedMatchList is effectively a constant
+var KilovoltsElectricPotentialLoadedMatchList = [...]string{"kv", "kilovolt", "kilovolts"}
+
+// MatchList always returns KilovoltsElectricPotentialLoadedMatchList[:]
+func (x KilovoltsElectricPotentialLoaded) MatchList() []string {
+	return KilovoltsElectricPotentialLoadedMatchList[:]
+}
+
+// KilovoltsElectricPotentialLoadedExactMatchList is effectively a constant
+var KilovoltsElectricPotentialLoadedExactMatchList = [...]string{"kV"}
+
+// ExactMatchList always returns KilovoltsElectricPotentialLoadedExactMatchList[:]
+func (x KilovoltsElectricPotentialLoaded) ExactMatchList() []string {
+	return KilovoltsElectricPotentialLoadedExactMatchList[:]
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
-func (x VoltsElectricPotentialLoaded) Matches(check string) bool {
+func (x KilovoltsElectricPotentialLoaded) Matches(check string) bool {
+	exact := WhitespaceRegex.ReplaceAllString(check, "")
+	for _, m := range x.ExactMatchList() {
+		if m == exact {
+			return true
+		}
+	}
 	check = SanitizeString(check)
 	for _, m := range x.MatchList() {
 		if m == check || m == "*" {
@@ -3330,20 +4761,29 @@ func (x VoltsElectricPotentialLoaded) Matches(check string) bool {
 }
 
 // TypeOf always returns ElectricPotentialLoadedUnitType
-func (x VoltsElectricPotentialLoaded) TypeOf() UnitType {
+func (x KilovoltsElectricPotentialLoaded) TypeOf() UnitType {
 	return ElectricPotentialLoadedUnitType
 }
 
 // Base always returns VoltsElectricPotentialLoadedUnit
-func (x VoltsElectricPotentialLoaded) Base() Unit {
+func (x KilovoltsElectricPotentialLoaded) Base() Unit {
 	return VoltsElectricPotentialLoadedUnit
 }
 
-var VoltsElectricPotentialLoadedUnit VoltsElectricPotentialLoaded = 0.0
+// ExactFactor always returns 1000 exactly
+func (x KilovoltsElectricPotentialLoaded) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1000")
+	return r, true
+}
+
+var KilovoltsElectricPotentialLoadedUnit KilovoltsElectricPotentialLoaded = 0.0
 
 // ElectricPotentialUnloaded (UnitType)
-// Contains 1 units:
-//  - VoltsElectricPotentialUnloaded V => V = V
+// Contains 3 units:
+//   - VoltsElectricPotentialUnloaded      V => V         = V
+//   - MillivoltsElectricPotentialUnloaded v => v * 1000  = mV
+//   - KilovoltsElectricPotentialUnloaded  v => v * 0.001 = kV
+//
 // Base: VoltsElectricPotentialUnloaded
 type ElectricPotentialUnloaded float64
 
@@ -3362,8 +4802,13 @@ func (x ElectricPotentialUnloaded) Base() Unit {
 	return VoltsElectricPotentialUnloadedUnit
 }
 
+// Dimension always returns Dimension{Mass: 1, Length: 2, Time: -3, Current: -1}
+func (x ElectricPotentialUnloaded) Dimension() Dimension {
+	return Dimension{Mass: 1, Length: 2, Time: -3, Current: -1}
+}
+
 // ElectricPotentialUnloadedUnits is effectively a constant
-var ElectricPotentialUnloadedUnits = [...]Unit{VoltsElectricPotentialUnloadedUnit}
+var ElectricPotentialUnloadedUnits = [...]Unit{VoltsElectricPotentialUnloadedUnit, MillivoltsElectricPotentialUnloadedUnit, KilovoltsElectricPotentialUnloadedUnit}
 
 // Units always returns ElectricPotentialUnloadedUnits[:]
 func (x ElectricPotentialUnloaded) Units() []Unit {
@@ -3371,7 +4816,7 @@ func (x ElectricPotentialUnloaded) Units() []Unit {
 }
 
 // ElectricPotentialUnloadedUnitList is effectively a constant
-var ElectricPotentialUnloadedUnitList = [...]string{"Volts"}
+var ElectricPotentialUnloadedUnitList = [...]string{"Volts", "Millivolts", "Kilovolts"}
 
 // UnitList always returns ElectricPotentialUnloadedUnitList[:]
 func (x ElectricPotentialUnloaded) UnitList() []string {
@@ -3405,7 +4850,7 @@ var ElectricPotentialUnloadedUnitType ElectricPotentialUnloaded = 0.0
 // UnitType     : ElectricPotentialUnloaded
 // UnitType.Base: VoltsElectricPotentialUnloaded
 // Unit.FromBase: V => V = V
-// Unit.ToBase  : V => V = V
+// Unit.ToBase  : v => v = V
 type VoltsElectricPotentialUnloaded ElectricPotentialUnloaded
 
 // Title always returns "Volts"
@@ -3429,8 +4874,8 @@ func (x VoltsElectricPotentialUnloaded) FromBase(V float64) float64 {
 }
 
 // ToBase converts V to V
-func (x VoltsElectricPotentialUnloaded) ToBase(V float64) float64 {
-	return V
+func (x VoltsElectricPotentialUnloaded) ToBase(v float64) float64 {
+	return v
 }
 
 // VoltsElectricPotentialUnloadedMatchList is effectively a constant
@@ -3441,6 +4886,11 @@ func (x VoltsElectricPotentialUnloaded) MatchList() []string {
 	return VoltsElectricPotentialUnloadedMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x VoltsElectricPotentialUnloaded) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -3464,11 +4914,188 @@ func (x VoltsElectricPotentialUnloaded) Base() Unit {
 	return VoltsElectricPotentialUnloadedUnit
 }
 
+// ExactFactor always returns 1 exactly
+func (x VoltsElectricPotentialUnloaded) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1")
+	return r, true
+}
+
 var VoltsElectricPotentialUnloadedUnit VoltsElectricPotentialUnloaded = 0.0
 
+// MillivoltsElectricPotentialUnloaded (Unit)
+// UnitType     : ElectricPotentialUnloaded
+// UnitType.Base: VoltsElectricPotentialUnloaded
+// Unit.FromBase: v => v * 1000  = mV
+// Unit.ToBase  : v => v * 0.001 = V
+type MillivoltsElectricPotentialUnloaded ElectricPotentialUnloaded
+
+// Title always returns "Millivolts"
+func (x MillivoltsElectricPotentialUnloaded) Title() string {
+	return "Millivolts"
+}
+
+// Name always returns "Millivolts"
+func (x MillivoltsElectricPotentialUnloaded) Name() string {
+	return "Millivolts"
+}
+
+// Symbol always returns "mV"
+func (x MillivoltsElectricPotentialUnloaded) Symbol() string {
+	return "mV"
+}
+
+// FromBase converts V to mV
+func (x MillivoltsElectricPotentialUnloaded) FromBase(v float64) float64 {
+	return v * 1000.0
+}
+
+// ToBase converts mV to V
+func (x MillivoltsElectricPotentialUnloaded) ToBase(v float64) float64 {
+	return v * 0.001
+}
+
+// MillivoltsElectricPotentialUnloadedMatchList is effectively a constant
+var MillivoltsElectricPotentialUnloadedMatchList = [...]string{"millivolt", "millivolts"}
+
+// MatchList always returns MillivoltsElectricPotentialUnloadedMatchList[:]
+func (x MillivoltsElectricPotentialUnloaded) MatchList() []string {
+	return MillivoltsElectricPotentialUnloadedMatchList[:]
+}
+
+// MillivoltsElectricPotentialUnloadedExactMatchList is effectively a constant
+var MillivoltsElectricPotentialUnloadedExactMatchList = [...]string{"mV"}
+
+// ExactMatchList always returns MillivoltsElectricPotentialUnloadedExactMatchList[:]
+func (x MillivoltsElectricPotentialUnloaded) ExactMatchList() []string {
+	return MillivoltsElectricPotentialUnloadedExactMatchList[:]
+}
+
+// Matches returns true if check matches our possible names.
+// Helpful when a user is allowed to enter in unit types
+// freehand, for example.
+func (x MillivoltsElectricPotentialUnloaded) Matches(check string) bool {
+	exact := WhitespaceRegex.ReplaceAllString(check, "")
+	for _, m := range x.ExactMatchList() {
+		if m == exact {
+			return true
+		}
+	}
+	check = SanitizeString(check)
+	for _, m := range x.MatchList() {
+		if m == check || m == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// TypeOf always returns ElectricPotentialUnloadedUnitType
+func (x MillivoltsElectricPotentialUnloaded) TypeOf() UnitType {
+	return ElectricPotentialUnloadedUnitType
+}
+
+// Base always returns VoltsElectricPotentialUnloadedUnit
+func (x MillivoltsElectricPotentialUnloaded) Base() Unit {
+	return VoltsElectricPotentialUnloadedUnit
+}
+
+// ExactFactor always returns 1/1000 exactly
+func (x MillivoltsElectricPotentialUnloaded) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1/1000")
+	return r, true
+}
+
+var MillivoltsElectricPotentialUnloadedUnit MillivoltsElectricPotentialUnloaded = 0.0
+
+// KilovoltsElectricPotentialUnloaded (Unit)
+// UnitType     : ElectricPotentialUnloaded
+// UnitType.Base: VoltsElectricPotentialUnloaded
+// Unit.FromBase: v => v * 0.001 = kV
+// Unit.ToBase  : v => v * 1000  = V
+type KilovoltsElectricPotentialUnloaded ElectricPotentialUnloaded
+
+// Title always returns "Kilovolts"
+func (x KilovoltsElectricPotentialUnloaded) Title() string {
+	return "Kilovolts"
+}
+
+// Name always returns "Kilovolts"
+func (x KilovoltsElectricPotentialUnloaded) Name() string {
+	return "Kilovolts"
+}
+
+// Symbol always returns "kV"
+func (x KilovoltsElectricPotentialUnloaded) Symbol() string {
+	return "kV"
+}
+
+// FromBase converts V to kV
+func (x KilovoltsElectricPotentialUnloaded) FromBase(v float64) float64 {
+	return v * 0.001
+}
+
+// ToBase converts kV to V
+func (x KilovoltsElectricPotentialUnloaded) ToBase(v float64) float64 {
+	return v * 1000.0
+}
+
+// KilovoltsElectricPotentialUnloadedMatchList is effectively a constant
+var KilovoltsElectricPotentialUnloadedMatchList = [...]string{"kv", "kilovolt", "kilovolts"}
+
+// MatchList always returns KilovoltsElectricPotentialUnloadedMatchList[:]
+func (x KilovoltsElectricPotentialUnloaded) MatchList() []string {
+	return KilovoltsElectricPotentialUnloadedMatchList[:]
+}
+
+// KilovoltsElectricPotentialUnloadedExactMatchList is effectively a constant
+var KilovoltsElectricPotentialUnloadedExactMatchList = [...]string{"kV"}
+
+// ExactMatchList always returns KilovoltsElectricPotentialUnloadedExactMatchList[:]
+func (x KilovoltsElectricPotentialUnloaded) ExactMatchList() []string {
+	return KilovoltsElectricPotentialUnloadedExactMatchList[:]
+}
+
+// Matches returns true if check matches our possible names.
+// Helpful when a user is allowed to enter in unit types
+// freehand, for example.
+func (x KilovoltsElectricPotentialUnloaded) Matches(check string) bool {
+	exact := WhitespaceRegex.ReplaceAllString(check, "")
+	for _, m := range x.ExactMatchList() {
+		if m == exact {
+			return true
+		}
+	}
+	check = SanitizeString(check)
+	for _, m := range x.MatchList() {
+		if m == check || m == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// TypeOf always returns ElectricPotentialUnloadedUnitType
+func (x KilovoltsElectricPotentialUnloaded) TypeOf() UnitType {
+	return ElectricPotentialUnloadedUnitType
+}
+
+// Base always returns VoltsElectricPotentialUnloadedUnit
+func (x KilovoltsElectricPotentialUnloaded) Base() Unit {
+	return VoltsElectricPotentialUnloadedUnit
+}
+
+// ExactFactor always returns 1000 exactly
+func (x KilovoltsElectricPotentialUnloaded) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1000")
+	return r, true
+}
+
+var KilovoltsElectricPotentialUnloadedUnit KilovoltsElectricPotentialUnloaded = 0.0
+
 // Percentage (UnitType)
 // Contains 1 units:
-//  - PercentPercentage p => p = %
+//   - PercentPercentage p => p = %
+//
 // Base: PercentPercentage
 type Percentage float64
 
@@ -3487,6 +5114,11 @@ func (x Percentage) Base() Unit {
 	return PercentPercentageUnit
 }
 
+// Dimension always returns Dimension{}
+func (x Percentage) Dimension() Dimension {
+	return Dimension{}
+}
+
 // PercentageUnits is effectively a constant
 var PercentageUnits = [...]Unit{PercentPercentageUnit}
 
@@ -3530,7 +5162,7 @@ var PercentageUnitType Percentage = 0.0
 // UnitType     : Percentage
 // UnitType.Base: PercentPercentage
 // Unit.FromBase: p => p = %
-// Unit.ToBase  : p => p = %
+// Unit.ToBase  : v => v = %
 type PercentPercentage Percentage
 
 // Title always returns "Percent"
@@ -3554,8 +5186,8 @@ func (x PercentPercentage) FromBase(p float64) float64 {
 }
 
 // ToBase converts % to %
-func (x PercentPercentage) ToBase(p float64) float64 {
-	return p
+func (x PercentPercentage) ToBase(v float64) float64 {
+	return v
 }
 
 // PercentPercentageMatchList is effectively a constant
@@ -3566,6 +5198,11 @@ func (x PercentPercentage) MatchList() []string {
 	return PercentPercentageMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x PercentPercentage) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -3589,11 +5226,18 @@ func (x PercentPercentage) Base() Unit {
 	return PercentPercentageUnit
 }
 
+// ExactFactor always returns 1 exactly
+func (x PercentPercentage) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1")
+	return r, true
+}
+
 var PercentPercentageUnit PercentPercentage = 0.0
 
 // Humidity (UnitType)
 // Contains 1 units:
-//  - PercentHumidity p => p = %
+//   - PercentHumidity p => p = %
+//
 // Base: PercentHumidity
 type Humidity float64
 
@@ -3612,6 +5256,11 @@ func (x Humidity) Base() Unit {
 	return PercentHumidityUnit
 }
 
+// Dimension always returns Dimension{}
+func (x Humidity) Dimension() Dimension {
+	return Dimension{}
+}
+
 // HumidityUnits is effectively a constant
 var HumidityUnits = [...]Unit{PercentHumidityUnit}
 
@@ -3655,7 +5304,7 @@ var HumidityUnitType Humidity = 0.0
 // UnitType     : Humidity
 // UnitType.Base: PercentHumidity
 // Unit.FromBase: p => p = %
-// Unit.ToBase  : p => p = %
+// Unit.ToBase  : v => v = %
 type PercentHumidity Humidity
 
 // Title always returns "Percent"
@@ -3679,8 +5328,8 @@ func (x PercentHumidity) FromBase(p float64) float64 {
 }
 
 // ToBase converts % to %
-func (x PercentHumidity) ToBase(p float64) float64 {
-	return p
+func (x PercentHumidity) ToBase(v float64) float64 {
+	return v
 }
 
 // PercentHumidityMatchList is effectively a constant
@@ -3691,6 +5340,11 @@ func (x PercentHumidity) MatchList() []string {
 	return PercentHumidityMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x PercentHumidity) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -3714,11 +5368,18 @@ func (x PercentHumidity) Base() Unit {
 	return PercentHumidityUnit
 }
 
+// ExactFactor always returns 1 exactly
+func (x PercentHumidity) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1")
+	return r, true
+}
+
 var PercentHumidityUnit PercentHumidity = 0.0
 
 // Alarm (UnitType)
 // Contains 1 units:
-//  - PercentAlarm p => p = %
+//   - PercentAlarm p => p = %
+//
 // Base: PercentAlarm
 type Alarm float64
 
@@ -3737,6 +5398,11 @@ func (x Alarm) Base() Unit {
 	return PercentAlarmUnit
 }
 
+// Dimension always returns Dimension{}
+func (x Alarm) Dimension() Dimension {
+	return Dimension{}
+}
+
 // AlarmUnits is effectively a constant
 var AlarmUnits = [...]Unit{PercentAlarmUnit}
 
@@ -3780,7 +5446,7 @@ var AlarmUnitType Alarm = 0.0
 // UnitType     : Alarm
 // UnitType.Base: PercentAlarm
 // Unit.FromBase: p => p = %
-// Unit.ToBase  : p => p = %
+// Unit.ToBase  : v => v = %
 type PercentAlarm Alarm
 
 // Title always returns "Percent"
@@ -3804,8 +5470,8 @@ func (x PercentAlarm) FromBase(p float64) float64 {
 }
 
 // ToBase converts % to %
-func (x PercentAlarm) ToBase(p float64) float64 {
-	return p
+func (x PercentAlarm) ToBase(v float64) float64 {
+	return v
 }
 
 // PercentAlarmMatchList is effectively a constant
@@ -3816,6 +5482,11 @@ func (x PercentAlarm) MatchList() []string {
 	return PercentAlarmMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x PercentAlarm) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -3839,14 +5510,23 @@ func (x PercentAlarm) Base() Unit {
 	return PercentAlarmUnit
 }
 
+// ExactFactor always returns 1 exactly
+func (x PercentAlarm) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1")
+	return r, true
+}
+
 var PercentAlarmUnit PercentAlarm = 0.0
 
 // Work (UnitType)
-// Contains 4 units:
-//  - JoulesWork                 J => J                         = J
-//  - InchPoundsForceWork        J => J * 8.850,74              = in lbf
-//  - CubicFeetOfNaturalGasWork  J => J * 0.000,000,947,817     = BTUᵢₜ
-//  - BarrelsOfOilEquivalentWork J => J * 0.000,000,000,163,399 = bboe
+// Contains 6 units:
+//   - JoulesWork                 J => J                          = J
+//   - KilojoulesWork             v => v * 0.001                  = kJ
+//   - MegajoulesWork             v => v * 1e-06                  = MJ
+//   - InchPoundsForceWork        v => v * 8.850745791327185      = in lbf
+//   - CubicFeetOfNaturalGasWork  v => v * 0.0009478171203133172  = BTUᵢₜ
+//   - BarrelsOfOilEquivalentWork v => v * 1.6339869281045752e-10 = bboe
+//
 // Base: JoulesWork
 type Work float64
 
@@ -3855,44 +5535,194 @@ func (x Work) Title() string {
 	return "Work"
 }
 
-// Name always returns "Work"
-func (x Work) Name() string {
-	return "Work"
+// Name always returns "Work"
+func (x Work) Name() string {
+	return "Work"
+}
+
+// Base always returns JoulesWorkUnit
+func (x Work) Base() Unit {
+	return JoulesWorkUnit
+}
+
+// Dimension always returns Dimension{Mass: 1, Length: 2, Time: -2}
+func (x Work) Dimension() Dimension {
+	return Dimension{Mass: 1, Length: 2, Time: -2}
+}
+
+// WorkUnits is effectively a constant
+var WorkUnits = [...]Unit{JoulesWorkUnit, KilojoulesWorkUnit, MegajoulesWorkUnit, InchPoundsForceWorkUnit, CubicFeetOfNaturalGasWorkUnit, BarrelsOfOilEquivalentWorkUnit}
+
+// Units always returns WorkUnits[:]
+func (x Work) Units() []Unit {
+	return WorkUnits[:]
+}
+
+// WorkUnitList is effectively a constant
+var WorkUnitList = [...]string{"Joules", "Kilojoules", "Megajoules", "Inch-pounds Force", "Cubic Feet of Natural Gas", "Barrels of Oil Equivalent"}
+
+// UnitList always returns WorkUnitList[:]
+func (x Work) UnitList() []string {
+	return WorkUnitList[:]
+}
+
+// WorkMatchList is effectively a constant
+var WorkMatchList = [...]string{"work"}
+
+// MatchList always returns WorkMatchList[:]
+func (x Work) MatchList() []string {
+	return WorkMatchList[:]
+}
+
+// Matches returns true if check matches our possible names.
+// Helpful when a user is allowed to enter in unit types
+// freehand, for example.
+func (x Work) Matches(check string) bool {
+	check = SanitizeString(check)
+	for _, m := range x.MatchList() {
+		if m == check || m == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+var WorkUnitType Work = 0.0
+
+// JoulesWork (Unit)
+// UnitType     : Work
+// UnitType.Base: JoulesWork
+// Unit.FromBase: J => J = J
+// Unit.ToBase  : v => v = J
+type JoulesWork Work
+
+// Title always returns "Joules"
+func (x JoulesWork) Title() string {
+	return "Joules"
+}
+
+// Name always returns "Joules"
+func (x JoulesWork) Name() string {
+	return "Joules"
+}
+
+// Symbol always returns "J"
+func (x JoulesWork) Symbol() string {
+	return "J"
+}
+
+// FromBase converts J to J
+func (x JoulesWork) FromBase(J float64) float64 {
+	return J
+}
+
+// ToBase converts J to J
+func (x JoulesWork) ToBase(v float64) float64 {
+	return v
+}
+
+// JoulesWorkMatchList is effectively a constant
+var JoulesWorkMatchList = [...]string{"j", "joule", "joules"}
+
+// MatchList always returns JoulesWorkMatchList[:]
+func (x JoulesWork) MatchList() []string {
+	return JoulesWorkMatchList[:]
+}
+
+// ExactMatchList always returns nil
+func (x JoulesWork) ExactMatchList() []string {
+	return nil
+}
+
+// Matches returns true if check matches our possible names.
+// Helpful when a user is allowed to enter in unit types
+// freehand, for example.
+func (x JoulesWork) Matches(check string) bool {
+	check = SanitizeString(check)
+	for _, m := range x.MatchList() {
+		if m == check || m == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// TypeOf always returns WorkUnitType
+func (x JoulesWork) TypeOf() UnitType {
+	return WorkUnitType
+}
+
+// Base always returns JoulesWorkUnit
+func (x JoulesWork) Base() Unit {
+	return JoulesWorkUnit
+}
+
+// ExactFactor always returns 1 exactly
+func (x JoulesWork) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1")
+	return r, true
+}
+
+var JoulesWorkUnit JoulesWork = 0.0
+
+// KilojoulesWork (Unit)
+// UnitType     : Work
+// UnitType.Base: JoulesWork
+// Unit.FromBase: v => v * 0.001 = kJ
+// Unit.ToBase  : v => v * 1000  = J
+type KilojoulesWork Work
+
+// Title always returns "Kilojoules"
+func (x KilojoulesWork) Title() string {
+	return "Kilojoules"
+}
+
+// Name always returns "Kilojoules"
+func (x KilojoulesWork) Name() string {
+	return "Kilojoules"
 }
 
-// Base always returns JoulesWorkUnit
-func (x Work) Base() Unit {
-	return JoulesWorkUnit
+// Symbol always returns "kJ"
+func (x KilojoulesWork) Symbol() string {
+	return "kJ"
 }
 
-// WorkUnits is effectively a constant
-var WorkUnits = [...]Unit{JoulesWorkUnit, InchPoundsForceWorkUnit, CubicFeetOfNaturalGasWorkUnit, BarrelsOfOilEquivalentWorkUnit}
+// FromBase converts J to kJ
+func (x KilojoulesWork) FromBase(v float64) float64 {
+	return v * 0.001
+}
 
-// Units always returns WorkUnits[:]
-func (x Work) Units() []Unit {
-	return WorkUnits[:]
+// ToBase converts kJ to J
+func (x KilojoulesWork) ToBase(v float64) float64 {
+	return v * 1000.0
 }
 
-// WorkUnitList is effectively a constant
-var WorkUnitList = [...]string{"Joules", "Inch-pounds Force", "Cubic Feet of Natural Gas", "Barrels of Oil Equivalent"}
+// KilojoulesWorkMatchList is effectively a constant
+var KilojoulesWorkMatchList = [...]string{"kj", "kilojoule", "kilojoules"}
 
-// UnitList always returns WorkUnitList[:]
-func (x Work) UnitList() []string {
-	return WorkUnitList[:]
+// MatchList always returns KilojoulesWorkMatchList[:]
+func (x KilojoulesWork) MatchList() []string {
+	return KilojoulesWorkMatchList[:]
 }
 
-// WorkMatchList is effectively a constant
-var WorkMatchList = [...]string{"work"}
+// KilojoulesWorkExactMatchList is effectively a constant
+var KilojoulesWorkExactMatchList = [...]string{"kJ"}
 
-// MatchList always returns WorkMatchList[:]
-func (x Work) MatchList() []string {
-	return WorkMatchList[:]
+// ExactMatchList always returns KilojoulesWorkExactMatchList[:]
+func (x KilojoulesWork) ExactMatchList() []string {
+	return KilojoulesWorkExactMatchList[:]
 }
 
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
-func (x Work) Matches(check string) bool {
+func (x KilojoulesWork) Matches(check string) bool {
+	exact := WhitespaceRegex.ReplaceAllString(check, "")
+	for _, m := range x.ExactMatchList() {
+		if m == exact {
+			return true
+		}
+	}
 	check = SanitizeString(check)
 	for _, m := range x.MatchList() {
 		if m == check || m == "*" {
@@ -3902,52 +5732,82 @@ func (x Work) Matches(check string) bool {
 	return false
 }
 
-var WorkUnitType Work = 0.0
+// TypeOf always returns WorkUnitType
+func (x KilojoulesWork) TypeOf() UnitType {
+	return WorkUnitType
+}
 
-// JoulesWork (Unit)
+// Base always returns JoulesWorkUnit
+func (x KilojoulesWork) Base() Unit {
+	return JoulesWorkUnit
+}
+
+// ExactFactor always returns 1000 exactly
+func (x KilojoulesWork) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1000")
+	return r, true
+}
+
+var KilojoulesWorkUnit KilojoulesWork = 0.0
+
+// MegajoulesWork (Unit)
 // UnitType     : Work
 // UnitType.Base: JoulesWork
-// Unit.FromBase: J => J = J
-// Unit.ToBase  : J => J = J
-type JoulesWork Work
+// Unit.FromBase: v => v * 1e-06 = MJ
+// Unit.ToBase  : v => v * 1e+06 = J
+type MegajoulesWork Work
 
-// Title always returns "Joules"
-func (x JoulesWork) Title() string {
-	return "Joules"
+// Title always returns "Megajoules"
+func (x MegajoulesWork) Title() string {
+	return "Megajoules"
 }
 
-// Name always returns "Joules"
-func (x JoulesWork) Name() string {
-	return "Joules"
+// Name always returns "Megajoules"
+func (x MegajoulesWork) Name() string {
+	return "Megajoules"
 }
 
-// Symbol always returns "J"
-func (x JoulesWork) Symbol() string {
-	return "J"
+// Symbol always returns "MJ"
+func (x MegajoulesWork) Symbol() string {
+	return "MJ"
 }
 
-// FromBase converts J to J
-func (x JoulesWork) FromBase(J float64) float64 {
-	return J
+// FromBase converts J to MJ
+func (x MegajoulesWork) FromBase(v float64) float64 {
+	return v * 1e-06
 }
 
-// ToBase converts J to J
-func (x JoulesWork) ToBase(J float64) float64 {
-	return J
+// ToBase converts MJ to J
+func (x MegajoulesWork) ToBase(v float64) float64 {
+	return v * 1e+06
 }
 
-// JoulesWorkMatchList is effectively a constant
-var JoulesWorkMatchList = [...]string{"j", "joule", "joules"}
+// MegajoulesWorkMatchList is effectively a constant
+var MegajoulesWorkMatchList = [...]string{"megajoule", "megajoules"}
 
-// MatchList always returns JoulesWorkMatchList[:]
-func (x JoulesWork) MatchList() []string {
-	return JoulesWorkMatchList[:]
+// MatchList always returns MegajoulesWorkMatchList[:]
+func (x MegajoulesWork) MatchList() []string {
+	return MegajoulesWorkMatchList[:]
+}
+
+// MegajoulesWorkExactMatchList is effectively a constant
+var MegajoulesWorkExactMatchList = [...]string{"MJ"}
+
+// ExactMatchList always returns MegajoulesWorkExactMatchList[:]
+func (x MegajoulesWork) ExactMatchList() []string {
+	return MegajoulesWorkExactMatchList[:]
 }
 
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
-func (x JoulesWork) Matches(check string) bool {
+func (x MegajoulesWork) Matches(check string) bool {
+	exact := WhitespaceRegex.ReplaceAllString(check, "")
+	for _, m := range x.ExactMatchList() {
+		if m == exact {
+			return true
+		}
+	}
 	check = SanitizeString(check)
 	for _, m := range x.MatchList() {
 		if m == check || m == "*" {
@@ -3958,22 +5818,28 @@ func (x JoulesWork) Matches(check string) bool {
 }
 
 // TypeOf always returns WorkUnitType
-func (x JoulesWork) TypeOf() UnitType {
+func (x MegajoulesWork) TypeOf() UnitType {
 	return WorkUnitType
 }
 
 // Base always returns JoulesWorkUnit
-func (x JoulesWork) Base() Unit {
+func (x MegajoulesWork) Base() Unit {
 	return JoulesWorkUnit
 }
 
-var JoulesWorkUnit JoulesWork = 0.0
+// ExactFactor always returns 1000000 exactly
+func (x MegajoulesWork) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1000000")
+	return r, true
+}
+
+var MegajoulesWorkUnit MegajoulesWork = 0.0
 
 // InchPoundsForceWork (Unit)
 // UnitType     : Work
 // UnitType.Base: JoulesWork
-// Unit.FromBase: J => J * 8.850,74          = in lbf
-// Unit.ToBase  : inlbf => inlbf * 0.112,985 = J
+// Unit.FromBase: v => v * 8.850745791327185  = in lbf
+// Unit.ToBase  : v => v * 0.1129848290276167 = J
 type InchPoundsForceWork Work
 
 // Title always returns "InchPoundsForce"
@@ -3992,13 +5858,13 @@ func (x InchPoundsForceWork) Symbol() string {
 }
 
 // FromBase converts J to in lbf
-func (x InchPoundsForceWork) FromBase(J float64) float64 {
-	return J * 8.85074
+func (x InchPoundsForceWork) FromBase(v float64) float64 {
+	return v * 8.850745791327185
 }
 
 // ToBase converts in lbf to J
-func (x InchPoundsForceWork) ToBase(inlbf float64) float64 {
-	return inlbf * 0.112985
+func (x InchPoundsForceWork) ToBase(v float64) float64 {
+	return v * 0.1129848290276167
 }
 
 // InchPoundsForceWorkMatchList is effectively a constant
@@ -4009,6 +5875,11 @@ func (x InchPoundsForceWork) MatchList() []string {
 	return InchPoundsForceWorkMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x InchPoundsForceWork) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -4032,13 +5903,19 @@ func (x InchPoundsForceWork) Base() Unit {
 	return JoulesWorkUnit
 }
 
+// ExactFactor always returns 1129848290276167/10000000000000000 exactly
+func (x InchPoundsForceWork) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1129848290276167/10000000000000000")
+	return r, true
+}
+
 var InchPoundsForceWorkUnit InchPoundsForceWork = 0.0
 
 // CubicFeetOfNaturalGasWork (Unit)
 // UnitType     : Work
 // UnitType.Base: JoulesWork
-// Unit.FromBase: J => J * 0.000,000,947,817 = BTUᵢₜ
-// Unit.ToBase  : btu => btu * 1,055,060     = J
+// Unit.FromBase: v => v * 0.0009478171203133172 = BTUᵢₜ
+// Unit.ToBase  : v => v * 1055.05585262         = J
 type CubicFeetOfNaturalGasWork Work
 
 // Title always returns "CubicFeetOfNaturalGas"
@@ -4057,13 +5934,13 @@ func (x CubicFeetOfNaturalGasWork) Symbol() string {
 }
 
 // FromBase converts J to BTUᵢₜ
-func (x CubicFeetOfNaturalGasWork) FromBase(J float64) float64 {
-	return J * 0.000000947817
+func (x CubicFeetOfNaturalGasWork) FromBase(v float64) float64 {
+	return v * 0.0009478171203133172
 }
 
 // ToBase converts BTUᵢₜ to J
-func (x CubicFeetOfNaturalGasWork) ToBase(btu float64) float64 {
-	return btu * 1055060
+func (x CubicFeetOfNaturalGasWork) ToBase(v float64) float64 {
+	return v * 1055.05585262
 }
 
 // CubicFeetOfNaturalGasWorkMatchList is effectively a constant
@@ -4074,6 +5951,11 @@ func (x CubicFeetOfNaturalGasWork) MatchList() []string {
 	return CubicFeetOfNaturalGasWorkMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x CubicFeetOfNaturalGasWork) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -4097,13 +5979,19 @@ func (x CubicFeetOfNaturalGasWork) Base() Unit {
 	return JoulesWorkUnit
 }
 
+// ExactFactor always returns 52752792631/50000000 exactly
+func (x CubicFeetOfNaturalGasWork) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("52752792631/50000000")
+	return r, true
+}
+
 var CubicFeetOfNaturalGasWorkUnit CubicFeetOfNaturalGasWork = 0.0
 
 // BarrelsOfOilEquivalentWork (Unit)
 // UnitType     : Work
 // UnitType.Base: JoulesWork
-// Unit.FromBase: J => J * 0.000,000,000,163,399 = bboe
-// Unit.ToBase  : bboe => bboe * 6,120,000,000   = J
+// Unit.FromBase: v => v * 1.6339869281045752e-10 = bboe
+// Unit.ToBase  : v => v * 6.12e+09               = J
 type BarrelsOfOilEquivalentWork Work
 
 // Title always returns "BarrelsOfOilEquivalent"
@@ -4122,13 +6010,13 @@ func (x BarrelsOfOilEquivalentWork) Symbol() string {
 }
 
 // FromBase converts J to bboe
-func (x BarrelsOfOilEquivalentWork) FromBase(J float64) float64 {
-	return J * 0.000000000163399
+func (x BarrelsOfOilEquivalentWork) FromBase(v float64) float64 {
+	return v * 1.6339869281045752e-10
 }
 
 // ToBase converts bboe to J
-func (x BarrelsOfOilEquivalentWork) ToBase(bboe float64) float64 {
-	return bboe * 6120000000
+func (x BarrelsOfOilEquivalentWork) ToBase(v float64) float64 {
+	return v * 6.12e+09
 }
 
 // BarrelsOfOilEquivalentWorkMatchList is effectively a constant
@@ -4139,6 +6027,11 @@ func (x BarrelsOfOilEquivalentWork) MatchList() []string {
 	return BarrelsOfOilEquivalentWorkMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x BarrelsOfOilEquivalentWork) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -4162,13 +6055,21 @@ func (x BarrelsOfOilEquivalentWork) Base() Unit {
 	return JoulesWorkUnit
 }
 
+// ExactFactor always returns 6120000000 exactly
+func (x BarrelsOfOilEquivalentWork) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("6120000000")
+	return r, true
+}
+
 var BarrelsOfOilEquivalentWorkUnit BarrelsOfOilEquivalentWork = 0.0
 
 // Force (UnitType)
-// Contains 3 units:
-//  - NewtonsForce        N => N             = N
-//  - PoundsForceForce    N => N * 0.224,809 = lbf
-//  - KilogramsForceForce N => N * 0.101,972 = kgf
+// Contains 4 units:
+//   - NewtonsForce        N => N                       = N
+//   - KilonewtonsForce    v => v * 0.001               = kN
+//   - PoundsForceForce    v => v * 0.22480894309971047 = lbf
+//   - KilogramsForceForce v => v * 0.10197162129779283 = kgf
+//
 // Base: NewtonsForce
 type Force float64
 
@@ -4187,8 +6088,13 @@ func (x Force) Base() Unit {
 	return NewtonsForceUnit
 }
 
+// Dimension always returns Dimension{Mass: 1, Length: 1, Time: -2}
+func (x Force) Dimension() Dimension {
+	return Dimension{Mass: 1, Length: 1, Time: -2}
+}
+
 // ForceUnits is effectively a constant
-var ForceUnits = [...]Unit{NewtonsForceUnit, PoundsForceForceUnit, KilogramsForceForceUnit}
+var ForceUnits = [...]Unit{NewtonsForceUnit, KilonewtonsForceUnit, PoundsForceForceUnit, KilogramsForceForceUnit}
 
 // Units always returns ForceUnits[:]
 func (x Force) Units() []Unit {
@@ -4196,7 +6102,7 @@ func (x Force) Units() []Unit {
 }
 
 // ForceUnitList is effectively a constant
-var ForceUnitList = [...]string{"Newtons", "Pounds-force", "Kilograms-force"}
+var ForceUnitList = [...]string{"Newtons", "Kilonewtons", "Pounds-force", "Kilograms-force"}
 
 // UnitList always returns ForceUnitList[:]
 func (x Force) UnitList() []string {
@@ -4230,7 +6136,7 @@ var ForceUnitType Force = 0.0
 // UnitType     : Force
 // UnitType.Base: NewtonsForce
 // Unit.FromBase: N => N = N
-// Unit.ToBase  : N => N = N
+// Unit.ToBase  : v => v = N
 type NewtonsForce Force
 
 // Title always returns "Newtons"
@@ -4254,8 +6160,8 @@ func (x NewtonsForce) FromBase(N float64) float64 {
 }
 
 // ToBase converts N to N
-func (x NewtonsForce) ToBase(N float64) float64 {
-	return N
+func (x NewtonsForce) ToBase(v float64) float64 {
+	return v
 }
 
 // NewtonsForceMatchList is effectively a constant
@@ -4266,6 +6172,11 @@ func (x NewtonsForce) MatchList() []string {
 	return NewtonsForceMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x NewtonsForce) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -4289,13 +6200,104 @@ func (x NewtonsForce) Base() Unit {
 	return NewtonsForceUnit
 }
 
+// ExactFactor always returns 1 exactly
+func (x NewtonsForce) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1")
+	return r, true
+}
+
 var NewtonsForceUnit NewtonsForce = 0.0
 
+// KilonewtonsForce (Unit)
+// UnitType     : Force
+// UnitType.Base: NewtonsForce
+// Unit.FromBase: v => v * 0.001 = kN
+// Unit.ToBase  : v => v * 1000  = N
+type KilonewtonsForce Force
+
+// Title always returns "Kilonewtons"
+func (x KilonewtonsForce) Title() string {
+	return "Kilonewtons"
+}
+
+// Name always returns "Kilonewtons"
+func (x KilonewtonsForce) Name() string {
+	return "Kilonewtons"
+}
+
+// Symbol always returns "kN"
+func (x KilonewtonsForce) Symbol() string {
+	return "kN"
+}
+
+// FromBase converts N to kN
+func (x KilonewtonsForce) FromBase(v float64) float64 {
+	return v * 0.001
+}
+
+// ToBase converts kN to N
+func (x KilonewtonsForce) ToBase(v float64) float64 {
+	return v * 1000.0
+}
+
+// KilonewtonsForceMatchList is effectively a constant
+var KilonewtonsForceMatchList = [...]string{"kn", "kilonewton", "kilonewtons"}
+
+// MatchList always returns KilonewtonsForceMatchList[:]
+func (x KilonewtonsForce) MatchList() []string {
+	return KilonewtonsForceMatchList[:]
+}
+
+// KilonewtonsForceExactMatchList is effectively a constant
+var KilonewtonsForceExactMatchList = [...]string{"kN"}
+
+// ExactMatchList always returns KilonewtonsForceExactMatchList[:]
+func (x KilonewtonsForce) ExactMatchList() []string {
+	return KilonewtonsForceExactMatchList[:]
+}
+
+// Matches returns true if check matches our possible names.
+// Helpful when a user is allowed to enter in unit types
+// freehand, for example.
+func (x KilonewtonsForce) Matches(check string) bool {
+	exact := WhitespaceRegex.ReplaceAllString(check, "")
+	for _, m := range x.ExactMatchList() {
+		if m == exact {
+			return true
+		}
+	}
+	check = SanitizeString(check)
+	for _, m := range x.MatchList() {
+		if m == check || m == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// TypeOf always returns ForceUnitType
+func (x KilonewtonsForce) TypeOf() UnitType {
+	return ForceUnitType
+}
+
+// Base always returns NewtonsForceUnit
+func (x KilonewtonsForce) Base() Unit {
+	return NewtonsForceUnit
+}
+
+// ExactFactor always returns 1000 exactly
+func (x KilonewtonsForce) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1000")
+	return r, true
+}
+
+var KilonewtonsForceUnit KilonewtonsForce = 0.0
+
 // PoundsForceForce (Unit)
 // UnitType     : Force
 // UnitType.Base: NewtonsForce
-// Unit.FromBase: N => N * 0.224,809    = lbf
-// Unit.ToBase  : lbf => lbf * 4.448,22 = N
+// Unit.FromBase: v => v * 0.22480894309971047 = lbf
+// Unit.ToBase  : v => v * 4.4482216152605     = N
 type PoundsForceForce Force
 
 // Title always returns "PoundsForce"
@@ -4314,13 +6316,13 @@ func (x PoundsForceForce) Symbol() string {
 }
 
 // FromBase converts N to lbf
-func (x PoundsForceForce) FromBase(N float64) float64 {
-	return N * 0.224809
+func (x PoundsForceForce) FromBase(v float64) float64 {
+	return v * 0.22480894309971047
 }
 
 // ToBase converts lbf to N
-func (x PoundsForceForce) ToBase(lbf float64) float64 {
-	return lbf * 4.44822
+func (x PoundsForceForce) ToBase(v float64) float64 {
+	return v * 4.4482216152605
 }
 
 // PoundsForceForceMatchList is effectively a constant
@@ -4331,6 +6333,11 @@ func (x PoundsForceForce) MatchList() []string {
 	return PoundsForceForceMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x PoundsForceForce) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -4354,13 +6361,19 @@ func (x PoundsForceForce) Base() Unit {
 	return NewtonsForceUnit
 }
 
+// ExactFactor always returns 8896443230521/2000000000000 exactly
+func (x PoundsForceForce) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("8896443230521/2000000000000")
+	return r, true
+}
+
 var PoundsForceForceUnit PoundsForceForce = 0.0
 
 // KilogramsForceForce (Unit)
 // UnitType     : Force
 // UnitType.Base: NewtonsForce
-// Unit.FromBase: N => N * 0.101,972    = kgf
-// Unit.ToBase  : kgf => kgf * 9.806,65 = N
+// Unit.FromBase: v => v * 0.10197162129779283 = kgf
+// Unit.ToBase  : v => v * 9.80665             = N
 type KilogramsForceForce Force
 
 // Title always returns "KilogramsForce"
@@ -4379,13 +6392,13 @@ func (x KilogramsForceForce) Symbol() string {
 }
 
 // FromBase converts N to kgf
-func (x KilogramsForceForce) FromBase(N float64) float64 {
-	return N * 0.101972
+func (x KilogramsForceForce) FromBase(v float64) float64 {
+	return v * 0.10197162129779283
 }
 
 // ToBase converts kgf to N
-func (x KilogramsForceForce) ToBase(kgf float64) float64 {
-	return kgf * 9.80665
+func (x KilogramsForceForce) ToBase(v float64) float64 {
+	return v * 9.80665
 }
 
 // KilogramsForceForceMatchList is effectively a constant
@@ -4396,6 +6409,11 @@ func (x KilogramsForceForce) MatchList() []string {
 	return KilogramsForceForceMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x KilogramsForceForce) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -4419,13 +6437,22 @@ func (x KilogramsForceForce) Base() Unit {
 	return NewtonsForceUnit
 }
 
+// ExactFactor always returns 196133/20000 exactly
+func (x KilogramsForceForce) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("196133/20000")
+	return r, true
+}
+
 var KilogramsForceForceUnit KilogramsForceForce = 0.0
 
 // Length (UnitType)
-// Contains 3 units:
-//  - MetersLength m => m            = m
-//  - FeetLength   m => m * 3.280,84 = ft
-//  - InchesLength m => m * 39.370,1 = in
+// Contains 5 units:
+//   - MetersLength      m => m                      = m
+//   - MillimetersLength v => v * 1000               = mm
+//   - KilometersLength  v => v * 0.001              = km
+//   - FeetLength        v => v * 3.2808398950131235 = ft
+//   - InchesLength      v => v * 39.37007874015748  = in
+//
 // Base: MetersLength
 type Length float64
 
@@ -4444,8 +6471,13 @@ func (x Length) Base() Unit {
 	return MetersLengthUnit
 }
 
+// Dimension always returns Dimension{Length: 1}
+func (x Length) Dimension() Dimension {
+	return Dimension{Length: 1}
+}
+
 // LengthUnits is effectively a constant
-var LengthUnits = [...]Unit{MetersLengthUnit, FeetLengthUnit, InchesLengthUnit}
+var LengthUnits = [...]Unit{MetersLengthUnit, MillimetersLengthUnit, KilometersLengthUnit, FeetLengthUnit, InchesLengthUnit}
 
 // Units always returns LengthUnits[:]
 func (x Length) Units() []Unit {
@@ -4453,7 +6485,7 @@ func (x Length) Units() []Unit {
 }
 
 // LengthUnitList is effectively a constant
-var LengthUnitList = [...]string{"Meters", "Feet", "Inches"}
+var LengthUnitList = [...]string{"Meters", "Millimeters", "Kilometers", "Feet", "Inches"}
 
 // UnitList always returns LengthUnitList[:]
 func (x Length) UnitList() []string {
@@ -4487,7 +6519,7 @@ var LengthUnitType Length = 0.0
 // UnitType     : Length
 // UnitType.Base: MetersLength
 // Unit.FromBase: m => m = m
-// Unit.ToBase  : m => m = m
+// Unit.ToBase  : v => v = m
 type MetersLength Length
 
 // Title always returns "Meters"
@@ -4495,38 +6527,213 @@ func (x MetersLength) Title() string {
 	return "Meters"
 }
 
-// Name always returns "Meters"
-func (x MetersLength) Name() string {
-	return "Meters"
+// Name always returns "Meters"
+func (x MetersLength) Name() string {
+	return "Meters"
+}
+
+// Symbol always returns "m"
+func (x MetersLength) Symbol() string {
+	return "m"
+}
+
+// FromBase converts m to m
+func (x MetersLength) FromBase(m float64) float64 {
+	return m
+}
+
+// ToBase converts m to m
+func (x MetersLength) ToBase(v float64) float64 {
+	return v
+}
+
+// MetersLengthMatchList is effectively a constant
+var MetersLengthMatchList = [...]string{"m", "meter", "meters"}
+
+// MatchList always returns MetersLengthMatchList[:]
+func (x MetersLength) MatchList() []string {
+	return MetersLengthMatchList[:]
+}
+
+// ExactMatchList always returns nil
+func (x MetersLength) ExactMatchList() []string {
+	return nil
+}
+
+// Matches returns true if check matches our possible names.
+// Helpful when a user is allowed to enter in unit types
+// freehand, for example.
+func (x MetersLength) Matches(check string) bool {
+	check = SanitizeString(check)
+	for _, m := range x.MatchList() {
+		if m == check || m == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// TypeOf always returns LengthUnitType
+func (x MetersLength) TypeOf() UnitType {
+	return LengthUnitType
+}
+
+// Base always returns MetersLengthUnit
+func (x MetersLength) Base() Unit {
+	return MetersLengthUnit
+}
+
+// ExactFactor always returns 1 exactly
+func (x MetersLength) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1")
+	return r, true
+}
+
+var MetersLengthUnit MetersLength = 0.0
+
+// MillimetersLength (Unit)
+// UnitType     : Length
+// UnitType.Base: MetersLength
+// Unit.FromBase: v => v * 1000  = mm
+// Unit.ToBase  : v => v * 0.001 = m
+type MillimetersLength Length
+
+// Title always returns "Millimeters"
+func (x MillimetersLength) Title() string {
+	return "Millimeters"
+}
+
+// Name always returns "Millimeters"
+func (x MillimetersLength) Name() string {
+	return "Millimeters"
+}
+
+// Symbol always returns "mm"
+func (x MillimetersLength) Symbol() string {
+	return "mm"
+}
+
+// FromBase converts m to mm
+func (x MillimetersLength) FromBase(v float64) float64 {
+	return v * 1000.0
+}
+
+// ToBase converts mm to m
+func (x MillimetersLength) ToBase(v float64) float64 {
+	return v * 0.001
+}
+
+// MillimetersLengthMatchList is effectively a constant
+var MillimetersLengthMatchList = [...]string{"millimeter", "millimeters"}
+
+// MatchList always returns MillimetersLengthMatchList[:]
+func (x MillimetersLength) MatchList() []string {
+	return MillimetersLengthMatchList[:]
+}
+
+// MillimetersLengthExactMatchList is effectively a constant
+var MillimetersLengthExactMatchList = [...]string{"mm"}
+
+// ExactMatchList always returns MillimetersLengthExactMatchList[:]
+func (x MillimetersLength) ExactMatchList() []string {
+	return MillimetersLengthExactMatchList[:]
+}
+
+// Matches returns true if check matches our possible names.
+// Helpful when a user is allowed to enter in unit types
+// freehand, for example.
+func (x MillimetersLength) Matches(check string) bool {
+	exact := WhitespaceRegex.ReplaceAllString(check, "")
+	for _, m := range x.ExactMatchList() {
+		if m == exact {
+			return true
+		}
+	}
+	check = SanitizeString(check)
+	for _, m := range x.MatchList() {
+		if m == check || m == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// TypeOf always returns LengthUnitType
+func (x MillimetersLength) TypeOf() UnitType {
+	return LengthUnitType
+}
+
+// Base always returns MetersLengthUnit
+func (x MillimetersLength) Base() Unit {
+	return MetersLengthUnit
+}
+
+// ExactFactor always returns 1/1000 exactly
+func (x MillimetersLength) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1/1000")
+	return r, true
+}
+
+var MillimetersLengthUnit MillimetersLength = 0.0
+
+// KilometersLength (Unit)
+// UnitType     : Length
+// UnitType.Base: MetersLength
+// Unit.FromBase: v => v * 0.001 = km
+// Unit.ToBase  : v => v * 1000  = m
+type KilometersLength Length
+
+// Title always returns "Kilometers"
+func (x KilometersLength) Title() string {
+	return "Kilometers"
+}
+
+// Name always returns "Kilometers"
+func (x KilometersLength) Name() string {
+	return "Kilometers"
+}
+
+// Symbol always returns "km"
+func (x KilometersLength) Symbol() string {
+	return "km"
 }
 
-// Symbol always returns "m"
-func (x MetersLength) Symbol() string {
-	return "m"
+// FromBase converts m to km
+func (x KilometersLength) FromBase(v float64) float64 {
+	return v * 0.001
 }
 
-// FromBase converts m to m
-func (x MetersLength) FromBase(m float64) float64 {
-	return m
+// ToBase converts km to m
+func (x KilometersLength) ToBase(v float64) float64 {
+	return v * 1000.0
 }
 
-// ToBase converts m to m
-func (x MetersLength) ToBase(m float64) float64 {
-	return m
+// KilometersLengthMatchList is effectively a constant
+var KilometersLengthMatchList = [...]string{"km", "kilometer", "kilometers"}
+
+// MatchList always returns KilometersLengthMatchList[:]
+func (x KilometersLength) MatchList() []string {
+	return KilometersLengthMatchList[:]
 }
 
-// MetersLengthMatchList is effectively a constant
-var MetersLengthMatchList = [...]string{"m", "meter", "meters"}
+// KilometersLengthExactMatchList is effectively a constant
+var KilometersLengthExactMatchList = [...]string{"km"}
 
-// MatchList always returns MetersLengthMatchList[:]
-func (x MetersLength) MatchList() []string {
-	return MetersLengthMatchList[:]
+// ExactMatchList always returns KilometersLengthExactMatchList[:]
+func (x KilometersLength) ExactMatchList() []string {
+	return KilometersLengthExactMatchList[:]
 }
 
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
-func (x MetersLength) Matches(check string) bool {
+func (x KilometersLength) Matches(check string) bool {
+	exact := WhitespaceRegex.ReplaceAllString(check, "")
+	for _, m := range x.ExactMatchList() {
+		if m == exact {
+			return true
+		}
+	}
 	check = SanitizeString(check)
 	for _, m := range x.MatchList() {
 		if m == check || m == "*" {
@@ -4537,22 +6744,28 @@ func (x MetersLength) Matches(check string) bool {
 }
 
 // TypeOf always returns LengthUnitType
-func (x MetersLength) TypeOf() UnitType {
+func (x KilometersLength) TypeOf() UnitType {
 	return LengthUnitType
 }
 
 // Base always returns MetersLengthUnit
-func (x MetersLength) Base() Unit {
+func (x KilometersLength) Base() Unit {
 	return MetersLengthUnit
 }
 
-var MetersLengthUnit MetersLength = 0.0
+// ExactFactor always returns 1000 exactly
+func (x KilometersLength) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1000")
+	return r, true
+}
+
+var KilometersLengthUnit KilometersLength = 0.0
 
 // FeetLength (Unit)
 // UnitType     : Length
 // UnitType.Base: MetersLength
-// Unit.FromBase: m => m * 3.280,84    = ft
-// Unit.ToBase  : ft => ft * 0.304,800 = m
+// Unit.FromBase: v => v * 3.2808398950131235 = ft
+// Unit.ToBase  : v => v * 0.3048             = m
 type FeetLength Length
 
 // Title always returns "Feet"
@@ -4571,13 +6784,13 @@ func (x FeetLength) Symbol() string {
 }
 
 // FromBase converts m to ft
-func (x FeetLength) FromBase(m float64) float64 {
-	return m * 3.28084
+func (x FeetLength) FromBase(v float64) float64 {
+	return v * 3.2808398950131235
 }
 
 // ToBase converts ft to m
-func (x FeetLength) ToBase(ft float64) float64 {
-	return ft * 0.304800
+func (x FeetLength) ToBase(v float64) float64 {
+	return v * 0.3048
 }
 
 // FeetLengthMatchList is effectively a constant
@@ -4588,6 +6801,11 @@ func (x FeetLength) MatchList() []string {
 	return FeetLengthMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x FeetLength) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -4611,13 +6829,19 @@ func (x FeetLength) Base() Unit {
 	return MetersLengthUnit
 }
 
+// ExactFactor always returns 381/1250 exactly
+func (x FeetLength) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("381/1250")
+	return r, true
+}
+
 var FeetLengthUnit FeetLength = 0.0
 
 // InchesLength (Unit)
 // UnitType     : Length
 // UnitType.Base: MetersLength
-// Unit.FromBase: m => m * 39.370,1     = in
-// Unit.ToBase  : in => in * 0.0254,000 = m
+// Unit.FromBase: v => v * 39.37007874015748 = in
+// Unit.ToBase  : v => v * 0.0254            = m
 type InchesLength Length
 
 // Title always returns "Inches"
@@ -4636,13 +6860,13 @@ func (x InchesLength) Symbol() string {
 }
 
 // FromBase converts m to in
-func (x InchesLength) FromBase(m float64) float64 {
-	return m * 39.3701
+func (x InchesLength) FromBase(v float64) float64 {
+	return v * 39.37007874015748
 }
 
 // ToBase converts in to m
-func (x InchesLength) ToBase(in float64) float64 {
-	return in * 0.0254000
+func (x InchesLength) ToBase(v float64) float64 {
+	return v * 0.0254
 }
 
 // InchesLengthMatchList is effectively a constant
@@ -4653,6 +6877,11 @@ func (x InchesLength) MatchList() []string {
 	return InchesLengthMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x InchesLength) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -4676,11 +6905,18 @@ func (x InchesLength) Base() Unit {
 	return MetersLengthUnit
 }
 
+// ExactFactor always returns 127/5000 exactly
+func (x InchesLength) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("127/5000")
+	return r, true
+}
+
 var InchesLengthUnit InchesLength = 0.0
 
 // StrokeRate (UnitType)
 // Contains 1 units:
-//  - StrokesPerSecondStrokeRate ss => ss = strokes/s
+//   - StrokesPerSecondStrokeRate ss => ss = strokes/s
+//
 // Base: StrokesPerSecondStrokeRate
 type StrokeRate float64
 
@@ -4699,6 +6935,11 @@ func (x StrokeRate) Base() Unit {
 	return StrokesPerSecondStrokeRateUnit
 }
 
+// Dimension always returns Dimension{Time: -1}
+func (x StrokeRate) Dimension() Dimension {
+	return Dimension{Time: -1}
+}
+
 // StrokeRateUnits is effectively a constant
 var StrokeRateUnits = [...]Unit{StrokesPerSecondStrokeRateUnit}
 
@@ -4742,7 +6983,7 @@ var StrokeRateUnitType StrokeRate = 0.0
 // UnitType     : StrokeRate
 // UnitType.Base: StrokesPerSecondStrokeRate
 // Unit.FromBase: ss => ss = strokes/s
-// Unit.ToBase  : ss => ss = strokes/s
+// Unit.ToBase  : v => v   = strokes/s
 type StrokesPerSecondStrokeRate StrokeRate
 
 // Title always returns "StrokesPerSecond"
@@ -4766,8 +7007,8 @@ func (x StrokesPerSecondStrokeRate) FromBase(ss float64) float64 {
 }
 
 // ToBase converts strokes/s to strokes/s
-func (x StrokesPerSecondStrokeRate) ToBase(ss float64) float64 {
-	return ss
+func (x StrokesPerSecondStrokeRate) ToBase(v float64) float64 {
+	return v
 }
 
 // StrokesPerSecondStrokeRateMatchList is effectively a constant
@@ -4778,6 +7019,11 @@ func (x StrokesPerSecondStrokeRate) MatchList() []string {
 	return StrokesPerSecondStrokeRateMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x StrokesPerSecondStrokeRate) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -4801,11 +7047,391 @@ func (x StrokesPerSecondStrokeRate) Base() Unit {
 	return StrokesPerSecondStrokeRateUnit
 }
 
+// ExactFactor always returns 1 exactly
+func (x StrokesPerSecondStrokeRate) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1")
+	return r, true
+}
+
 var StrokesPerSecondStrokeRateUnit StrokesPerSecondStrokeRate = 0.0
 
+// Time (UnitType)
+// Contains 4 units:
+//   - SecondsTime s => s                          = s
+//   - MinutesTime v => v * 0.016666666666666666   = min
+//   - HoursTime   v => v * 0.0002777777777777778  = h
+//   - DaysTime    v => v * 1.1574074074074073e-05 = d
+//
+// Base: SecondsTime
+type Time float64
+
+// Title always returns "Time"
+func (x Time) Title() string {
+	return "Time"
+}
+
+// Name always returns "Time"
+func (x Time) Name() string {
+	return "Time"
+}
+
+// Base always returns SecondsTimeUnit
+func (x Time) Base() Unit {
+	return SecondsTimeUnit
+}
+
+// Dimension always returns Dimension{Time: 1}
+func (x Time) Dimension() Dimension {
+	return Dimension{Time: 1}
+}
+
+// TimeUnits is effectively a constant
+var TimeUnits = [...]Unit{SecondsTimeUnit, MinutesTimeUnit, HoursTimeUnit, DaysTimeUnit}
+
+// Units always returns TimeUnits[:]
+func (x Time) Units() []Unit {
+	return TimeUnits[:]
+}
+
+// TimeUnitList is effectively a constant
+var TimeUnitList = [...]string{"Seconds", "Minutes", "Hours", "Days"}
+
+// UnitList always returns TimeUnitList[:]
+func (x Time) UnitList() []string {
+	return TimeUnitList[:]
+}
+
+// TimeMatchList is effectively a constant
+var TimeMatchList = [...]string{"time", "duration"}
+
+// MatchList always returns TimeMatchList[:]
+func (x Time) MatchList() []string {
+	return TimeMatchList[:]
+}
+
+// Matches returns true if check matches our possible names.
+// Helpful when a user is allowed to enter in unit types
+// freehand, for example.
+func (x Time) Matches(check string) bool {
+	check = SanitizeString(check)
+	for _, m := range x.MatchList() {
+		if m == check || m == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+var TimeUnitType Time = 0.0
+
+// SecondsTime (Unit)
+// UnitType     : Time
+// UnitType.Base: SecondsTime
+// Unit.FromBase: s => s = s
+// Unit.ToBase  : v => v = s
+type SecondsTime Time
+
+// Title always returns "Seconds"
+func (x SecondsTime) Title() string {
+	return "Seconds"
+}
+
+// Name always returns "Seconds"
+func (x SecondsTime) Name() string {
+	return "Seconds"
+}
+
+// Symbol always returns "s"
+func (x SecondsTime) Symbol() string {
+	return "s"
+}
+
+// FromBase converts s to s
+func (x SecondsTime) FromBase(s float64) float64 {
+	return s
+}
+
+// ToBase converts s to s
+func (x SecondsTime) ToBase(v float64) float64 {
+	return v
+}
+
+// SecondsTimeMatchList is effectively a constant
+var SecondsTimeMatchList = [...]string{"s", "sec", "secs", "second", "seconds"}
+
+// MatchList always returns SecondsTimeMatchList[:]
+func (x SecondsTime) MatchList() []string {
+	return SecondsTimeMatchList[:]
+}
+
+// ExactMatchList always returns nil
+func (x SecondsTime) ExactMatchList() []string {
+	return nil
+}
+
+// Matches returns true if check matches our possible names.
+// Helpful when a user is allowed to enter in unit types
+// freehand, for example.
+func (x SecondsTime) Matches(check string) bool {
+	check = SanitizeString(check)
+	for _, m := range x.MatchList() {
+		if m == check || m == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// TypeOf always returns TimeUnitType
+func (x SecondsTime) TypeOf() UnitType {
+	return TimeUnitType
+}
+
+// Base always returns SecondsTimeUnit
+func (x SecondsTime) Base() Unit {
+	return SecondsTimeUnit
+}
+
+// ExactFactor always returns 1 exactly
+func (x SecondsTime) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1")
+	return r, true
+}
+
+var SecondsTimeUnit SecondsTime = 0.0
+
+// MinutesTime (Unit)
+// UnitType     : Time
+// UnitType.Base: SecondsTime
+// Unit.FromBase: v => v * 0.016666666666666666 = min
+// Unit.ToBase  : v => v * 60                   = s
+type MinutesTime Time
+
+// Title always returns "Minutes"
+func (x MinutesTime) Title() string {
+	return "Minutes"
+}
+
+// Name always returns "Minutes"
+func (x MinutesTime) Name() string {
+	return "Minutes"
+}
+
+// Symbol always returns "min"
+func (x MinutesTime) Symbol() string {
+	return "min"
+}
+
+// FromBase converts s to min
+func (x MinutesTime) FromBase(v float64) float64 {
+	return v * 0.016666666666666666
+}
+
+// ToBase converts min to s
+func (x MinutesTime) ToBase(v float64) float64 {
+	return v * 60.0
+}
+
+// MinutesTimeMatchList is effectively a constant
+var MinutesTimeMatchList = [...]string{"min", "mins", "minute", "minutes"}
+
+// MatchList always returns MinutesTimeMatchList[:]
+func (x MinutesTime) MatchList() []string {
+	return MinutesTimeMatchList[:]
+}
+
+// ExactMatchList always returns nil
+func (x MinutesTime) ExactMatchList() []string {
+	return nil
+}
+
+// Matches returns true if check matches our possible names.
+// Helpful when a user is allowed to enter in unit types
+// freehand, for example.
+func (x MinutesTime) Matches(check string) bool {
+	check = SanitizeString(check)
+	for _, m := range x.MatchList() {
+		if m == check || m == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// TypeOf always returns TimeUnitType
+func (x MinutesTime) TypeOf() UnitType {
+	return TimeUnitType
+}
+
+// Base always returns SecondsTimeUnit
+func (x MinutesTime) Base() Unit {
+	return SecondsTimeUnit
+}
+
+// ExactFactor always returns 60 exactly
+func (x MinutesTime) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("60")
+	return r, true
+}
+
+var MinutesTimeUnit MinutesTime = 0.0
+
+// HoursTime (Unit)
+// UnitType     : Time
+// UnitType.Base: SecondsTime
+// Unit.FromBase: v => v * 0.0002777777777777778 = h
+// Unit.ToBase  : v => v * 3600                  = s
+type HoursTime Time
+
+// Title always returns "Hours"
+func (x HoursTime) Title() string {
+	return "Hours"
+}
+
+// Name always returns "Hours"
+func (x HoursTime) Name() string {
+	return "Hours"
+}
+
+// Symbol always returns "h"
+func (x HoursTime) Symbol() string {
+	return "h"
+}
+
+// FromBase converts s to h
+func (x HoursTime) FromBase(v float64) float64 {
+	return v * 0.0002777777777777778
+}
+
+// ToBase converts h to s
+func (x HoursTime) ToBase(v float64) float64 {
+	return v * 3600.0
+}
+
+// HoursTimeMatchList is effectively a constant
+var HoursTimeMatchList = [...]string{"h", "hr", "hrs", "hour", "hours"}
+
+// MatchList always returns HoursTimeMatchList[:]
+func (x HoursTime) MatchList() []string {
+	return HoursTimeMatchList[:]
+}
+
+// ExactMatchList always returns nil
+func (x HoursTime) ExactMatchList() []string {
+	return nil
+}
+
+// Matches returns true if check matches our possible names.
+// Helpful when a user is allowed to enter in unit types
+// freehand, for example.
+func (x HoursTime) Matches(check string) bool {
+	check = SanitizeString(check)
+	for _, m := range x.MatchList() {
+		if m == check || m == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// TypeOf always returns TimeUnitType
+func (x HoursTime) TypeOf() UnitType {
+	return TimeUnitType
+}
+
+// Base always returns SecondsTimeUnit
+func (x HoursTime) Base() Unit {
+	return SecondsTimeUnit
+}
+
+// ExactFactor always returns 3600 exactly
+func (x HoursTime) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("3600")
+	return r, true
+}
+
+var HoursTimeUnit HoursTime = 0.0
+
+// DaysTime (Unit)
+// UnitType     : Time
+// UnitType.Base: SecondsTime
+// Unit.FromBase: v => v * 1.1574074074074073e-05 = d
+// Unit.ToBase  : v => v * 86400                  = s
+type DaysTime Time
+
+// Title always returns "Days"
+func (x DaysTime) Title() string {
+	return "Days"
+}
+
+// Name always returns "Days"
+func (x DaysTime) Name() string {
+	return "Days"
+}
+
+// Symbol always returns "d"
+func (x DaysTime) Symbol() string {
+	return "d"
+}
+
+// FromBase converts s to d
+func (x DaysTime) FromBase(v float64) float64 {
+	return v * 1.1574074074074073e-05
+}
+
+// ToBase converts d to s
+func (x DaysTime) ToBase(v float64) float64 {
+	return v * 86400.0
+}
+
+// DaysTimeMatchList is effectively a constant
+var DaysTimeMatchList = [...]string{"d", "day", "days"}
+
+// MatchList always returns DaysTimeMatchList[:]
+func (x DaysTime) MatchList() []string {
+	return DaysTimeMatchList[:]
+}
+
+// ExactMatchList always returns nil
+func (x DaysTime) ExactMatchList() []string {
+	return nil
+}
+
+// Matches returns true if check matches our possible names.
+// Helpful when a user is allowed to enter in unit types
+// freehand, for example.
+func (x DaysTime) Matches(check string) bool {
+	check = SanitizeString(check)
+	for _, m := range x.MatchList() {
+		if m == check || m == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// TypeOf always returns TimeUnitType
+func (x DaysTime) TypeOf() UnitType {
+	return TimeUnitType
+}
+
+// Base always returns SecondsTimeUnit
+func (x DaysTime) Base() Unit {
+	return SecondsTimeUnit
+}
+
+// ExactFactor always returns 86400 exactly
+func (x DaysTime) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("86400")
+	return r, true
+}
+
+var DaysTimeUnit DaysTime = 0.0
+
 // Number (UnitType)
 // Contains 1 units:
-//  - NumberNumber n => n =
+//   - NumberNumber n => n =
+//
 // Base: NumberNumber
 type Number float64
 
@@ -4824,6 +7450,11 @@ func (x Number) Base() Unit {
 	return NumberNumberUnit
 }
 
+// Dimension always returns Dimension{}
+func (x Number) Dimension() Dimension {
+	return Dimension{}
+}
+
 // NumberUnits is effectively a constant
 var NumberUnits = [...]Unit{NumberNumberUnit}
 
@@ -4867,7 +7498,7 @@ var NumberUnitType Number = 0.0
 // UnitType     : Number
 // UnitType.Base: NumberNumber
 // Unit.FromBase: n => n =
-// Unit.ToBase  : n => n =
+// Unit.ToBase  : v => v =
 type NumberNumber Number
 
 // Title always returns "Number"
@@ -4891,8 +7522,8 @@ func (x NumberNumber) FromBase(n float64) float64 {
 }
 
 // ToBase converts  to
-func (x NumberNumber) ToBase(n float64) float64 {
-	return n
+func (x NumberNumber) ToBase(v float64) float64 {
+	return v
 }
 
 // NumberNumberMatchList is effectively a constant
@@ -4903,6 +7534,11 @@ func (x NumberNumber) MatchList() []string {
 	return NumberNumberMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x NumberNumber) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -4926,11 +7562,18 @@ func (x NumberNumber) Base() Unit {
 	return NumberNumberUnit
 }
 
+// ExactFactor always returns 1 exactly
+func (x NumberNumber) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1")
+	return r, true
+}
+
 var NumberNumberUnit NumberNumber = 0.0
 
 // Overspeed (UnitType)
 // Contains 1 units:
-//  - NumberOverspeed n => n =
+//   - NumberOverspeed n => n =
+//
 // Base: NumberOverspeed
 type Overspeed float64
 
@@ -4949,6 +7592,11 @@ func (x Overspeed) Base() Unit {
 	return NumberOverspeedUnit
 }
 
+// Dimension always returns Dimension{}
+func (x Overspeed) Dimension() Dimension {
+	return Dimension{}
+}
+
 // OverspeedUnits is effectively a constant
 var OverspeedUnits = [...]Unit{NumberOverspeedUnit}
 
@@ -4992,7 +7640,7 @@ var OverspeedUnitType Overspeed = 0.0
 // UnitType     : Overspeed
 // UnitType.Base: NumberOverspeed
 // Unit.FromBase: n => n =
-// Unit.ToBase  : n => n =
+// Unit.ToBase  : v => v =
 type NumberOverspeed Overspeed
 
 // Title always returns "Number"
@@ -5016,8 +7664,8 @@ func (x NumberOverspeed) FromBase(n float64) float64 {
 }
 
 // ToBase converts  to
-func (x NumberOverspeed) ToBase(n float64) float64 {
-	return n
+func (x NumberOverspeed) ToBase(v float64) float64 {
+	return v
 }
 
 // NumberOverspeedMatchList is effectively a constant
@@ -5028,6 +7676,11 @@ func (x NumberOverspeed) MatchList() []string {
 	return NumberOverspeedMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x NumberOverspeed) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -5051,11 +7704,18 @@ func (x NumberOverspeed) Base() Unit {
 	return NumberOverspeedUnit
 }
 
+// ExactFactor always returns 1 exactly
+func (x NumberOverspeed) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1")
+	return r, true
+}
+
 var NumberOverspeedUnit NumberOverspeed = 0.0
 
 // Underspeed (UnitType)
 // Contains 1 units:
-//  - NumberUnderspeed n => n =
+//   - NumberUnderspeed n => n =
+//
 // Base: NumberUnderspeed
 type Underspeed float64
 
@@ -5074,6 +7734,11 @@ func (x Underspeed) Base() Unit {
 	return NumberUnderspeedUnit
 }
 
+// Dimension always returns Dimension{}
+func (x Underspeed) Dimension() Dimension {
+	return Dimension{}
+}
+
 // UnderspeedUnits is effectively a constant
 var UnderspeedUnits = [...]Unit{NumberUnderspeedUnit}
 
@@ -5117,7 +7782,7 @@ var UnderspeedUnitType Underspeed = 0.0
 // UnitType     : Underspeed
 // UnitType.Base: NumberUnderspeed
 // Unit.FromBase: n => n =
-// Unit.ToBase  : n => n =
+// Unit.ToBase  : v => v =
 type NumberUnderspeed Underspeed
 
 // Title always returns "Number"
@@ -5141,8 +7806,8 @@ func (x NumberUnderspeed) FromBase(n float64) float64 {
 }
 
 // ToBase converts  to
-func (x NumberUnderspeed) ToBase(n float64) float64 {
-	return n
+func (x NumberUnderspeed) ToBase(v float64) float64 {
+	return v
 }
 
 // NumberUnderspeedMatchList is effectively a constant
@@ -5153,6 +7818,11 @@ func (x NumberUnderspeed) MatchList() []string {
 	return NumberUnderspeedMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x NumberUnderspeed) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -5176,11 +7846,18 @@ func (x NumberUnderspeed) Base() Unit {
 	return NumberUnderspeedUnit
 }
 
+// ExactFactor always returns 1 exactly
+func (x NumberUnderspeed) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1")
+	return r, true
+}
+
 var NumberUnderspeedUnit NumberUnderspeed = 0.0
 
 // Totaliser (UnitType)
 // Contains 1 units:
-//  - NumberTotaliser n => n =
+//   - NumberTotaliser n => n =
+//
 // Base: NumberTotaliser
 type Totaliser float64
 
@@ -5199,6 +7876,11 @@ func (x Totaliser) Base() Unit {
 	return NumberTotaliserUnit
 }
 
+// Dimension always returns Dimension{}
+func (x Totaliser) Dimension() Dimension {
+	return Dimension{}
+}
+
 // TotaliserUnits is effectively a constant
 var TotaliserUnits = [...]Unit{NumberTotaliserUnit}
 
@@ -5242,7 +7924,7 @@ var TotaliserUnitType Totaliser = 0.0
 // UnitType     : Totaliser
 // UnitType.Base: NumberTotaliser
 // Unit.FromBase: n => n =
-// Unit.ToBase  : n => n =
+// Unit.ToBase  : v => v =
 type NumberTotaliser Totaliser
 
 // Title always returns "Number"
@@ -5266,8 +7948,8 @@ func (x NumberTotaliser) FromBase(n float64) float64 {
 }
 
 // ToBase converts  to
-func (x NumberTotaliser) ToBase(n float64) float64 {
-	return n
+func (x NumberTotaliser) ToBase(v float64) float64 {
+	return v
 }
 
 // NumberTotaliserMatchList is effectively a constant
@@ -5278,6 +7960,11 @@ func (x NumberTotaliser) MatchList() []string {
 	return NumberTotaliserMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x NumberTotaliser) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -5301,11 +7988,18 @@ func (x NumberTotaliser) Base() Unit {
 	return NumberTotaliserUnit
 }
 
+// ExactFactor always returns 1 exactly
+func (x NumberTotaliser) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1")
+	return r, true
+}
+
 var NumberTotaliserUnit NumberTotaliser = 0.0
 
 // WMLFlowRate (UnitType)
 // Contains 1 units:
-//  - NumberWMLFlowRate n => n =
+//   - NumberWMLFlowRate n => n =
+//
 // Base: NumberWMLFlowRate
 type WMLFlowRate float64
 
@@ -5324,6 +8018,11 @@ func (x WMLFlowRate) Base() Unit {
 	return NumberWMLFlowRateUnit
 }
 
+// Dimension always returns Dimension{}
+func (x WMLFlowRate) Dimension() Dimension {
+	return Dimension{}
+}
+
 // WMLFlowRateUnits is effectively a constant
 var WMLFlowRateUnits = [...]Unit{NumberWMLFlowRateUnit}
 
@@ -5367,7 +8066,7 @@ var WMLFlowRateUnitType WMLFlowRate = 0.0
 // UnitType     : WMLFlowRate
 // UnitType.Base: NumberWMLFlowRate
 // Unit.FromBase: n => n =
-// Unit.ToBase  : n => n =
+// Unit.ToBase  : v => v =
 type NumberWMLFlowRate WMLFlowRate
 
 // Title always returns "Number"
@@ -5391,8 +8090,8 @@ func (x NumberWMLFlowRate) FromBase(n float64) float64 {
 }
 
 // ToBase converts  to
-func (x NumberWMLFlowRate) ToBase(n float64) float64 {
-	return n
+func (x NumberWMLFlowRate) ToBase(v float64) float64 {
+	return v
 }
 
 // NumberWMLFlowRateMatchList is effectively a constant
@@ -5403,6 +8102,11 @@ func (x NumberWMLFlowRate) MatchList() []string {
 	return NumberWMLFlowRateMatchList[:]
 }
 
+// ExactMatchList always returns nil
+func (x NumberWMLFlowRate) ExactMatchList() []string {
+	return nil
+}
+
 // Matches returns true if check matches our possible names.
 // Helpful when a user is allowed to enter in unit types
 // freehand, for example.
@@ -5426,4 +8130,10 @@ func (x NumberWMLFlowRate) Base() Unit {
 	return NumberWMLFlowRateUnit
 }
 
+// ExactFactor always returns 1 exactly
+func (x NumberWMLFlowRate) ExactFactor() (*big.Rat, bool) {
+	r, _ := new(big.Rat).SetString("1")
+	return r, true
+}
+
 var NumberWMLFlowRateUnit NumberWMLFlowRate = 0.0
@@ -0,0 +1,149 @@
+package units
+
+import (
+	"math"
+	"testing"
+)
+
+// File autogenerated from units.yaml (sha256 5bc3954acadf7f43).
+// Do not edit directly
+
+// roundTripSamples are converted to and from the base of every unit
+var roundTripSamples = [...]float64{-1e6, -40, -1, 0, 1e-6, 1e-3, 1, 37.5, 1e3, 1e6, 1e9}
+
+// roundTripTolerance is relative, or absolute for samples under 1
+const roundTripTolerance = 1e-09
+
+// generatedUnits is every unit of every unit type
+var generatedUnits = [...]struct {
+	alakaTitle string
+	typeOf     UnitType
+	unit       Unit
+}{
+	{"Pressure_Pascals", PressureUnitType, PascalsPressureUnit},
+	{"Pressure_Kilopascals", PressureUnitType, KilopascalsPressureUnit},
+	{"Pressure_Megapascals", PressureUnitType, MegapascalsPressureUnit},
+	{"Pressure_PoundsPerSquareInch", PressureUnitType, PoundsPerSquareInchPressureUnit},
+	{"Pressure_InchesOfWater", PressureUnitType, InchesOfWaterPressureUnit},
+	{"Temperature_DegreesCelsius", TemperatureUnitType, DegreesCelsiusTemperatureUnit},
+	{"Temperature_DegreesFahrenheit", TemperatureUnitType, DegreesFahrenheitTemperatureUnit},
+	{"Temperature_Kelvins", TemperatureUnitType, KelvinsTemperatureUnit},
+	{"TemperatureDifference_DegreesCelsius", TemperatureDifferenceUnitType, DegreesCelsiusTemperatureDifferenceUnit},
+	{"TemperatureDifference_DegreesFahrenheit", TemperatureDifferenceUnitType, DegreesFahrenheitTemperatureDifferenceUnit},
+	{"TemperatureDifference_Kelvins", TemperatureDifferenceUnitType, KelvinsTemperatureDifferenceUnit},
+	{"Flow_CubicMetersPerSecond", FlowUnitType, CubicMetersPerSecondFlowUnit},
+	{"Flow_CubicFeetPerSecond", FlowUnitType, CubicFeetPerSecondFlowUnit},
+	{"Flow_ThousandCubicFeetPerDay", FlowUnitType, ThousandCubicFeetPerDayFlowUnit},
+	{"Flow_GallonsUSFluidPerSecond", FlowUnitType, GallonsUSFluidPerSecondFlowUnit},
+	{"Flow_GallonsUSFluidPerMinute", FlowUnitType, GallonsUSFluidPerMinuteFlowUnit},
+	{"Flow_BarrelsPerSecond", FlowUnitType, BarrelsPerSecondFlowUnit},
+	{"Flow_BarrelsPerMinute", FlowUnitType, BarrelsPerMinuteFlowUnit},
+	{"Volume_CubicMeters", VolumeUnitType, CubicMetersVolumeUnit},
+	{"Volume_CubicFeet", VolumeUnitType, CubicFeetVolumeUnit},
+	{"Volume_ThousandsOfCubicFeet", VolumeUnitType, ThousandsOfCubicFeetVolumeUnit},
+	{"Volume_CubicDecimeter", VolumeUnitType, CubicDecimeterVolumeUnit},
+	{"Volume_Liter", VolumeUnitType, LiterVolumeUnit},
+	{"Volume_GallonUSFluid", VolumeUnitType, GallonUSFluidVolumeUnit},
+	{"Volume_BarrelsOfOil", VolumeUnitType, BarrelsOfOilVolumeUnit},
+	{"Mass_Kilograms", MassUnitType, KilogramsMassUnit},
+	{"Mass_Pounds", MassUnitType, PoundsMassUnit},
+	{"MassFlow_KilogramsPerSecond", MassFlowUnitType, KilogramsPerSecondMassFlowUnit},
+	{"MassFlow_PoundsPerSecond", MassFlowUnitType, PoundsPerSecondMassFlowUnit},
+	{"MassFlow_PoundsPerMinute", MassFlowUnitType, PoundsPerMinuteMassFlowUnit},
+	{"ElectricPotential_Volts", ElectricPotentialUnitType, VoltsElectricPotentialUnit},
+	{"ElectricPotential_Millivolts", ElectricPotentialUnitType, MillivoltsElectricPotentialUnit},
+	{"ElectricPotential_Kilovolts", ElectricPotentialUnitType, KilovoltsElectricPotentialUnit},
+	{"ElectricPotentialLoaded_Volts", ElectricPotentialLoadedUnitType, VoltsElectricPotentialLoadedUnit},
+	{"ElectricPotentialLoaded_Millivolts", ElectricPotentialLoadedUnitType, MillivoltsElectricPotentialLoadedUnit},
+	{"ElectricPotentialLoaded_Kilovolts", ElectricPotentialLoadedUnitType, KilovoltsElectricPotentialLoadedUnit},
+	{"ElectricPotentialUnloaded_Volts", ElectricPotentialUnloadedUnitType, VoltsElectricPotentialUnloadedUnit},
+	{"ElectricPotentialUnloaded_Millivolts", ElectricPotentialUnloadedUnitType, MillivoltsElectricPotentialUnloadedUnit},
+	{"ElectricPotentialUnloaded_Kilovolts", ElectricPotentialUnloadedUnitType, KilovoltsElectricPotentialUnloadedUnit},
+	{"Percentage_Percent", PercentageUnitType, PercentPercentageUnit},
+	{"Humidity_Percent", HumidityUnitType, PercentHumidityUnit},
+	{"Alarm_Percent", AlarmUnitType, PercentAlarmUnit},
+	{"Work_Joules", WorkUnitType, JoulesWorkUnit},
+	{"Work_Kilojoules", WorkUnitType, KilojoulesWorkUnit},
+	{"Work_Megajoules", WorkUnitType, MegajoulesWorkUnit},
+	{"Work_InchPoundsForce", WorkUnitType, InchPoundsForceWorkUnit},
+	{"Work_CubicFeetOfNaturalGas", WorkUnitType, CubicFeetOfNaturalGasWorkUnit},
+	{"Work_BarrelsOfOilEquivalent", WorkUnitType, BarrelsOfOilEquivalentWorkUnit},
+	{"Force_Newtons", ForceUnitType, NewtonsForceUnit},
+	{"Force_Kilonewtons", ForceUnitType, KilonewtonsForceUnit},
+	{"Force_PoundsForce", ForceUnitType, PoundsForceForceUnit},
+	{"Force_KilogramsForce", ForceUnitType, KilogramsForceForceUnit},
+	{"Length_Meters", LengthUnitType, MetersLengthUnit},
+	{"Length_Millimeters", LengthUnitType, MillimetersLengthUnit},
+	{"Length_Kilometers", LengthUnitType, KilometersLengthUnit},
+	{"Length_Feet", LengthUnitType, FeetLengthUnit},
+	{"Length_Inches", LengthUnitType, InchesLengthUnit},
+	{"StrokeRate_StrokesPerSecond", StrokeRateUnitType, StrokesPerSecondStrokeRateUnit},
+	{"Time_Seconds", TimeUnitType, SecondsTimeUnit},
+	{"Time_Minutes", TimeUnitType, MinutesTimeUnit},
+	{"Time_Hours", TimeUnitType, HoursTimeUnit},
+	{"Time_Days", TimeUnitType, DaysTimeUnit},
+	{"Number_Number", NumberUnitType, NumberNumberUnit},
+	{"Overspeed_Number", OverspeedUnitType, NumberOverspeedUnit},
+	{"Underspeed_Number", UnderspeedUnitType, NumberUnderspeedUnit},
+	{"Totaliser_Number", TotaliserUnitType, NumberTotaliserUnit},
+	{"WMLFlowRate_Number", WMLFlowRateUnitType, NumberWMLFlowRateUnit},
+}
+
+func TestGeneratedRoundTrip(t *testing.T) {
+	for _, tc := range generatedUnits {
+		for _, x := range roundTripSamples {
+			got := tc.unit.ToBase(tc.unit.FromBase(x))
+			if math.Abs(got-x) > roundTripTolerance*math.Max(1, math.Abs(x)) {
+				t.Errorf("%s: ToBase(FromBase(%g)) = %g", tc.alakaTitle, x, got)
+			}
+		}
+	}
+}
+
+func TestGeneratedMatches(t *testing.T) {
+	for _, tc := range generatedUnits {
+		for _, m := range append(tc.unit.MatchList(), ExactMatchList(tc.unit)...) {
+			if got := GetUnit(m, tc.typeOf); got != tc.unit {
+				t.Errorf("%s: GetUnit(%q) = %s", tc.alakaTitle, m, AlakaTitle(got.TypeOf(), got))
+			}
+		}
+	}
+}
+
+func TestGeneratedAlakaTitles(t *testing.T) {
+	if len(AllUnitTypes) != len(generatedUnits) {
+		t.Fatalf("AllUnitTypes has %d entries, want %d", len(AllUnitTypes), len(generatedUnits))
+	}
+	for idx, tc := range generatedUnits {
+		if AllUnitTypes[idx] != tc.alakaTitle {
+			t.Errorf("AllUnitTypes[%d] = %s, want %s", idx, AllUnitTypes[idx], tc.alakaTitle)
+		}
+		if got := AlakaTitle(tc.typeOf, tc.unit); got != tc.alakaTitle {
+			t.Errorf("AlakaTitle = %s, want %s", got, tc.alakaTitle)
+		}
+		ut, u := GetTypeUnit(tc.alakaTitle)
+		if ut != tc.typeOf || u != tc.unit {
+			t.Errorf("GetTypeUnit(%s) = %s", tc.alakaTitle, AlakaTitle(ut, u))
+		}
+	}
+}
+
+func TestGeneratedText(t *testing.T) {
+	for _, tc := range generatedUnits {
+		text, err := AnyUnit{tc.unit}.MarshalText()
+		if err != nil || string(text) != tc.alakaTitle {
+			t.Errorf("%s: MarshalText() = %s, %v", tc.alakaTitle, text, err)
+		}
+		if u, err := DecodeUnit(text); err != nil || u != tc.unit {
+			t.Errorf("%s: DecodeUnit(%s) = %v, %v", tc.alakaTitle, text, u, err)
+		}
+
+		text, err = AnyUnitType{tc.typeOf}.MarshalText()
+		if err != nil || string(text) != tc.typeOf.Title() {
+			t.Errorf("%s: type MarshalText() = %s, %v", tc.alakaTitle, text, err)
+		}
+		if ut, err := DecodeUnitType(text); err != nil || ut != tc.typeOf {
+			t.Errorf("%s: DecodeUnitType(%s) = %v, %v", tc.alakaTitle, text, ut, err)
+		}
+	}
+}